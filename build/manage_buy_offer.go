@@ -0,0 +1,56 @@
+package build
+
+import (
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// CreateBuyOffer creates a new offer to buy amount of the rate's buying asset
+func CreateBuyOffer(rate Rate, amount Amount) (result ManageBuyOfferBuilder) {
+	return ManageBuyOffer(rate, amount)
+}
+
+// UpdateBuyOffer updates an existing buy offer
+func UpdateBuyOffer(rate Rate, amount Amount, offerID OfferID) (result ManageBuyOfferBuilder) {
+	return ManageBuyOffer(rate, amount, offerID)
+}
+
+// DeleteBuyOffer deletes an existing buy offer
+func DeleteBuyOffer(rate Rate, offerID OfferID) (result ManageBuyOfferBuilder) {
+	return ManageBuyOffer(rate, Amount("0"), offerID)
+}
+
+// ManageBuyOffer groups the creation of a new ManageBuyOfferBuilder with a call to Mutate.
+func ManageBuyOffer(muts ...interface{}) (result ManageBuyOfferBuilder) {
+	result.Mutate(muts...)
+	return
+}
+
+// ManageBuyOfferBuilder represents a transaction that is being built.
+// The Amount mutator sets the amount of the buying asset the offer wants to buy,
+// and the Rate's price is the price of the buying asset in terms of the selling asset.
+type ManageBuyOfferBuilder struct {
+	O   xdr.Operation
+	BO  xdr.ManageBuyOfferOp
+	Err error
+}
+
+// Mutate applies the provided mutators to this builder's offer or operation.
+func (b *ManageBuyOfferBuilder) Mutate(muts ...interface{}) {
+	for _, m := range muts {
+		var err error
+		switch mut := m.(type) {
+		case ManageOfferMutator:
+			err = mut.MutateManageOffer(&b.BO)
+		case OperationMutator:
+			err = mut.MutateOperation(&b.O)
+		default:
+			err = errors.New("Mutator type not allowed")
+		}
+
+		if err != nil {
+			b.Err = errors.Wrap(err, "ManageBuyOfferBuilder error")
+			return
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package build
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stellar/go/xdr"
+)
+
+var _ = Describe("ManageBuyOffer", func() {
+
+	Describe("ManageBuyOfferBuilder", func() {
+		var (
+			subject ManageBuyOfferBuilder
+			mut     interface{}
+
+			address = "GAXEMCEXBERNSRXOEKD4JAIKVECIXQCENHEBRVSPX2TTYZPMNEDSQCNQ"
+			bad     = "foo"
+
+			rate = Rate{
+				Selling: NativeAsset(),
+				Buying:  CreditAsset("EUR", "GAWSI2JO2CF36Z43UGMUJCDQ2IMR5B3P5TMS7XM7NUTU3JHG3YJUDQXA"),
+				Price:   Price("41.265"),
+			}
+		)
+
+		JustBeforeEach(func() {
+			subject = ManageBuyOfferBuilder{}
+			subject.Mutate(mut)
+		})
+
+		Describe("CreateBuyOffer", func() {
+			Context("creates offer properly", func() {
+				It("sets values properly", func() {
+					builder := CreateBuyOffer(rate, "20")
+
+					Expect(builder.BO.BuyAmount).To(Equal(xdr.Int64(200000000)))
+
+					Expect(builder.BO.Selling.Type).To(Equal(xdr.AssetTypeAssetTypeNative))
+					Expect(builder.BO.Selling.AlphaNum4).To(BeNil())
+					Expect(builder.BO.Selling.AlphaNum12).To(BeNil())
+
+					Expect(builder.BO.Buying.Type).To(Equal(xdr.AssetTypeAssetTypeCreditAlphanum4))
+					Expect(builder.BO.Buying.AlphaNum4.AssetCode).To(Equal([4]byte{'E', 'U', 'R', 0}))
+					var aid xdr.AccountId
+					aid.SetAddress(rate.Buying.Issuer)
+					Expect(builder.BO.Buying.AlphaNum4.Issuer.MustEd25519()).To(Equal(aid.MustEd25519()))
+					Expect(builder.BO.Buying.AlphaNum12).To(BeNil())
+
+					Expect(builder.BO.Price.N).To(Equal(xdr.Int32(8253)))
+					Expect(builder.BO.Price.D).To(Equal(xdr.Int32(200)))
+
+					Expect(builder.BO.OfferId).To(Equal(xdr.Uint64(0)))
+				})
+			})
+		})
+
+		Describe("UpdateBuyOffer", func() {
+			Context("updates the offer properly", func() {
+				It("sets values properly", func() {
+					builder := UpdateBuyOffer(rate, "100", 5)
+
+					Expect(builder.BO.BuyAmount).To(Equal(xdr.Int64(1000000000)))
+					Expect(builder.BO.Price.N).To(Equal(xdr.Int32(8253)))
+					Expect(builder.BO.Price.D).To(Equal(xdr.Int32(200)))
+					Expect(builder.BO.OfferId).To(Equal(xdr.Uint64(5)))
+				})
+			})
+		})
+
+		Describe("DeleteBuyOffer", func() {
+			Context("deletes the offer properly", func() {
+				It("sets values properly", func() {
+					builder := DeleteBuyOffer(rate, 10)
+
+					Expect(builder.BO.BuyAmount).To(Equal(xdr.Int64(0)))
+					Expect(builder.BO.OfferId).To(Equal(xdr.Uint64(10)))
+				})
+			})
+		})
+
+		Describe("SourceAccount", func() {
+			Context("using a valid stellar address", func() {
+				BeforeEach(func() { mut = SourceAccount{address} })
+
+				It("succeeds", func() {
+					Expect(subject.Err).NotTo(HaveOccurred())
+				})
+
+				It("sets the destination to the correct xdr.AccountId", func() {
+					var aid xdr.AccountId
+					aid.SetAddress(address)
+					Expect(subject.O.SourceAccount.MustEd25519()).To(Equal(aid.MustEd25519()))
+				})
+			})
+
+			Context("using an invalid value", func() {
+				BeforeEach(func() { mut = SourceAccount{bad} })
+				It("failed", func() { Expect(subject.Err).To(HaveOccurred()) })
+			})
+		})
+	})
+})
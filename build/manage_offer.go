@@ -83,6 +83,8 @@ func (m Amount) MutateManageOffer(o interface{}) (err error) {
 		o.Amount, err = amount.Parse(string(m))
 	case *xdr.CreatePassiveOfferOp:
 		o.Amount, err = amount.Parse(string(m))
+	case *xdr.ManageBuyOfferOp:
+		o.BuyAmount, err = amount.Parse(string(m))
 	}
 	return
 }
@@ -94,6 +96,8 @@ func (m OfferID) MutateManageOffer(o interface{}) (err error) {
 		err = errors.New("Unexpected operation type")
 	case *xdr.ManageOfferOp:
 		o.OfferId = xdr.Uint64(m)
+	case *xdr.ManageBuyOfferOp:
+		o.OfferId = xdr.Uint64(m)
 	}
 	return
 }
@@ -126,6 +130,18 @@ func (m Rate) MutateManageOffer(o interface{}) (err error) {
 			return
 		}
 
+		o.Price, err = price.Parse(string(m.Price))
+	case *xdr.ManageBuyOfferOp:
+		o.Selling, err = m.Selling.ToXDR()
+		if err != nil {
+			return
+		}
+
+		o.Buying, err = m.Buying.ToXDR()
+		if err != nil {
+			return
+		}
+
 		o.Price, err = price.Parse(string(m.Price))
 	}
 	return
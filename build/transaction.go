@@ -234,6 +234,19 @@ func (m ManageDataBuilder) MutateTransaction(o *TransactionBuilder) error {
 	return m.Err
 }
 
+// MutateTransaction for ManageBuyOfferBuilder causes the underylying
+// ManageBuyOfferOp to be added to the operation list for the provided
+// transaction
+func (m ManageBuyOfferBuilder) MutateTransaction(o *TransactionBuilder) error {
+	if m.Err != nil {
+		return m.Err
+	}
+
+	m.O.Body, m.Err = xdr.NewOperationBody(xdr.OperationTypeManageBuyOffer, m.BO)
+	o.TX.Operations = append(o.TX.Operations, m.O)
+	return m.Err
+}
+
 // MutateTransaction for ManageOfferBuilder causes the underylying
 // ManageData to be added to the operation list for the provided
 // transaction
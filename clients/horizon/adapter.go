@@ -0,0 +1,426 @@
+package horizon
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	horizonclient "github.com/stellar/go/exp/clients/horizon"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/protocols/horizon/effects"
+	"github.com/stellar/go/protocols/horizon/operations"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/render/hal"
+	"github.com/stellar/go/xdr"
+)
+
+// Adapter implements ClientInterface with a client of the
+// github.com/stellar/go/exp/clients/horizon package, so that code written for
+// this package can move to the new client one step at a time.
+//
+// Errors returned by horizon are converted to *Error, so they can be inspected
+// like the errors of Client.
+type Adapter struct {
+	// Client is the client requests are sent with. It can be a
+	// *horizonclient.Client or a *horizonclient.Pool.
+	Client horizonclient.ClientInterface
+}
+
+// ensure that the adapter implements ClientInterface
+var _ ClientInterface = &Adapter{}
+
+// Root loads the root endpoint of horizon
+func (a *Adapter) Root() (Root, error) {
+	root, err := a.Client.Root()
+	return root, convertError(err)
+}
+
+// HomeDomainForAccount returns the home domain for the provided strkey-encoded
+// account id.
+func (a *Adapter) HomeDomainForAccount(aid string) (string, error) {
+	account, err := a.LoadAccount(aid)
+	if err != nil {
+		return "", errors.Wrap(err, "load account failed")
+	}
+	return account.HomeDomain, nil
+}
+
+// LoadAccount loads the account state from horizon. err can be either error
+// object or horizon.Error object.
+func (a *Adapter) LoadAccount(accountID string) (Account, error) {
+	account, err := a.Client.AccountDetail(horizonclient.AccountRequest{AccountID: accountID})
+	return account, convertError(err)
+}
+
+// LoadAccountOffers loads the account offers from horizon. err can be either
+// error object or horizon.Error object.
+func (a *Adapter) LoadAccountOffers(
+	accountID string,
+	params ...interface{},
+) (offers OffersPage, err error) {
+	request := horizonclient.OfferRequest{ForAccount: accountID}
+	at := ""
+
+	for _, param := range params {
+		switch param := param.(type) {
+		case At:
+			at = string(param)
+		case Limit:
+			request.Limit = uint(param)
+		case Order:
+			request.Order = horizonclient.Order(param)
+		case Cursor:
+			request.Cursor = string(param)
+		default:
+			err = errors.Errorf("Undefined parameter (%T): %+v", param, param)
+			return
+		}
+	}
+
+	var page hProtocol.OffersPage
+	if at != "" {
+		// At is the URL of a page, which the new client only loads as the next
+		// page of another one
+		page.Links.Next = hal.Link{Href: at}
+		page, err = a.Client.NextOffersPage(page)
+	} else {
+		page, err = a.Client.Offers(request)
+	}
+	if err != nil {
+		err = convertError(err)
+		return
+	}
+
+	offers = OffersPage(page)
+	return
+}
+
+// LoadTradeAggregations loads the trade aggregation from horizon.
+func (a *Adapter) LoadTradeAggregations(
+	baseAsset Asset,
+	counterAsset Asset,
+	resolution int64,
+	params ...interface{},
+) (tradeAggrs TradeAggregationsPage, err error) {
+	// A zero start or end time is ignored by horizon, like a missing one
+	request := horizonclient.TradeAggregationRequest{
+		StartTime:          time.Unix(0, 0),
+		EndTime:            time.Unix(0, 0),
+		Resolution:         time.Duration(resolution) * time.Millisecond,
+		BaseAssetType:      horizonclient.AssetType(baseAsset.Type),
+		BaseAssetCode:      baseAsset.Code,
+		BaseAssetIssuer:    baseAsset.Issuer,
+		CounterAssetType:   horizonclient.AssetType(counterAsset.Type),
+		CounterAssetCode:   counterAsset.Code,
+		CounterAssetIssuer: counterAsset.Issuer,
+	}
+
+	for _, param := range params {
+		switch param := param.(type) {
+		case StartTime:
+			request.StartTime = millisToTime(int64(param))
+		case EndTime:
+			request.EndTime = millisToTime(int64(param))
+		case Limit:
+			request.Limit = uint(param)
+		case Order:
+			request.Order = horizonclient.Order(param)
+		default:
+			err = errors.Errorf("Undefined parameter (%T): %+v", param, param)
+			return
+		}
+	}
+
+	page, err := a.Client.TradeAggregations(request)
+	if err != nil {
+		err = convertError(err)
+		return
+	}
+
+	tradeAggrs = TradeAggregationsPage(page)
+	return
+}
+
+// LoadTrades loads the /trades endpoint from horizon. resolution is ignored by
+// horizon.
+func (a *Adapter) LoadTrades(
+	baseAsset Asset,
+	counterAsset Asset,
+	offerID int64,
+	resolution int64,
+	params ...interface{},
+) (tradesPage TradesPage, err error) {
+	request := horizonclient.TradeRequest{
+		BaseAssetType:      horizonclient.AssetType(baseAsset.Type),
+		BaseAssetCode:      baseAsset.Code,
+		BaseAssetIssuer:    baseAsset.Issuer,
+		CounterAssetType:   horizonclient.AssetType(counterAsset.Type),
+		CounterAssetCode:   counterAsset.Code,
+		CounterAssetIssuer: counterAsset.Issuer,
+	}
+	if offerID != 0 {
+		request.ForOfferID = strconv.FormatInt(offerID, 10)
+	}
+
+	for _, param := range params {
+		switch param := param.(type) {
+		case Cursor:
+			request.Cursor = string(param)
+		case Limit:
+			request.Limit = uint(param)
+		case Order:
+			request.Order = horizonclient.Order(param)
+		default:
+			err = errors.Errorf("Undefined parameter (%T): %+v", param, param)
+			return
+		}
+	}
+
+	page, err := a.Client.Trades(request)
+	if err != nil {
+		err = convertError(err)
+		return
+	}
+
+	tradesPage = TradesPage(page)
+	return
+}
+
+// LoadAccountMergeAmount loads `account_merge` operation amount from it's effects
+func (a *Adapter) LoadAccountMergeAmount(p *Payment) error {
+	if p.Type != "account_merge" {
+		return errors.New("Not `account_merge` operation")
+	}
+
+	page, err := a.Client.Effects(horizonclient.EffectRequest{ForOperation: p.ID})
+	if err != nil {
+		return errors.Wrap(convertError(err), "Error getting effects for operation")
+	}
+
+	for _, effect := range page.Embedded.Records {
+		if credited, ok := effect.(effects.AccountCredited); ok {
+			p.Amount = credited.Amount
+			return nil
+		}
+	}
+
+	return errors.New("Could not find `account_credited` effect in `account_merge` operation effects")
+}
+
+// LoadMemo loads memo for a transaction in Payment
+func (a *Adapter) LoadMemo(p *Payment) error {
+	transaction, err := a.Client.TransactionDetail(p.TransactionHash)
+	if err != nil {
+		return errors.Wrap(convertError(err), "load transaction failed")
+	}
+
+	p.Memo.Type = transaction.MemoType
+	p.Memo.Value = transaction.Memo
+	return nil
+}
+
+// LoadOperation loads a single operation from Horizon server
+func (a *Adapter) LoadOperation(operationID string) (payment Payment, err error) {
+	op, err := a.Client.OperationDetail(operationID)
+	if err != nil {
+		err = convertError(err)
+		return
+	}
+
+	return paymentFromOperation(op)
+}
+
+// LoadOrderBook loads order book for given selling and buying assets.
+func (a *Adapter) LoadOrderBook(
+	selling Asset,
+	buying Asset,
+	params ...interface{},
+) (orderBook OrderBookSummary, err error) {
+	request := horizonclient.OrderBookRequest{
+		SellingAssetType:   horizonclient.AssetType(selling.Type),
+		SellingAssetCode:   selling.Code,
+		SellingAssetIssuer: selling.Issuer,
+		BuyingAssetType:    horizonclient.AssetType(buying.Type),
+		BuyingAssetCode:    buying.Code,
+		BuyingAssetIssuer:  buying.Issuer,
+	}
+
+	for _, param := range params {
+		switch param := param.(type) {
+		case Limit:
+			request.Limit = uint(param)
+		default:
+			err = errors.Errorf("Undefined parameter (%T): %+v", param, param)
+			return
+		}
+	}
+
+	orderBook, err = a.Client.OrderBook(request)
+	err = convertError(err)
+	return
+}
+
+// LoadTransaction loads a single transaction from Horizon server
+func (a *Adapter) LoadTransaction(transactionID string) (Transaction, error) {
+	transaction, err := a.Client.TransactionDetail(transactionID)
+	return transaction, convertError(err)
+}
+
+// LoadAccountTransactions loads the transactions of an account from horizon.
+func (a *Adapter) LoadAccountTransactions(accountID string, params ...interface{}) (TransactionsPage, error) {
+	request := horizonclient.TransactionRequest{ForAccount: accountID}
+
+	for _, param := range params {
+		switch param := param.(type) {
+		case Limit:
+			request.Limit = uint(param)
+		case Order:
+			request.Order = horizonclient.Order(param)
+		case Cursor:
+			request.Cursor = string(param)
+		default:
+			return TransactionsPage{}, errors.Errorf("Undefined parameter (%T): %+v", param, param)
+		}
+	}
+
+	page, err := a.Client.Transactions(request)
+	if err != nil {
+		return TransactionsPage{}, errors.Wrap(convertError(err), "loading endpoint")
+	}
+
+	return TransactionsPage(page), nil
+}
+
+// SequenceForAccount implements build.SequenceProvider
+func (a *Adapter) SequenceForAccount(accountID string) (xdr.SequenceNumber, error) {
+	account, err := a.LoadAccount(accountID)
+	if err != nil {
+		return 0, errors.Wrap(err, "load account failed")
+	}
+
+	seq, err := strconv.ParseUint(account.Sequence, 10, 64)
+	if err != nil {
+		return 0, errors.Wrap(err, "parse sequence failed")
+	}
+
+	return xdr.SequenceNumber(seq), nil
+}
+
+// StreamLedgers streams incoming ledgers. Use context.WithCancel to stop streaming or
+// context.Background() if you want to stream indefinitely.
+func (a *Adapter) StreamLedgers(
+	ctx context.Context,
+	cursor *Cursor,
+	handler LedgerHandler,
+) error {
+	request := horizonclient.LedgerRequest{Cursor: streamCursor(cursor)}
+	err := a.Client.StreamLedgers(ctx, request, func(ledger hProtocol.Ledger) error {
+		handler(ledger)
+		return nil
+	})
+	return convertError(err)
+}
+
+// StreamPayments streams payments, for which the given `accountID` was either the sender or receiver.
+// Use context.WithCancel to stop streaming or context.Background() if you want to stream indefinitely.
+func (a *Adapter) StreamPayments(
+	ctx context.Context,
+	accountID string,
+	cursor *Cursor,
+	handler PaymentHandler,
+) error {
+	request := horizonclient.OperationRequest{ForAccount: accountID, Cursor: streamCursor(cursor)}
+	err := a.Client.StreamPayments(ctx, request, func(op operations.Operation) error {
+		payment, err := paymentFromOperation(op)
+		if err != nil {
+			return err
+		}
+		handler(payment)
+		return nil
+	})
+	return convertError(err)
+}
+
+// StreamTransactions streams incoming transactions. Use context.WithCancel to stop streaming or
+// context.Background() if you want to stream indefinitely.
+func (a *Adapter) StreamTransactions(
+	ctx context.Context,
+	accountID string,
+	cursor *Cursor,
+	handler TransactionHandler,
+) error {
+	request := horizonclient.TransactionRequest{ForAccount: accountID, Cursor: streamCursor(cursor)}
+	err := a.Client.StreamTransactions(ctx, request, func(transaction hProtocol.Transaction) error {
+		handler(transaction)
+		return nil
+	})
+	return convertError(err)
+}
+
+// SubmitTransaction submits a transaction to the network. err can be either error object or horizon.Error object.
+func (a *Adapter) SubmitTransaction(transactionEnvelopeXdr string) (TransactionSuccess, error) {
+	response, err := a.Client.SubmitTransaction(transactionEnvelopeXdr)
+	return response, convertError(err)
+}
+
+// paymentFromOperation converts an operation loaded by the new client to a
+// Payment. Payment holds a subset of the fields of the operation resources, so
+// the conversion goes through their JSON representation.
+func paymentFromOperation(op operations.Operation) (payment Payment, err error) {
+	data, err := json.Marshal(op)
+	if err != nil {
+		err = errors.Wrap(err, "Error marshaling operation")
+		return
+	}
+
+	err = json.Unmarshal(data, &payment)
+	if err != nil {
+		err = errors.Wrap(err, "Error unmarshaling data")
+	}
+	return
+}
+
+// convertError converts the errors returned by horizon to *Error. Other
+// errors are returned unchanged.
+func convertError(err error) error {
+	herr, ok := errors.Cause(err).(*horizonclient.Error)
+	if !ok {
+		return err
+	}
+
+	extras := make(map[string]json.RawMessage)
+	for key, value := range herr.Problem.Extras {
+		raw, marshalErr := json.Marshal(value)
+		if marshalErr != nil {
+			return errors.Wrap(marshalErr, "Error marshaling problem extras")
+		}
+		extras[key] = raw
+	}
+
+	return &Error{
+		Response: herr.Response,
+		Problem: Problem{
+			Type:     herr.Problem.Type,
+			Title:    herr.Problem.Title,
+			Status:   herr.Problem.Status,
+			Detail:   herr.Problem.Detail,
+			Instance: herr.Problem.Instance,
+			Extras:   extras,
+		},
+	}
+}
+
+// streamCursor returns the cursor a stream starts from. Without a cursor,
+// horizon streams from the oldest record while the new client streams from
+// now, so the oldest record is then requested explicitly.
+func streamCursor(cursor *Cursor) string {
+	if cursor == nil {
+		return "0"
+	}
+	return string(*cursor)
+}
+
+func millisToTime(millis int64) time.Time {
+	return time.Unix(0, millis*int64(time.Millisecond))
+}
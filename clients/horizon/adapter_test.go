@@ -0,0 +1,138 @@
+package horizon
+
+import (
+	"context"
+	"testing"
+
+	horizonclient "github.com/stellar/go/exp/clients/horizon"
+	"github.com/stellar/go/exp/clients/horizon/horizontest"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/protocols/horizon/base"
+	"github.com/stellar/go/protocols/horizon/effects"
+	"github.com/stellar/go/protocols/horizon/operations"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	adapterAlice = "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU"
+	adapterBob   = "GBNZN27NAOHRJRCMHQF2ZN2F6TAPVEWKJIGZIRNKIADWIS2HDENIS6CI"
+)
+
+func newTestAdapter() (*Adapter, *horizontest.Server) {
+	server := horizontest.NewServer()
+	return &Adapter{
+		Client: &horizonclient.Client{HorizonURL: server.URL, HTTP: server.Client()},
+	}, server
+}
+
+func TestAdapterLoadAccount(t *testing.T) {
+	adapter, server := newTestAdapter()
+	defer server.Close()
+
+	server.AddAccount(Account{
+		HistoryAccount: HistoryAccount{AccountID: adapterAlice},
+		Sequence:       "4294967297",
+		HomeDomain:     "stellar.org",
+	})
+
+	account, err := adapter.LoadAccount(adapterAlice)
+	require.NoError(t, err)
+	assert.Equal(t, adapterAlice, account.AccountID)
+
+	sequence, err := adapter.SequenceForAccount(adapterAlice)
+	require.NoError(t, err)
+	assert.Equal(t, xdr.SequenceNumber(4294967297), sequence)
+
+	domain, err := adapter.HomeDomainForAccount(adapterAlice)
+	require.NoError(t, err)
+	assert.Equal(t, "stellar.org", domain)
+
+	// Errors returned by horizon are converted
+	_, err = adapter.LoadAccount(adapterBob)
+	if assert.IsType(t, &Error{}, err) {
+		assert.Equal(t, 404, err.(*Error).Problem.Status)
+	}
+}
+
+func TestAdapterStreamPayments(t *testing.T) {
+	adapter, server := newTestAdapter()
+	defer server.Close()
+
+	server.AddTransaction(hProtocol.Transaction{
+		ID: "tx1", Hash: "tx1", PT: "4294971392", Ledger: 1, Account: adapterAlice,
+		MemoType: "text", Memo: "hello",
+	})
+	server.AddOperation(operations.Payment{
+		Base:   operations.Base{ID: "4294971393", PT: "4294971393", Type: "payment", TransactionHash: "tx1"},
+		Asset:  base.Asset{Type: "native"},
+		From:   adapterAlice,
+		To:     adapterBob,
+		Amount: "10.0000000",
+	}, adapterAlice, adapterBob)
+	server.AddOperation(operations.AccountMerge{
+		Base:    operations.Base{ID: "4294971394", PT: "4294971394", Type: "account_merge", TransactionHash: "tx1"},
+		Account: adapterAlice,
+		Into:    adapterBob,
+	}, adapterAlice, adapterBob)
+	server.AddEffect(effects.AccountCredited{
+		Base:   effects.Base{ID: "0004294971394-0000000001", PT: "4294971394-1", Account: adapterBob, Type: "account_credited"},
+		Asset:  base.Asset{Type: "native"},
+		Amount: "99.0000000",
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var payments []Payment
+	err := adapter.StreamPayments(ctx, adapterBob, nil, func(payment Payment) {
+		payments = append(payments, payment)
+		if len(payments) == 2 {
+			cancel()
+		}
+	})
+	require.NoError(t, err)
+	require.Len(t, payments, 2)
+
+	payment := payments[0]
+	assert.Equal(t, "payment", payment.Type)
+	assert.Equal(t, "4294971393", payment.PagingToken)
+	assert.Equal(t, adapterAlice, payment.From)
+	assert.Equal(t, adapterBob, payment.To)
+	assert.Equal(t, "native", payment.AssetType)
+	assert.Equal(t, "10.0000000", payment.Amount)
+
+	require.NoError(t, adapter.LoadMemo(&payment))
+	assert.Equal(t, "text", payment.Memo.Type)
+	assert.Equal(t, "hello", payment.Memo.Value)
+
+	merge := payments[1]
+	assert.Equal(t, adapterBob, merge.Into)
+	require.NoError(t, adapter.LoadAccountMergeAmount(&merge))
+	assert.Equal(t, "99.0000000", merge.Amount)
+	assert.Error(t, adapter.LoadAccountMergeAmount(&payment))
+
+	loaded, err := adapter.LoadOperation("4294971393")
+	require.NoError(t, err)
+	assert.Equal(t, payments[0], loaded)
+}
+
+func TestAdapterSubmitTransaction(t *testing.T) {
+	adapter, server := newTestAdapter()
+	defer server.Close()
+
+	server.SubmitHandler = func(transactionXDR string) (TransactionSuccess, error) {
+		return TransactionSuccess{}, horizontest.TransactionFailed(transactionXDR, "AAAAAAAAAGT////7AAAAAA==", "tx_bad_seq")
+	}
+
+	_, err := adapter.SubmitTransaction("AAAA")
+	if assert.IsType(t, &Error{}, err) {
+		herr := err.(*Error)
+		codes, codesErr := herr.ResultCodes()
+		require.NoError(t, codesErr)
+		assert.Equal(t, "tx_bad_seq", codes.TransactionCode)
+
+		result, resultErr := herr.ResultString()
+		require.NoError(t, resultErr)
+		assert.Equal(t, "AAAAAAAAAGT////7AAAAAA==", result)
+	}
+}
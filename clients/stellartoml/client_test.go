@@ -29,11 +29,13 @@ func TestClient(t *testing.T) {
 	h.
 		On("GET", "https://stellar.org/.well-known/stellar.toml").
 		ReturnString(http.StatusOK,
-			`FEDERATION_SERVER="https://localhost/federation"`,
+			`FEDERATION_SERVER="https://localhost/federation"
+URI_REQUEST_SIGNING_KEY="GAWSI2JO2CF36Z43UGMUJCDQ2IMR5B3P5TMS7XM7NUTU3JHG3YJUDQXA"`,
 		)
 	stoml, err := c.GetStellarToml("stellar.org")
 	require.NoError(t, err)
 	assert.Equal(t, "https://localhost/federation", stoml.FederationServer)
+	assert.Equal(t, "GAWSI2JO2CF36Z43UGMUJCDQ2IMR5B3P5TMS7XM7NUTU3JHG3YJUDQXA", stoml.URIRequestSigningKey)
 
 	// stellar.toml exceeds limit
 	h.
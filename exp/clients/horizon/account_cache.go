@@ -0,0 +1,92 @@
+package horizonclient
+
+import (
+	"sync"
+	"time"
+)
+
+// accountCache holds the responses of the accounts endpoints, by endpoint. Expired entries are
+// swept when a response is cached, at most once per AccountCacheTTL, so that the cache only holds
+// the responses cached during the last two TTLs.
+type accountCache struct {
+	mutex     sync.Mutex
+	entries   map[string]accountCacheEntry
+	nextSweep time.Time
+}
+
+type accountCacheEntry struct {
+	response interface{}
+	expires  time.Time
+}
+
+// cachedAccountResponse returns the cached response to request, if AccountCacheTTL is set and it
+// did not expire. The slices and maps of the response are shared with the cache and must not be
+// modified.
+func (c *Client) cachedAccountResponse(request AccountRequest) (interface{}, bool) {
+	if c.AccountCacheTTL <= 0 {
+		return nil, false
+	}
+
+	endpoint, err := request.BuildURL()
+	if err != nil {
+		return nil, false
+	}
+
+	c.accountCache.mutex.Lock()
+	defer c.accountCache.mutex.Unlock()
+
+	entry, ok := c.accountCache.entries[endpoint]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(c.accountCache.entries, endpoint)
+		return nil, false
+	}
+	return entry.response, true
+}
+
+// cacheAccountResponse caches the response to request for AccountCacheTTL.
+func (c *Client) cacheAccountResponse(request AccountRequest, response interface{}) {
+	if c.AccountCacheTTL <= 0 {
+		return
+	}
+
+	endpoint, err := request.BuildURL()
+	if err != nil {
+		return
+	}
+
+	c.accountCache.mutex.Lock()
+	defer c.accountCache.mutex.Unlock()
+
+	now := time.Now()
+	if c.accountCache.entries == nil {
+		c.accountCache.entries = map[string]accountCacheEntry{}
+	}
+	if now.After(c.accountCache.nextSweep) {
+		c.accountCache.sweep(now)
+		c.accountCache.nextSweep = now.Add(c.AccountCacheTTL)
+	}
+
+	c.accountCache.entries[endpoint] = accountCacheEntry{
+		response: response,
+		expires:  now.Add(c.AccountCacheTTL),
+	}
+}
+
+// sweep removes the entries expired at now. The cache mutex must be held.
+func (c *accountCache) sweep(now time.Time) {
+	for endpoint, entry := range c.entries {
+		if now.After(entry.expires) {
+			delete(c.entries, endpoint)
+		}
+	}
+}
+
+// ClearAccountCache removes all the responses cached by AccountDetail and AccountData.
+func (c *Client) ClearAccountCache() {
+	c.accountCache.mutex.Lock()
+	defer c.accountCache.mutex.Unlock()
+	c.accountCache.entries = nil
+}
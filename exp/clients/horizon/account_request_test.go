@@ -1,8 +1,13 @@
 package horizonclient
 
 import (
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/jarcoal/httpmock"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/http/httptest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -40,3 +45,121 @@ func TestAccountRequestBuildUrl(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, "accounts/GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU/data/test", endpoint)
 }
+
+func TestAccountSignersAndThresholds(t *testing.T) {
+	hmock := httptest.NewClient()
+	client := &Client{
+		HorizonURL: "https://localhost/",
+		HTTP:       hmock,
+	}
+	accountRequest := AccountRequest{AccountID: "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU"}
+
+	hmock.On(
+		"GET",
+		"https://localhost/accounts/GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU",
+	).ReturnString(200, accountResponse)
+
+	signers, err := client.AccountSigners(accountRequest)
+	if assert.NoError(t, err) && assert.Len(t, signers, 1) {
+		assert.Equal(t, "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU", signers[0].Key)
+		assert.Equal(t, int32(1), signers[0].Weight)
+	}
+
+	thresholds, err := client.AccountThresholds(accountRequest)
+	if assert.NoError(t, err) {
+		assert.Equal(t, hProtocol.AccountThresholds{}, thresholds)
+	}
+
+	_, err = client.AccountSigners(AccountRequest{})
+	assert.EqualError(t, err, "No account ID provided")
+}
+
+func TestAccountDataValue(t *testing.T) {
+	hmock := httptest.NewClient()
+	client := &Client{
+		HorizonURL: "https://localhost/",
+		HTTP:       hmock,
+	}
+
+	hmock.On(
+		"GET",
+		"https://localhost/accounts/GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU/data/test",
+	).ReturnString(200, `{"value": "dGVzdA=="}`)
+
+	value, err := client.AccountDataValue(AccountRequest{AccountID: "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU", DataKey: "test"})
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte("test"), value)
+	}
+
+	hmock.On(
+		"GET",
+		"https://localhost/accounts/GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU/data/invalid",
+	).ReturnString(200, `{"value": "!!"}`)
+
+	_, err = client.AccountDataValue(AccountRequest{AccountID: "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU", DataKey: "invalid"})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "failed to decode account data")
+	}
+}
+
+func TestAccountCache(t *testing.T) {
+	hmock := httptest.NewClient()
+	client := &Client{
+		HorizonURL:      "https://localhost/",
+		HTTP:            hmock,
+		AccountCacheTTL: time.Hour,
+	}
+	accountRequest := AccountRequest{AccountID: "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU"}
+
+	requests := 0
+	hmock.On(
+		"GET",
+		"https://localhost/accounts/GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU",
+	).Return(func(req *http.Request) (*http.Response, error) {
+		requests++
+		return httpmock.NewStringResponse(200, accountResponse), nil
+	})
+
+	for i := 0; i < 3; i++ {
+		account, err := client.AccountDetail(accountRequest)
+		require.NoError(t, err)
+		assert.Equal(t, "9865509814140929", account.Sequence)
+	}
+	_, err := client.AccountSigners(accountRequest)
+	require.NoError(t, err)
+	assert.Equal(t, 1, requests)
+
+	// Submitting a transaction clears the cache
+	hmock.On("POST", "https://localhost/transactions?tx=AAAA").ReturnString(200, `{}`)
+	_, err = client.SubmitTransaction("AAAA")
+	require.NoError(t, err)
+	_, err = client.AccountDetail(accountRequest)
+	require.NoError(t, err)
+	assert.Equal(t, 2, requests)
+
+	// Cached responses expire
+	client.AccountCacheTTL = time.Nanosecond
+	client.ClearAccountCache()
+	_, err = client.AccountDetail(accountRequest)
+	require.NoError(t, err)
+	time.Sleep(time.Millisecond)
+	_, err = client.AccountDetail(accountRequest)
+	require.NoError(t, err)
+	assert.Equal(t, 4, requests)
+}
+
+func TestAccountCacheSweep(t *testing.T) {
+	client := &Client{AccountCacheTTL: time.Millisecond}
+	accountID := "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU"
+
+	for _, key := range []string{"a", "b", "c"} {
+		client.cacheAccountResponse(AccountRequest{AccountID: accountID, DataKey: key}, []byte(key))
+	}
+	assert.Len(t, client.accountCache.entries, 3)
+
+	// Expired entries are removed when another response is cached, even if they are never
+	// looked up again
+	time.Sleep(2 * time.Millisecond)
+	client.cacheAccountResponse(AccountRequest{AccountID: accountID, DataKey: "d"}, []byte("d"))
+	assert.Len(t, client.accountCache.entries, 1)
+}
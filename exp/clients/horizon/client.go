@@ -1,25 +1,20 @@
 package horizonclient
 
 import (
-	"bufio"
-	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
-	"io"
 	"net/http"
-	"net/url"
 	"strings"
 	"time"
 
-	"github.com/manucorporat/sse"
 	hProtocol "github.com/stellar/go/protocols/horizon"
 	"github.com/stellar/go/protocols/horizon/operations"
 	"github.com/stellar/go/support/app"
 	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/render/hal"
 )
 
-func (c *Client) sendRequest(hr HorizonRequest, a interface{}) (err error) {
+func (c *Client) sendRequest(ctx context.Context, hr HorizonRequest, a interface{}) (err error) {
 	endpoint, err := hr.BuildURL()
 	if err != nil {
 		return
@@ -39,148 +34,67 @@ func (c *Client) sendRequest(hr HorizonRequest, a interface{}) (err error) {
 	if err != nil {
 		return errors.Wrap(err, "Error creating HTTP request")
 	}
-	c.setClientAppHeaders(req)
 
-	if c.horizonTimeOut == 0 {
-		c.horizonTimeOut = HorizonTimeOut
-	}
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*c.horizonTimeOut)
+	return c.sendHTTPRequest(ctx, req, a)
+}
 
-	resp, err := c.HTTP.Do(req.WithContext(ctx))
+// sendGetRequest sends a GET request to requestURL, which is usually a link found in a
+// previous response, and decodes the response into a.
+func (c *Client) sendGetRequest(ctx context.Context, requestURL string, a interface{}) error {
+	req, err := http.NewRequest("GET", requestURL, nil)
 	if err != nil {
-		cancel()
-		return
+		return errors.Wrap(err, "Error creating HTTP request")
 	}
 
-	err = decodeResponse(resp, &a)
-	cancel()
-	return
+	return c.sendHTTPRequest(ctx, req, a)
 }
 
-// stream handles connections to endpoints that support streaming on an horizon server
-func (c *Client) stream(
-	ctx context.Context,
-	streamURL string,
-	handler func(data []byte) error,
-) error {
-	su, err := url.Parse(streamURL)
-	if err != nil {
-		return errors.Wrap(err, "Error parsing stream url")
+// sendPageRequest loads the page that link points to into page.
+func (c *Client) sendPageRequest(ctx context.Context, link hal.Link, page interface{}) error {
+	if link.Href == "" {
+		return errors.New("No page link provided")
 	}
 
-	query := su.Query()
-	if query.Get("cursor") == "" {
-		query.Set("cursor", "now")
+	return c.sendGetRequest(ctx, link.Href, page)
+}
+
+// sendHTTPRequest sends req with ctx and decodes the response into a. Each attempt is cancelled
+// after the horizon timeout, or when ctx is done. Requests rejected because of the rate limit, or
+// because horizon is unavailable, are sent again up to MaxRetries times.
+func (c *Client) sendHTTPRequest(ctx context.Context, req *http.Request, a interface{}) (err error) {
+	c.setClientAppHeaders(req)
+
+	if c.horizonTimeOut == 0 {
+		c.horizonTimeOut = HorizonTimeOut
 	}
 
-	for {
-		// updates the url with new cursor
-		su.RawQuery = query.Encode()
-		req, err := http.NewRequest("GET", fmt.Sprintf("%s", su), nil)
-		if err != nil {
-			return errors.Wrap(err, "Error creating HTTP request")
+	for attempt := 0; ; attempt++ {
+		if err := c.reserveRequest(ctx); err != nil {
+			return err
 		}
-		req.Header.Set("Accept", "text/event-stream")
-		// to do: confirm name and version
-		c.setClientAppHeaders(req)
+		attemptCtx, cancel := context.WithTimeout(ctx, time.Second*c.horizonTimeOut)
 
-		// We can use c.HTTP here because we set Timeout per request not on the client. See sendRequest()
-		resp, err := c.HTTP.Do(req)
+		resp, err := c.HTTP.Do(req.WithContext(attemptCtx))
 		if err != nil {
-			return errors.Wrap(err, "Error sending HTTP request")
+			cancel()
+			return err
 		}
-
-		// Expected statusCode are 200-299
-		if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
-			return fmt.Errorf("Got bad HTTP status code %d", resp.StatusCode)
-		}
-		defer resp.Body.Close()
-
-		reader := bufio.NewReader(resp.Body)
-
-		// Read events one by one. Break this loop when there is no more data to be
-		// read from resp.Body (io.EOF).
-	Events:
-		for {
-			// Read until empty line = event delimiter. The perfect solution would be to read
-			// as many bytes as possible and forward them to sse.Decode. However this
-			// requires much more complicated code.
-			// We could also write our own `sse` package that works fine with streams directly
-			// (github.com/manucorporat/sse is just using io/ioutils.ReadAll).
-			var buffer bytes.Buffer
-			nonEmptylinesRead := 0
-			for {
-				// Check if ctx is not cancelled
-				select {
-				case <-ctx.Done():
-					return nil
-				default:
-					// Continue
-				}
-
-				line, err := reader.ReadString('\n')
-				if err != nil {
-					if err == io.EOF || err == io.ErrUnexpectedEOF {
-						// We catch EOF errors to handle two possible situations:
-						// - The last line before closing the stream was not empty. This should never
-						//   happen in Horizon as it always sends an empty line after each event.
-						// - The stream was closed by the server/proxy because the connection was idle.
-						//
-						// In the former case, that (again) should never happen in Horizon, we need to
-						// check if there are any events we need to decode. We do this in the `if`
-						// statement below just in case if Horizon behaviour changes in a future.
-						//
-						// From spec:
-						// > Once the end of the file is reached, the user agent must dispatch the
-						// > event one final time, as defined below.
-						if nonEmptylinesRead == 0 {
-							break Events
-						}
-					} else {
-						return errors.Wrap(err, "Error reading line")
-					}
-				}
-				buffer.WriteString(line)
-
-				if strings.TrimRight(line, "\n\r") == "" {
-					break
-				}
-
-				nonEmptylinesRead++
-			}
-
-			events, err := sse.Decode(strings.NewReader(buffer.String()))
-			if err != nil {
-				return errors.Wrap(err, "Error decoding event")
-			}
-
-			// Right now len(events) should always be 1. This loop will be helpful after writing
-			// new SSE decoder that can handle io.Reader without using ioutils.ReadAll().
-			for _, event := range events {
-				if event.Event != "message" {
-					continue
-				}
-
-				// Update cursor with event ID
-				if event.Id != "" {
-					query.Set("cursor", event.Id)
-				}
-
-				switch data := event.Data.(type) {
-				case string:
-					err = handler([]byte(data))
-					err = errors.Wrap(err, "Handler error")
-				case []byte:
-					err = handler(data)
-					err = errors.Wrap(err, "Handler error")
-				default:
-					err = errors.New("Invalid event.Data type")
-				}
-				if err != nil {
-					return err
-				}
+		c.updateRateLimit(resp)
+
+		if c.shouldRetry(resp, attempt) {
+			resp.Body.Close()
+			cancel()
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(retryDelay(resp, attempt)):
 			}
+			continue
 		}
+
+		err = decodeResponse(resp, &a)
+		cancel()
+		return err
 	}
 }
 
@@ -210,6 +124,11 @@ func (c *Client) GetHorizonTimeOut() time.Duration {
 // AccountDetail returns information for a single account.
 // See https://www.stellar.org/developers/horizon/reference/endpoints/accounts-single.html
 func (c *Client) AccountDetail(request AccountRequest) (account hProtocol.Account, err error) {
+	return c.AccountDetailContext(context.Background(), request)
+}
+
+// AccountDetailContext is AccountDetail with a context, which is passed to the HTTP request.
+func (c *Client) AccountDetailContext(ctx context.Context, request AccountRequest) (account hProtocol.Account, err error) {
 	if request.AccountID == "" {
 		err = errors.New("No account ID provided")
 	}
@@ -218,13 +137,57 @@ func (c *Client) AccountDetail(request AccountRequest) (account hProtocol.Accoun
 		return
 	}
 
-	err = c.sendRequest(request, &account)
+	if cached, ok := c.cachedAccountResponse(request); ok {
+		return cached.(hProtocol.Account), nil
+	}
+
+	err = c.sendRequest(ctx, request, &account)
+	if err == nil {
+		c.cacheAccountResponse(request, account)
+	}
 	return
 }
 
+// AccountSigners returns the signers of an account.
+// See https://www.stellar.org/developers/guides/concepts/multi-sig.html
+func (c *Client) AccountSigners(request AccountRequest) (signers []hProtocol.Signer, err error) {
+	return c.AccountSignersContext(context.Background(), request)
+}
+
+// AccountSignersContext is AccountSigners with a context, which is passed to the HTTP request.
+func (c *Client) AccountSignersContext(ctx context.Context, request AccountRequest) (signers []hProtocol.Signer, err error) {
+	account, err := c.AccountDetailContext(ctx, AccountRequest{AccountID: request.AccountID})
+	if err != nil {
+		return
+	}
+
+	return account.Signers, nil
+}
+
+// AccountThresholds returns the thresholds of an account.
+// See https://www.stellar.org/developers/guides/concepts/multi-sig.html
+func (c *Client) AccountThresholds(request AccountRequest) (thresholds hProtocol.AccountThresholds, err error) {
+	return c.AccountThresholdsContext(context.Background(), request)
+}
+
+// AccountThresholdsContext is AccountThresholds with a context, which is passed to the HTTP request.
+func (c *Client) AccountThresholdsContext(ctx context.Context, request AccountRequest) (thresholds hProtocol.AccountThresholds, err error) {
+	account, err := c.AccountDetailContext(ctx, AccountRequest{AccountID: request.AccountID})
+	if err != nil {
+		return
+	}
+
+	return account.Thresholds, nil
+}
+
 // AccountData returns a single data associated with a given account
 // See https://www.stellar.org/developers/horizon/reference/endpoints/data-for-account.html
 func (c *Client) AccountData(request AccountRequest) (accountData hProtocol.AccountData, err error) {
+	return c.AccountDataContext(context.Background(), request)
+}
+
+// AccountDataContext is AccountData with a context, which is passed to the HTTP request.
+func (c *Client) AccountDataContext(ctx context.Context, request AccountRequest) (accountData hProtocol.AccountData, err error) {
 	if request.AccountID == "" || request.DataKey == "" {
 		err = errors.New("Too few parameters")
 	}
@@ -233,21 +196,100 @@ func (c *Client) AccountData(request AccountRequest) (accountData hProtocol.Acco
 		return
 	}
 
-	err = c.sendRequest(request, &accountData)
+	if cached, ok := c.cachedAccountResponse(request); ok {
+		return cached.(hProtocol.AccountData), nil
+	}
+
+	err = c.sendRequest(ctx, request, &accountData)
+	if err == nil {
+		c.cacheAccountResponse(request, accountData)
+	}
+	return
+}
+
+// AccountDataValue returns the decoded value of a single data associated with a given account.
+// See https://www.stellar.org/developers/horizon/reference/endpoints/data-for-account.html
+func (c *Client) AccountDataValue(request AccountRequest) (value []byte, err error) {
+	return c.AccountDataValueContext(context.Background(), request)
+}
+
+// AccountDataValueContext is AccountDataValue with a context, which is passed to the HTTP request.
+func (c *Client) AccountDataValueContext(ctx context.Context, request AccountRequest) (value []byte, err error) {
+	accountData, err := c.AccountDataContext(ctx, request)
+	if err != nil {
+		return
+	}
+
+	value, err = accountData.Decode()
+	err = errors.Wrap(err, "failed to decode account data")
 	return
 }
 
 // Effects returns effects(https://www.stellar.org/developers/horizon/reference/resources/effect.html)
 // It can be used to return effects for an account, a ledger, an operation, a transaction and all effects on the network.
 func (c *Client) Effects(request EffectRequest) (effects hProtocol.EffectsPage, err error) {
-	err = c.sendRequest(request, &effects)
+	return c.EffectsContext(context.Background(), request)
+}
+
+// EffectsContext is Effects with a context, which is passed to the HTTP request.
+func (c *Client) EffectsContext(ctx context.Context, request EffectRequest) (effects hProtocol.EffectsPage, err error) {
+	err = c.sendRequest(ctx, request, &effects)
+	return
+}
+
+// NextEffectsPage returns the next page of effects.
+func (c *Client) NextEffectsPage(page hProtocol.EffectsPage) (effects hProtocol.EffectsPage, err error) {
+	return c.NextEffectsPageContext(context.Background(), page)
+}
+
+// NextEffectsPageContext is NextEffectsPage with a context, which is passed to the HTTP request.
+func (c *Client) NextEffectsPageContext(ctx context.Context, page hProtocol.EffectsPage) (effects hProtocol.EffectsPage, err error) {
+	err = c.sendPageRequest(ctx, page.Links.Next, &effects)
+	return
+}
+
+// PrevEffectsPage returns the previous page of effects.
+func (c *Client) PrevEffectsPage(page hProtocol.EffectsPage) (effects hProtocol.EffectsPage, err error) {
+	return c.PrevEffectsPageContext(context.Background(), page)
+}
+
+// PrevEffectsPageContext is PrevEffectsPage with a context, which is passed to the HTTP request.
+func (c *Client) PrevEffectsPageContext(ctx context.Context, page hProtocol.EffectsPage) (effects hProtocol.EffectsPage, err error) {
+	err = c.sendPageRequest(ctx, page.Links.Prev, &effects)
 	return
 }
 
 // Assets returns asset information.
 // See https://www.stellar.org/developers/horizon/reference/endpoints/assets-all.html
 func (c *Client) Assets(request AssetRequest) (assets hProtocol.AssetsPage, err error) {
-	err = c.sendRequest(request, &assets)
+	return c.AssetsContext(context.Background(), request)
+}
+
+// AssetsContext is Assets with a context, which is passed to the HTTP request.
+func (c *Client) AssetsContext(ctx context.Context, request AssetRequest) (assets hProtocol.AssetsPage, err error) {
+	err = c.sendRequest(ctx, request, &assets)
+	return
+}
+
+// NextAssetsPage returns the next page of assets.
+func (c *Client) NextAssetsPage(page hProtocol.AssetsPage) (assets hProtocol.AssetsPage, err error) {
+	return c.NextAssetsPageContext(context.Background(), page)
+}
+
+// NextAssetsPageContext is NextAssetsPage with a context, which is passed to the HTTP request.
+func (c *Client) NextAssetsPageContext(ctx context.Context, page hProtocol.AssetsPage) (assets hProtocol.AssetsPage, err error) {
+	err = c.sendPageRequest(ctx, page.Links.Next, &assets)
+	return
+}
+
+// PrevAssetsPage returns the previous page of assets.
+func (c *Client) PrevAssetsPage(page hProtocol.AssetsPage) (assets hProtocol.AssetsPage, err error) {
+	return c.PrevAssetsPageContext(context.Background(), page)
+}
+
+// PrevAssetsPageContext is PrevAssetsPage with a context, which is passed to the HTTP request.
+func (c *Client) PrevAssetsPageContext(ctx context.Context, page hProtocol.AssetsPage) (assets hProtocol.AssetsPage, err error) {
+	err = c.sendPageRequest(ctx, page.Links.Prev, &assets)
 	return
 }
 
@@ -261,13 +303,45 @@ func (c *Client) Stream(ctx context.Context, request StreamRequest, handler func
 // Ledgers returns information about all ledgers.
 // See https://www.stellar.org/developers/horizon/reference/endpoints/ledgers-all.html
 func (c *Client) Ledgers(request LedgerRequest) (ledgers hProtocol.LedgersPage, err error) {
-	err = c.sendRequest(request, &ledgers)
+	return c.LedgersContext(context.Background(), request)
+}
+
+// LedgersContext is Ledgers with a context, which is passed to the HTTP request.
+func (c *Client) LedgersContext(ctx context.Context, request LedgerRequest) (ledgers hProtocol.LedgersPage, err error) {
+	err = c.sendRequest(ctx, request, &ledgers)
+	return
+}
+
+// NextLedgersPage returns the next page of ledgers.
+func (c *Client) NextLedgersPage(page hProtocol.LedgersPage) (ledgers hProtocol.LedgersPage, err error) {
+	return c.NextLedgersPageContext(context.Background(), page)
+}
+
+// NextLedgersPageContext is NextLedgersPage with a context, which is passed to the HTTP request.
+func (c *Client) NextLedgersPageContext(ctx context.Context, page hProtocol.LedgersPage) (ledgers hProtocol.LedgersPage, err error) {
+	err = c.sendPageRequest(ctx, page.Links.Next, &ledgers)
+	return
+}
+
+// PrevLedgersPage returns the previous page of ledgers.
+func (c *Client) PrevLedgersPage(page hProtocol.LedgersPage) (ledgers hProtocol.LedgersPage, err error) {
+	return c.PrevLedgersPageContext(context.Background(), page)
+}
+
+// PrevLedgersPageContext is PrevLedgersPage with a context, which is passed to the HTTP request.
+func (c *Client) PrevLedgersPageContext(ctx context.Context, page hProtocol.LedgersPage) (ledgers hProtocol.LedgersPage, err error) {
+	err = c.sendPageRequest(ctx, page.Links.Prev, &ledgers)
 	return
 }
 
 // LedgerDetail returns information about a particular ledger for a given sequence number
 // See https://www.stellar.org/developers/horizon/reference/endpoints/ledgers-single.html
 func (c *Client) LedgerDetail(sequence uint32) (ledger hProtocol.Ledger, err error) {
+	return c.LedgerDetailContext(context.Background(), sequence)
+}
+
+// LedgerDetailContext is LedgerDetail with a context, which is passed to the HTTP request.
+func (c *Client) LedgerDetailContext(ctx context.Context, sequence uint32) (ledger hProtocol.Ledger, err error) {
 	if sequence <= 0 {
 		err = errors.New("Invalid sequence number provided")
 	}
@@ -278,43 +352,143 @@ func (c *Client) LedgerDetail(sequence uint32) (ledger hProtocol.Ledger, err err
 
 	request := LedgerRequest{forSequence: sequence}
 
-	err = c.sendRequest(request, &ledger)
+	err = c.sendRequest(ctx, request, &ledger)
 	return
 }
 
 // Metrics returns monitoring information about a horizon server
 // See https://www.stellar.org/developers/horizon/reference/endpoints/metrics.html
 func (c *Client) Metrics() (metrics hProtocol.Metrics, err error) {
+	return c.MetricsContext(context.Background())
+}
+
+// MetricsContext is Metrics with a context, which is passed to the HTTP request.
+func (c *Client) MetricsContext(ctx context.Context) (metrics hProtocol.Metrics, err error) {
 	request := metricsRequest{endpoint: "metrics"}
-	err = c.sendRequest(request, &metrics)
+	err = c.sendRequest(ctx, request, &metrics)
+	return
+}
+
+// Root returns information about the horizon server and the stellar-core instance it is
+// connected to, such as their latest ledgers.
+// See https://www.stellar.org/developers/horizon/reference/endpoints/root.html
+func (c *Client) Root() (root hProtocol.Root, err error) {
+	return c.RootContext(context.Background())
+}
+
+// RootContext is Root with a context, which is passed to the HTTP request.
+func (c *Client) RootContext(ctx context.Context) (root hProtocol.Root, err error) {
+	request := rootRequest{}
+	err = c.sendRequest(ctx, request, &root)
 	return
 }
 
 // FeeStats returns information about fees in the last 5 ledgers.
 // See https://www.stellar.org/developers/horizon/reference/endpoints/fee-stats.html
 func (c *Client) FeeStats() (feestats hProtocol.FeeStats, err error) {
+	return c.FeeStatsContext(context.Background())
+}
+
+// FeeStatsContext is FeeStats with a context, which is passed to the HTTP request.
+func (c *Client) FeeStatsContext(ctx context.Context) (feestats hProtocol.FeeStats, err error) {
 	request := feeStatsRequest{endpoint: "fee_stats"}
-	err = c.sendRequest(request, &feestats)
+	err = c.sendRequest(ctx, request, &feestats)
 	return
 }
 
 // Offers returns information about offers made on the SDEX.
 // See https://www.stellar.org/developers/horizon/reference/endpoints/offers-for-account.html
 func (c *Client) Offers(request OfferRequest) (offers hProtocol.OffersPage, err error) {
-	err = c.sendRequest(request, &offers)
+	return c.OffersContext(context.Background(), request)
+}
+
+// OffersContext is Offers with a context, which is passed to the HTTP request.
+func (c *Client) OffersContext(ctx context.Context, request OfferRequest) (offers hProtocol.OffersPage, err error) {
+	err = c.sendRequest(ctx, request, &offers)
+	return
+}
+
+// NextOffersPage returns the next page of offers.
+func (c *Client) NextOffersPage(page hProtocol.OffersPage) (offers hProtocol.OffersPage, err error) {
+	return c.NextOffersPageContext(context.Background(), page)
+}
+
+// NextOffersPageContext is NextOffersPage with a context, which is passed to the HTTP request.
+func (c *Client) NextOffersPageContext(ctx context.Context, page hProtocol.OffersPage) (offers hProtocol.OffersPage, err error) {
+	err = c.sendPageRequest(ctx, page.Links.Next, &offers)
+	return
+}
+
+// PrevOffersPage returns the previous page of offers.
+func (c *Client) PrevOffersPage(page hProtocol.OffersPage) (offers hProtocol.OffersPage, err error) {
+	return c.PrevOffersPageContext(context.Background(), page)
+}
+
+// PrevOffersPageContext is PrevOffersPage with a context, which is passed to the HTTP request.
+func (c *Client) PrevOffersPageContext(ctx context.Context, page hProtocol.OffersPage) (offers hProtocol.OffersPage, err error) {
+	err = c.sendPageRequest(ctx, page.Links.Prev, &offers)
+	return
+}
+
+// OfferDetail returns information about a single offer for a given offer ID.
+// See https://www.stellar.org/developers/horizon/reference/resources/offer.html
+func (c *Client) OfferDetail(offerID string) (offer hProtocol.Offer, err error) {
+	return c.OfferDetailContext(context.Background(), offerID)
+}
+
+// OfferDetailContext is OfferDetail with a context, which is passed to the HTTP request.
+func (c *Client) OfferDetailContext(ctx context.Context, offerID string) (offer hProtocol.Offer, err error) {
+	if offerID == "" {
+		return offer, errors.New("No offer ID provided")
+	}
+
+	request := OfferRequest{forOfferID: offerID}
+	err = c.sendRequest(ctx, request, &offer)
 	return
 }
 
 // Operations returns stellar operations (https://www.stellar.org/developers/horizon/reference/resources/operation.html)
 // It can be used to return operations for an account, a ledger, a transaction and all operations on the network.
 func (c *Client) Operations(request OperationRequest) (ops operations.OperationsPage, err error) {
-	err = c.sendRequest(request.SetOperationsEndpoint(), &ops)
+	return c.OperationsContext(context.Background(), request)
+}
+
+// OperationsContext is Operations with a context, which is passed to the HTTP request.
+func (c *Client) OperationsContext(ctx context.Context, request OperationRequest) (ops operations.OperationsPage, err error) {
+	err = c.sendRequest(ctx, request.SetOperationsEndpoint(), &ops)
+	return
+}
+
+// NextOperationsPage returns the next page of operations, including pages of payments.
+func (c *Client) NextOperationsPage(page operations.OperationsPage) (ops operations.OperationsPage, err error) {
+	return c.NextOperationsPageContext(context.Background(), page)
+}
+
+// NextOperationsPageContext is NextOperationsPage with a context, which is passed to the HTTP request.
+func (c *Client) NextOperationsPageContext(ctx context.Context, page operations.OperationsPage) (ops operations.OperationsPage, err error) {
+	err = c.sendPageRequest(ctx, page.Links.Next, &ops)
+	return
+}
+
+// PrevOperationsPage returns the previous page of operations, including pages of payments.
+func (c *Client) PrevOperationsPage(page operations.OperationsPage) (ops operations.OperationsPage, err error) {
+	return c.PrevOperationsPageContext(context.Background(), page)
+}
+
+// PrevOperationsPageContext is PrevOperationsPage with a context, which is passed to the HTTP request.
+func (c *Client) PrevOperationsPageContext(ctx context.Context, page operations.OperationsPage) (ops operations.OperationsPage, err error) {
+	err = c.sendPageRequest(ctx, page.Links.Prev, &ops)
 	return
 }
 
 // OperationDetail returns a single stellar operations (https://www.stellar.org/developers/horizon/reference/resources/operation.html)
 // for a given operation id
 func (c *Client) OperationDetail(id string) (ops operations.Operation, err error) {
+	return c.OperationDetailContext(context.Background(), id)
+}
+
+// OperationDetailContext is OperationDetail with a context, which is passed to the HTTP request.
+func (c *Client) OperationDetailContext(ctx context.Context, id string) (ops operations.Operation, err error) {
 	if id == "" {
 		return ops, errors.New("Invalid operation id provided")
 	}
@@ -323,7 +497,7 @@ func (c *Client) OperationDetail(id string) (ops operations.Operation, err error
 
 	var record interface{}
 
-	err = c.sendRequest(request, &record)
+	err = c.sendRequest(ctx, request, &record)
 	if err != nil {
 		return ops, errors.Wrap(err, "Sending request to horizon")
 	}
@@ -343,56 +517,135 @@ func (c *Client) OperationDetail(id string) (ops operations.Operation, err error
 
 // SubmitTransaction submits a transaction to the network. err can be either error object or horizon.Error object.
 // See https://www.stellar.org/developers/horizon/reference/endpoints/transactions-create.html
-func (c *Client) SubmitTransaction(transactionXdr string) (txSuccess hProtocol.TransactionSuccess,
-	err error) {
+func (c *Client) SubmitTransaction(transactionXdr string) (txSuccess hProtocol.TransactionSuccess, err error) {
+	return c.SubmitTransactionContext(context.Background(), transactionXdr)
+}
+
+// SubmitTransactionContext is SubmitTransaction with a context, which is passed to the HTTP request.
+func (c *Client) SubmitTransactionContext(ctx context.Context, transactionXdr string) (txSuccess hProtocol.TransactionSuccess, err error) {
 	request := submitRequest{endpoint: "transactions", transactionXdr: transactionXdr}
-	err = c.sendRequest(request, &txSuccess)
+	err = c.sendRequest(ctx, request, &txSuccess)
+	// The transaction may have changed any of the cached accounts
+	c.ClearAccountCache()
 	return
-
 }
 
 // Transactions returns stellar transactions (https://www.stellar.org/developers/horizon/reference/resources/transaction.html)
 // It can be used to return transactions for an account, a ledger,and all transactions on the network.
 func (c *Client) Transactions(request TransactionRequest) (txs hProtocol.TransactionsPage, err error) {
-	err = c.sendRequest(request, &txs)
+	return c.TransactionsContext(context.Background(), request)
+}
+
+// TransactionsContext is Transactions with a context, which is passed to the HTTP request.
+func (c *Client) TransactionsContext(ctx context.Context, request TransactionRequest) (txs hProtocol.TransactionsPage, err error) {
+	err = c.sendRequest(ctx, request, &txs)
+	return
+}
+
+// NextTransactionsPage returns the next page of transactions.
+func (c *Client) NextTransactionsPage(page hProtocol.TransactionsPage) (txs hProtocol.TransactionsPage, err error) {
+	return c.NextTransactionsPageContext(context.Background(), page)
+}
+
+// NextTransactionsPageContext is NextTransactionsPage with a context, which is passed to the HTTP request.
+func (c *Client) NextTransactionsPageContext(ctx context.Context, page hProtocol.TransactionsPage) (txs hProtocol.TransactionsPage, err error) {
+	err = c.sendPageRequest(ctx, page.Links.Next, &txs)
+	return
+}
+
+// PrevTransactionsPage returns the previous page of transactions.
+func (c *Client) PrevTransactionsPage(page hProtocol.TransactionsPage) (txs hProtocol.TransactionsPage, err error) {
+	return c.PrevTransactionsPageContext(context.Background(), page)
+}
+
+// PrevTransactionsPageContext is PrevTransactionsPage with a context, which is passed to the HTTP request.
+func (c *Client) PrevTransactionsPageContext(ctx context.Context, page hProtocol.TransactionsPage) (txs hProtocol.TransactionsPage, err error) {
+	err = c.sendPageRequest(ctx, page.Links.Prev, &txs)
 	return
 }
 
 // TransactionDetail returns information about a particular transaction for a given transaction hash
 // See https://www.stellar.org/developers/horizon/reference/endpoints/transactions-single.html
 func (c *Client) TransactionDetail(txHash string) (tx hProtocol.Transaction, err error) {
+	return c.TransactionDetailContext(context.Background(), txHash)
+}
+
+// TransactionDetailContext is TransactionDetail with a context, which is passed to the HTTP request.
+func (c *Client) TransactionDetailContext(ctx context.Context, txHash string) (tx hProtocol.Transaction, err error) {
 	if txHash == "" {
 		return tx, errors.New("No transaction hash provided")
 	}
 
 	request := TransactionRequest{forTransactionHash: txHash}
-	err = c.sendRequest(request, &tx)
+	err = c.sendRequest(ctx, request, &tx)
 	return
 }
 
 // OrderBook returns the orderbook for an asset pair (https://www.stellar.org/developers/horizon/reference/resources/orderbook.html)
 func (c *Client) OrderBook(request OrderBookRequest) (obs hProtocol.OrderBookSummary, err error) {
-	err = c.sendRequest(request, &obs)
+	return c.OrderBookContext(context.Background(), request)
+}
+
+// OrderBookContext is OrderBook with a context, which is passed to the HTTP request.
+func (c *Client) OrderBookContext(ctx context.Context, request OrderBookRequest) (obs hProtocol.OrderBookSummary, err error) {
+	err = c.sendRequest(ctx, request, &obs)
 	return
 }
 
 // Paths returns the available paths to make a payment. See https://www.stellar.org/developers/horizon/reference/endpoints/path-finding.html
 func (c *Client) Paths(request PathsRequest) (paths hProtocol.PathsPage, err error) {
-	err = c.sendRequest(request, &paths)
+	return c.PathsContext(context.Background(), request)
+}
+
+// PathsContext is Paths with a context, which is passed to the HTTP request.
+func (c *Client) PathsContext(ctx context.Context, request PathsRequest) (paths hProtocol.PathsPage, err error) {
+	err = c.sendRequest(ctx, request, &paths)
 	return
 }
 
 // Payments returns stellar account_merge, create_account, path payment and payment operations.
 // It can be used to return payments for an account, a ledger, a transaction and all payments on the network.
 func (c *Client) Payments(request OperationRequest) (ops operations.OperationsPage, err error) {
-	err = c.sendRequest(request.SetPaymentsEndpoint(), &ops)
+	return c.PaymentsContext(context.Background(), request)
+}
+
+// PaymentsContext is Payments with a context, which is passed to the HTTP request.
+func (c *Client) PaymentsContext(ctx context.Context, request OperationRequest) (ops operations.OperationsPage, err error) {
+	err = c.sendRequest(ctx, request.SetPaymentsEndpoint(), &ops)
 	return
 }
 
 // Trades returns stellar trades (https://www.stellar.org/developers/horizon/reference/resources/trade.html)
 // It can be used to return trades for an account, an offer and all trades on the network.
 func (c *Client) Trades(request TradeRequest) (tds hProtocol.TradesPage, err error) {
-	err = c.sendRequest(request, &tds)
+	return c.TradesContext(context.Background(), request)
+}
+
+// TradesContext is Trades with a context, which is passed to the HTTP request.
+func (c *Client) TradesContext(ctx context.Context, request TradeRequest) (tds hProtocol.TradesPage, err error) {
+	err = c.sendRequest(ctx, request, &tds)
+	return
+}
+
+// NextTradesPage returns the next page of trades.
+func (c *Client) NextTradesPage(page hProtocol.TradesPage) (tds hProtocol.TradesPage, err error) {
+	return c.NextTradesPageContext(context.Background(), page)
+}
+
+// NextTradesPageContext is NextTradesPage with a context, which is passed to the HTTP request.
+func (c *Client) NextTradesPageContext(ctx context.Context, page hProtocol.TradesPage) (tds hProtocol.TradesPage, err error) {
+	err = c.sendPageRequest(ctx, page.Links.Next, &tds)
+	return
+}
+
+// PrevTradesPage returns the previous page of trades.
+func (c *Client) PrevTradesPage(page hProtocol.TradesPage) (tds hProtocol.TradesPage, err error) {
+	return c.PrevTradesPageContext(context.Background(), page)
+}
+
+// PrevTradesPageContext is PrevTradesPage with a context, which is passed to the HTTP request.
+func (c *Client) PrevTradesPageContext(ctx context.Context, page hProtocol.TradesPage) (tds hProtocol.TradesPage, err error) {
+	err = c.sendPageRequest(ctx, page.Links.Prev, &tds)
 	return
 }
 
@@ -406,7 +659,12 @@ func (c *Client) StreamTrades(ctx context.Context, request TradeRequest, handler
 
 // TradeAggregations returns stellar trade aggregations (https://www.stellar.org/developers/horizon/reference/resources/trade_aggregation.html)
 func (c *Client) TradeAggregations(request TradeAggregationRequest) (tds hProtocol.TradeAggregationsPage, err error) {
-	err = c.sendRequest(request, &tds)
+	return c.TradeAggregationsContext(context.Background(), request)
+}
+
+// TradeAggregationsContext is TradeAggregations with a context, which is passed to the HTTP request.
+func (c *Client) TradeAggregationsContext(ctx context.Context, request TradeAggregationRequest) (tds hProtocol.TradeAggregationsPage, err error) {
+	err = c.sendRequest(ctx, request, &tds)
 	return
 }
 
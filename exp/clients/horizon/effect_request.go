@@ -10,8 +10,9 @@ import (
 	"github.com/stellar/go/support/errors"
 )
 
-// EffectHandler is a function that is called when a new effect is received
-type EffectHandler func(effects.Base)
+// EffectHandler is a function that is called when a new effect is received.
+// Returning an error stops the stream, and the error is returned by the Stream method.
+type EffectHandler func(effects.Effect) error
 
 // BuildURL creates the endpoint to be queried based on the data in the EffectRequest struct.
 // If no data is set, it defaults to the build the URL for all effects
@@ -89,12 +90,15 @@ func (er EffectRequest) StreamEffects(
 
 	url := fmt.Sprintf("%s%s", client.getHorizonURL(), endpoint)
 	return client.stream(ctx, url, func(data []byte) error {
-		var effect effects.Base
-		err = json.Unmarshal(data, &effect)
-		if err != nil {
+		var baseRecord effects.Base
+		if err = json.Unmarshal(data, &baseRecord); err != nil {
 			return errors.Wrap(err, "Error unmarshaling data")
 		}
-		handler(effect)
-		return nil
+
+		effect, err := effects.UnmarshalEffect(baseRecord.GetType(), data)
+		if err != nil {
+			return errors.Wrap(err, "Unmarshaling to the correct effect type")
+		}
+		return handler(effect)
 	})
 }
@@ -76,8 +76,9 @@ func ExampleClient_StreamEffects() {
 		cancel()
 	}()
 
-	printHandler := func(e effects.Base) {
+	printHandler := func(e effects.Effect) error {
 		fmt.Println(e)
+		return nil
 	}
 	err := client.StreamEffects(ctx, effectRequest, printHandler)
 	if err != nil {
@@ -100,14 +101,20 @@ func TestEffectRequestStreamEffects(t *testing.T) {
 		"https://localhost/effects?cursor=now",
 	).ReturnString(200, effectStreamResponse)
 
-	effectStream := make([]effects.Base, 1)
-	err := client.StreamEffects(ctx, effectRequest, func(effect effects.Base) {
+	effectStream := make([]effects.Effect, 1)
+	err := client.StreamEffects(ctx, effectRequest, func(effect effects.Effect) error {
 		effectStream[0] = effect
 		cancel()
+		return nil
 	})
 
 	if assert.NoError(t, err) {
-		assert.Equal(t, effectStream[0].Type, "account_credited")
+		assert.Equal(t, effectStream[0].GetType(), "account_credited")
+		credited, ok := effectStream[0].(effects.AccountCredited)
+		if assert.True(t, ok) {
+			assert.Equal(t, "0.0460000", credited.Amount)
+			assert.Equal(t, "qwop", credited.Code)
+		}
 	}
 
 	// Account effects
@@ -119,13 +126,14 @@ func TestEffectRequestStreamEffects(t *testing.T) {
 		"https://localhost/accounts/GBNZN27NAOHRJRCMHQF2ZN2F6TAPVEWKJIGZIRNKIADWIS2HDENIS6CI/effects?cursor=now",
 	).ReturnString(200, effectStreamResponse)
 
-	err = client.StreamEffects(ctx, effectRequest, func(effect effects.Base) {
+	err = client.StreamEffects(ctx, effectRequest, func(effect effects.Effect) error {
 		effectStream[0] = effect
 		cancel()
+		return nil
 	})
 
 	if assert.NoError(t, err) {
-		assert.Equal(t, effectStream[0].Account, "GBNZN27NAOHRJRCMHQF2ZN2F6TAPVEWKJIGZIRNKIADWIS2HDENIS6CI")
+		assert.Equal(t, effectStream[0].GetAccount(), "GBNZN27NAOHRJRCMHQF2ZN2F6TAPVEWKJIGZIRNKIADWIS2HDENIS6CI")
 	}
 
 	// test error
@@ -137,9 +145,10 @@ func TestEffectRequestStreamEffects(t *testing.T) {
 		"https://localhost/effects?cursor=now",
 	).ReturnString(500, effectStreamResponse)
 
-	err = client.StreamEffects(ctx, effectRequest, func(effect effects.Base) {
+	err = client.StreamEffects(ctx, effectRequest, func(effect effects.Effect) error {
 		effectStream[0] = effect
 		cancel()
+		return nil
 	})
 
 	if assert.Error(t, err) {
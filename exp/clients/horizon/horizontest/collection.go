@@ -0,0 +1,158 @@
+package horizontest
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/stellar/go/support/render/hal"
+)
+
+// record is a resource served by the server, with the keys it is listed for.
+type record struct {
+	resource hal.Pageable
+	keys     map[string]bool
+}
+
+// collection is a list of records, ordered by paging token.
+type collection []record
+
+// key returns the key listing a record for name and value, or an empty string if value is
+// empty.
+func key(name string, value interface{}) string {
+	s := fmt.Sprint(value)
+	if s == "" {
+		return ""
+	}
+	return name + "=" + s
+}
+
+// hasKeyName returns true if k is the key of one of names.
+func hasKeyName(k string, names ...string) bool {
+	for _, name := range names {
+		if strings.HasPrefix(k, name+"=") {
+			return true
+		}
+	}
+	return false
+}
+
+// assetKey returns the value identifying an asset in keys.
+func assetKey(assetType, code, issuer string) string {
+	if assetType == "" {
+		return ""
+	}
+	return strings.Join([]string{assetType, code, issuer}, ":")
+}
+
+// tokenPrefix returns the first part of a paging token. The paging token of an effect starts with
+// the ID of its operation.
+func tokenPrefix(token string) string {
+	return strings.SplitN(token, "-", 2)[0]
+}
+
+// compareTokens compares two paging tokens. Paging tokens are made of parts separated by a dash,
+// which are compared as numbers when possible.
+func compareTokens(a, b string) int {
+	aParts := strings.Split(a, "-")
+	bParts := strings.Split(b, "-")
+
+	for i := 0; i < len(aParts) && i < len(bParts); i++ {
+		aNum, aErr := strconv.ParseUint(aParts[i], 10, 64)
+		bNum, bErr := strconv.ParseUint(bParts[i], 10, 64)
+		switch {
+		case aErr == nil && bErr == nil && aNum < bNum:
+			return -1
+		case aErr == nil && bErr == nil && aNum > bNum:
+			return 1
+		case aErr != nil || bErr != nil:
+			if c := strings.Compare(aParts[i], bParts[i]); c != 0 {
+				return c
+			}
+		}
+	}
+
+	return len(aParts) - len(bParts)
+}
+
+// add inserts a resource, listed for keys, in the collection. Empty keys are ignored.
+func (c *collection) add(resource hal.Pageable, keys ...string) {
+	r := record{resource: resource, keys: map[string]bool{}}
+	for _, k := range keys {
+		if k != "" {
+			r.keys[k] = true
+		}
+	}
+
+	records := *c
+	i := sort.Search(len(records), func(i int) bool {
+		return compareTokens(records[i].resource.PagingToken(), resource.PagingToken()) > 0
+	})
+	records = append(records, record{})
+	copy(records[i+1:], records[i:])
+	records[i] = r
+	*c = records
+}
+
+// find returns the record with the given ID.
+func (c collection) find(id string) (record, bool) {
+	for _, r := range c {
+		if r.keys[key("id", id)] {
+			return r, true
+		}
+	}
+	return record{}, false
+}
+
+// filter returns the records listed for all the keys. Empty keys are ignored.
+func (c collection) filter(keys ...string) collection {
+	var filtered collection
+	for _, r := range c {
+		matches := true
+		for _, k := range keys {
+			if k != "" && !r.keys[k] {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered
+}
+
+// page returns the resources of the page requested by q.
+func (c collection) page(q pageQuery) []hal.Pageable {
+	resources := []hal.Pageable{}
+
+	if q.order == "desc" {
+		for i := len(c) - 1; i >= 0 && len(resources) < q.limit; i-- {
+			token := c[i].resource.PagingToken()
+			if q.cursor == "" || q.cursor == "now" || compareTokens(token, q.cursor) < 0 {
+				resources = append(resources, c[i].resource)
+			}
+		}
+		return resources
+	}
+
+	if q.cursor == "now" {
+		return resources
+	}
+	for i := 0; i < len(c) && len(resources) < q.limit; i++ {
+		if q.cursor == "" || compareTokens(c[i].resource.PagingToken(), q.cursor) > 0 {
+			resources = append(resources, c[i].resource)
+		}
+	}
+	return resources
+}
+
+// lastToken returns the paging token of the last record, or an empty string if the collection is
+// empty.
+func (c collection) lastToken() string {
+	if len(c) == 0 {
+		return ""
+	}
+	return c[len(c)-1].resource.PagingToken()
+}
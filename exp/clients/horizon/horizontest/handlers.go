@@ -0,0 +1,368 @@
+package horizontest
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/go-chi/chi"
+	"github.com/stellar/go/network"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/render/hal"
+	"github.com/stellar/go/support/render/problem"
+	"github.com/stellar/go/xdr"
+)
+
+const (
+	defaultLimit = 10
+	maxLimit     = 200
+)
+
+// filter returns a key that the records listed by a request must have.
+type filter func(r *http.Request) string
+
+// param filters records on the value of a URL parameter.
+func param(name, urlParam string) filter {
+	return func(r *http.Request) string {
+		return key(name, chi.URLParam(r, urlParam))
+	}
+}
+
+// queryParam filters records on the value of a query parameter.
+func queryParam(name, queryParam string) filter {
+	return func(r *http.Request) string {
+		return key(name, r.URL.Query().Get(queryParam))
+	}
+}
+
+// assetParam filters records on the asset described by the query parameters starting with
+// prefix.
+func assetParam(name, prefix string) filter {
+	return func(r *http.Request) string {
+		query := r.URL.Query()
+		return key(name, assetKey(
+			query.Get(prefix+"_asset_type"),
+			query.Get(prefix+"_asset_code"),
+			query.Get(prefix+"_asset_issuer"),
+		))
+	}
+}
+
+// always filters records on a fixed key.
+func always(k string) filter {
+	return func(r *http.Request) string {
+		return k
+	}
+}
+
+// pageQuery holds the paging parameters of a request.
+type pageQuery struct {
+	cursor string
+	order  string
+	limit  int
+}
+
+func parsePageQuery(r *http.Request) (pageQuery, error) {
+	query := r.URL.Query()
+	q := pageQuery{cursor: query.Get("cursor"), order: query.Get("order"), limit: defaultLimit}
+
+	switch q.order {
+	case "":
+		q.order = "asc"
+	case "asc", "desc":
+	default:
+		return q, problem.MakeInvalidFieldProblem("order", errors.New("order: invalid value"))
+	}
+
+	if limit := query.Get("limit"); limit != "" {
+		n, err := strconv.Atoi(limit)
+		if err != nil || n <= 0 || n > maxLimit {
+			return q, problem.MakeInvalidFieldProblem(
+				"limit",
+				errors.Errorf("limit: must be a positive number less than or equal to %d", maxLimit),
+			)
+		}
+		q.limit = n
+	}
+
+	return q, nil
+}
+
+func (s *Server) router() http.Handler {
+	r := chi.NewRouter()
+	r.Use(s.injectFailures)
+	r.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		problem.Render(r.Context(), w, problem.NotFound)
+	})
+
+	r.Get("/", s.rootHandler)
+
+	r.Route("/ledgers", func(r chi.Router) {
+		r.Get("/", s.list(&s.ledgers))
+		r.Route("/{ledger_id}", func(r chi.Router) {
+			r.Get("/", s.show(&s.ledgers, "ledger_id"))
+			r.Get("/transactions", s.list(&s.transactions, param("ledger", "ledger_id")))
+			r.Get("/operations", s.list(&s.operations, param("ledger", "ledger_id")))
+			r.Get("/payments", s.list(&s.operations, param("ledger", "ledger_id"), always(key("payment", true))))
+			r.Get("/effects", s.list(&s.effects, param("ledger", "ledger_id")))
+		})
+	})
+
+	r.Route("/accounts/{account_id}", func(r chi.Router) {
+		r.Get("/", s.accountHandler)
+		r.Get("/transactions", s.list(&s.transactions, param("account", "account_id")))
+		r.Get("/operations", s.list(&s.operations, param("account", "account_id")))
+		r.Get("/payments", s.list(&s.operations, param("account", "account_id"), always(key("payment", true))))
+		r.Get("/effects", s.list(&s.effects, param("account", "account_id")))
+		r.Get("/offers", s.list(&s.offers, param("account", "account_id")))
+		r.Get("/trades", s.list(&s.trades, param("account", "account_id")))
+		r.Get("/data/{key}", s.accountDataHandler)
+	})
+
+	r.Route("/transactions", func(r chi.Router) {
+		r.Get("/", s.list(&s.transactions))
+		r.Post("/", s.submitHandler)
+		r.Route("/{tx_id}", func(r chi.Router) {
+			r.Get("/", s.show(&s.transactions, "tx_id"))
+			r.Get("/operations", s.list(&s.operations, param("transaction", "tx_id")))
+			r.Get("/payments", s.list(&s.operations, param("transaction", "tx_id"), always(key("payment", true))))
+			r.Get("/effects", s.list(&s.effects, param("transaction", "tx_id")))
+		})
+	})
+
+	r.Route("/operations", func(r chi.Router) {
+		r.Get("/", s.list(&s.operations))
+		r.Get("/{id}", s.show(&s.operations, "id"))
+		r.Get("/{op_id}/effects", s.list(&s.effects, param("operation", "op_id")))
+	})
+
+	r.Get("/payments", s.list(&s.operations, always(key("payment", true))))
+	r.Get("/effects", s.list(&s.effects))
+
+	r.Get("/trades", s.list(&s.trades,
+		assetParam("base_asset", "base"),
+		assetParam("counter_asset", "counter"),
+		queryParam("offer", "offer_id"),
+	))
+	r.Get("/offers/{offer_id}/trades", s.list(&s.trades, param("offer", "offer_id")))
+
+	r.Get("/assets", s.list(&s.assets,
+		queryParam("asset_code", "asset_code"),
+		queryParam("asset_issuer", "asset_issuer"),
+	))
+
+	return r
+}
+
+// injectFailures renders the problems queued with FailNext.
+func (s *Server) injectFailures(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		problems := s.failures[r.URL.Path]
+		if len(problems) > 0 {
+			s.failures[r.URL.Path] = problems[1:]
+		}
+		s.mutex.Unlock()
+
+		if len(problems) > 0 {
+			problem.Render(r.Context(), w, problems[0])
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Server) rootHandler(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.root != nil {
+		hal.Render(w, *s.root)
+		return
+	}
+
+	root := hProtocol.Root{NetworkPassphrase: s.NetworkPassphrase}
+	if len(s.ledgers) > 0 {
+		first := s.ledgers[0].resource.(hProtocol.Ledger)
+		last := s.ledgers[len(s.ledgers)-1].resource.(hProtocol.Ledger)
+		root.HistoryElderSequence = first.Sequence
+		root.HorizonSequence = last.Sequence
+		root.CoreSequence = last.Sequence
+		root.CurrentProtocolVersion = last.ProtocolVersion
+		root.CoreSupportedProtocolVersion = last.ProtocolVersion
+	}
+	hal.Render(w, root)
+}
+
+func (s *Server) accountHandler(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	account, ok := s.accounts[chi.URLParam(r, "account_id")]
+	s.mutex.Unlock()
+
+	if !ok {
+		problem.Render(r.Context(), w, problem.NotFound)
+		return
+	}
+	hal.Render(w, account)
+}
+
+func (s *Server) accountDataHandler(w http.ResponseWriter, r *http.Request) {
+	s.mutex.Lock()
+	account, ok := s.accounts[chi.URLParam(r, "account_id")]
+	s.mutex.Unlock()
+
+	value, found := account.Data[chi.URLParam(r, "key")]
+	if !ok || !found {
+		problem.Render(r.Context(), w, problem.NotFound)
+		return
+	}
+	hal.Render(w, hProtocol.AccountData{Value: value})
+}
+
+// show returns a handler rendering the record of c whose ID is the value of urlParam.
+func (s *Server) show(c *collection, urlParam string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mutex.Lock()
+		rec, ok := c.find(chi.URLParam(r, urlParam))
+		s.mutex.Unlock()
+
+		if !ok {
+			problem.Render(r.Context(), w, problem.NotFound)
+			return
+		}
+		hal.Render(w, rec.resource)
+	}
+}
+
+// list returns a handler rendering a page of the records of c that match the filters, or
+// streaming them when the request accepts server sent events.
+func (s *Server) list(c *collection, filters ...filter) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q, err := parsePageQuery(r)
+		if err != nil {
+			problem.Render(r.Context(), w, err)
+			return
+		}
+
+		keys := make([]string, len(filters))
+		for i, f := range filters {
+			keys[i] = f(r)
+		}
+
+		if r.Header.Get("Accept") == "text/event-stream" {
+			s.stream(w, r, c, keys, q.cursor)
+			return
+		}
+
+		s.mutex.Lock()
+		resources := c.filter(keys...).page(q)
+		s.mutex.Unlock()
+
+		page := hal.Page{Order: q.order, Limit: uint64(q.limit), Cursor: q.cursor}
+		page.FullURL = &url.URL{Scheme: "http", Host: r.Host, Path: r.URL.Path, RawQuery: r.URL.RawQuery}
+		for _, resource := range resources {
+			page.Add(resource)
+		}
+		page.PopulateLinks()
+		hal.Render(w, page)
+	}
+}
+
+// stream sends the records of c that match keys and follow cursor as server sent events, then
+// the matching records added to the server, until the client disconnects or the server is
+// closed.
+func (s *Server) stream(w http.ResponseWriter, r *http.Request, c *collection, keys []string, cursor string) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "Streaming Not Supported", http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	fmt.Fprint(w, "retry: 1000\nevent: open\ndata: \"hello\"\n\n")
+	flusher.Flush()
+
+	s.mutex.Lock()
+	if cursor == "now" {
+		cursor = c.filter(keys...).lastToken()
+	}
+	s.mutex.Unlock()
+
+	for {
+		s.mutex.Lock()
+		records := c.filter(keys...)
+		resources := records.page(pageQuery{cursor: cursor, order: "asc", limit: len(records)})
+		updated := s.updated
+		s.mutex.Unlock()
+
+		for _, resource := range resources {
+			data, err := json.Marshal(resource)
+			if err != nil {
+				fmt.Fprintf(w, "event: error\ndata: %s\n\n", err)
+				flusher.Flush()
+				return
+			}
+			cursor = resource.PagingToken()
+			fmt.Fprintf(w, "id: %s\ndata: %s\n\n", cursor, data)
+		}
+		flusher.Flush()
+
+		select {
+		case <-r.Context().Done():
+			return
+		case <-s.closed:
+			return
+		case <-updated:
+		}
+	}
+}
+
+func (s *Server) submitHandler(w http.ResponseWriter, r *http.Request) {
+	if err := r.ParseForm(); err != nil {
+		problem.Render(r.Context(), w, problem.BadRequest)
+		return
+	}
+
+	transactionXDR := r.Form.Get("tx")
+	var resp hProtocol.TransactionSuccess
+	var err error
+	if s.SubmitHandler != nil {
+		resp, err = s.SubmitHandler(transactionXDR)
+	} else {
+		resp, err = s.accept(transactionXDR)
+	}
+
+	if err != nil {
+		problem.Render(r.Context(), w, err)
+		return
+	}
+	hal.Render(w, resp)
+}
+
+// accept returns the response of horizon when a transaction is included in the latest ledger.
+func (s *Server) accept(transactionXDR string) (hProtocol.TransactionSuccess, error) {
+	var envelope xdr.TransactionEnvelope
+	if err := xdr.SafeUnmarshalBase64(transactionXDR, &envelope); err != nil {
+		return hProtocol.TransactionSuccess{}, TransactionMalformed(transactionXDR)
+	}
+
+	hash, err := network.HashTransaction(&envelope.Tx, s.NetworkPassphrase)
+	if err != nil {
+		return hProtocol.TransactionSuccess{}, errors.Wrap(err, "failed to hash transaction")
+	}
+
+	resp := hProtocol.TransactionSuccess{Hash: hex.EncodeToString(hash[:]), Env: transactionXDR}
+	s.mutex.Lock()
+	if len(s.ledgers) > 0 {
+		resp.Ledger = s.ledgers[len(s.ledgers)-1].resource.(hProtocol.Ledger).Sequence
+	}
+	s.mutex.Unlock()
+	return resp, nil
+}
@@ -0,0 +1,269 @@
+/*
+Package horizontest provides a fake, in-memory horizon server for testing code that talks to horizon.
+
+The server serves the resources of protocols/horizon that were added to it, with the cursor
+paging, streaming and problem errors of a real horizon server:
+
+	server := horizontest.NewServer()
+	defer server.Close()
+
+	server.AddLedger(hProtocol.Ledger{ID: "1", PT: "4294967296", Sequence: 1})
+	client := &horizonclient.Client{HorizonURL: server.URL + "/", HTTP: server.Client()}
+
+Resources added while a stream is open are sent to it. Requests can be made to fail with
+FailNext.
+*/
+package horizontest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	"github.com/stellar/go/network"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/protocols/horizon/effects"
+	"github.com/stellar/go/protocols/horizon/operations"
+	"github.com/stellar/go/support/render/problem"
+)
+
+// Timeout is the problem returned by horizon when a request, usually a transaction submission,
+// timed out.
+var Timeout = problem.P{
+	Type:   "timeout",
+	Title:  "Timeout",
+	Status: http.StatusGatewayTimeout,
+	Detail: "Your request timed out before completing.  Please try your " +
+		"request again. If you are submitting a transaction make sure you are " +
+		"sending exactly the same transaction (with the same sequence number).",
+}
+
+// RateLimitExceeded is the problem returned by horizon when a client sent too many requests.
+var RateLimitExceeded = problem.P{
+	Type:   "rate_limit_exceeded",
+	Title:  "Rate Limit Exceeded",
+	Status: http.StatusTooManyRequests,
+	Detail: "The rate limit for the requesting IP address is over its alloted " +
+		"limit.  The allowed limit and requests left per time period are " +
+		"communicated to clients via the http response headers 'X-RateLimit-*' " +
+		"headers.",
+}
+
+// TransactionMalformed returns the problem returned by horizon when the envelope of a submitted
+// transaction can not be decoded.
+func TransactionMalformed(envelopeXDR string) problem.P {
+	return problem.P{
+		Type:   "transaction_malformed",
+		Title:  "Transaction Malformed",
+		Status: http.StatusBadRequest,
+		Detail: "Horizon could not decode the transaction envelope in this " +
+			"request. A transaction should be an XDR TransactionEnvelope struct " +
+			"encoded using base64.  The envelope read from this request is " +
+			"echoed in the `extras.envelope_xdr` field of this response for your " +
+			"convenience.",
+		Extras: map[string]interface{}{
+			"envelope_xdr": envelopeXDR,
+		},
+	}
+}
+
+// TransactionFailed returns the problem returned by horizon when a submitted transaction failed
+// with the given result codes.
+func TransactionFailed(envelopeXDR, resultXDR, transactionCode string, operationCodes ...string) problem.P {
+	return problem.P{
+		Type:   "transaction_failed",
+		Title:  "Transaction Failed",
+		Status: http.StatusBadRequest,
+		Detail: "The transaction failed when submitted to the stellar network. " +
+			"The `extras.result_codes` field on this response contains further " +
+			"details.  Descriptions of each code can be found at: " +
+			"https://www.stellar.org/developers/learn/concepts/list-of-operations.html",
+		Extras: map[string]interface{}{
+			"envelope_xdr": envelopeXDR,
+			"result_xdr":   resultXDR,
+			"result_codes": hProtocol.TransactionResultCodes{
+				TransactionCode: transactionCode,
+				OperationCodes:  operationCodes,
+			},
+		},
+	}
+}
+
+// Server is a fake horizon server. Resources are added to it with the Add methods, which can be
+// called at any time.
+type Server struct {
+	*httptest.Server
+
+	// NetworkPassphrase is the passphrase of the network served, returned by the root endpoint
+	// and used to hash submitted transactions. It defaults to the test network passphrase.
+	NetworkPassphrase string
+
+	// SubmitHandler is called with the envelope of each submitted transaction. The error it
+	// returns is rendered as a problem, see problem.Render. When it is not set, well-formed
+	// transactions are reported as included in the latest ledger, without being added to the
+	// server.
+	SubmitHandler func(transactionXDR string) (hProtocol.TransactionSuccess, error)
+
+	mutex        sync.Mutex
+	root         *hProtocol.Root
+	accounts     map[string]hProtocol.Account
+	ledgers      collection
+	transactions collection
+	operations   collection
+	effects      collection
+	trades       collection
+	offers       collection
+	assets       collection
+	failures     map[string][]problem.P
+	updated      chan struct{}
+	closed       chan struct{}
+	closeOnce    sync.Once
+}
+
+// NewServer starts and returns a new Server. The caller should call Close when finished, to
+// shut it down.
+func NewServer() *Server {
+	s := &Server{
+		NetworkPassphrase: network.TestNetworkPassphrase,
+		accounts:          map[string]hProtocol.Account{},
+		failures:          map[string][]problem.P{},
+		updated:           make(chan struct{}),
+		closed:            make(chan struct{}),
+	}
+	s.Server = httptest.NewServer(s.router())
+	return s
+}
+
+// Close closes the open streams, then shuts down the server.
+func (s *Server) Close() {
+	s.closeOnce.Do(func() {
+		close(s.closed)
+	})
+	s.Server.Close()
+}
+
+// FailNext makes the next requests to path, for example "/transactions", fail with the given
+// problems, one per request and in order. Requests that follow are served normally.
+func (s *Server) FailNext(path string, problems ...problem.P) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.failures[path] = append(s.failures[path], problems...)
+}
+
+// SetRoot sets the resource returned by the root endpoint. By default, the root resource is built
+// from the ledgers added to the server.
+func (s *Server) SetRoot(root hProtocol.Root) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.root = &root
+}
+
+// AddAccount adds an account, replacing the account with the same ID if any.
+func (s *Server) AddAccount(account hProtocol.Account) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.accounts[account.AccountID] = account
+	s.notify()
+}
+
+// AddLedger adds a ledger.
+func (s *Server) AddLedger(ledger hProtocol.Ledger) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.ledgers.add(ledger, key("id", ledger.Sequence))
+	s.notify()
+}
+
+// AddTransaction adds a transaction, which is listed for its source account and ledger.
+func (s *Server) AddTransaction(tx hProtocol.Transaction) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.transactions.add(tx, key("id", tx.Hash), key("account", tx.Account), key("ledger", tx.Ledger))
+	s.notify()
+}
+
+// AddOperation adds an operation, which is listed for its transaction, the ledger of that
+// transaction and the given participants. When no participant is given, the operation is listed
+// for the source account of its transaction. The transaction must be added first.
+func (s *Server) AddOperation(op operations.Operation, participants ...string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	keys := []string{key("id", op.GetID()), key("transaction", op.GetTransactionHash())}
+	if tx, ok := s.transactions.find(op.GetTransactionHash()); ok {
+		keys = append(keys, key("ledger", tx.resource.(hProtocol.Transaction).Ledger))
+		if len(participants) == 0 {
+			participants = []string{tx.resource.(hProtocol.Transaction).Account}
+		}
+	}
+	for _, participant := range participants {
+		keys = append(keys, key("account", participant))
+	}
+
+	switch op.GetType() {
+	case "create_account", "payment", "path_payment", "account_merge":
+		keys = append(keys, key("payment", true))
+	}
+
+	s.operations.add(op, keys...)
+	s.notify()
+}
+
+// AddEffect adds an effect, which is listed for its account and for its operation, the
+// transaction and the ledger of that operation. The operation is found from the paging token of
+// the effect, and must be added first.
+func (s *Server) AddEffect(effect effects.Effect) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	keys := []string{key("id", effect.GetID()), key("account", effect.GetAccount())}
+	if op, ok := s.operations.find(tokenPrefix(effect.PagingToken())); ok {
+		keys = append(keys, key("operation", op.resource.(operations.Operation).GetID()))
+		for k := range op.keys {
+			if hasKeyName(k, "transaction", "ledger") {
+				keys = append(keys, k)
+			}
+		}
+	}
+
+	s.effects.add(effect, keys...)
+	s.notify()
+}
+
+// AddTrade adds a trade, which is listed for both its accounts and offers, and for its asset
+// pair.
+func (s *Server) AddTrade(trade hProtocol.Trade) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.trades.add(trade,
+		key("account", trade.BaseAccount),
+		key("account", trade.CounterAccount),
+		key("offer", trade.BaseOfferID),
+		key("offer", trade.CounterOfferID),
+		key("base_asset", assetKey(trade.BaseAssetType, trade.BaseAssetCode, trade.BaseAssetIssuer)),
+		key("counter_asset", assetKey(trade.CounterAssetType, trade.CounterAssetCode, trade.CounterAssetIssuer)),
+	)
+	s.notify()
+}
+
+// AddOffer adds an offer, which is listed for its seller.
+func (s *Server) AddOffer(offer hProtocol.Offer) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.offers.add(offer, key("id", offer.ID), key("account", offer.Seller))
+	s.notify()
+}
+
+// AddAsset adds the statistics of an asset.
+func (s *Server) AddAsset(asset hProtocol.AssetStat) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.assets.add(asset, key("asset_code", asset.Code), key("asset_issuer", asset.Issuer))
+	s.notify()
+}
+
+// notify wakes up the open streams. It must be called with the mutex held.
+func (s *Server) notify() {
+	close(s.updated)
+	s.updated = make(chan struct{})
+}
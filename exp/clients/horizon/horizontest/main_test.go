@@ -0,0 +1,223 @@
+package horizontest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	horizonclient "github.com/stellar/go/exp/clients/horizon"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/protocols/horizon/base"
+	"github.com/stellar/go/protocols/horizon/effects"
+	"github.com/stellar/go/protocols/horizon/operations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	alice = "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU"
+	bob   = "GBNZN27NAOHRJRCMHQF2ZN2F6TAPVEWKJIGZIRNKIADWIS2HDENIS6CI"
+
+	txXdr = `AAAAABB90WssODNIgi6BHveqzxTRmIpvAFRyVNM+Hm2GVuCcAAAAZAAABD0AAuV/AAAAAAAAAAAAAAABAAAAAAAAAAAAAAAAyTBGxOgfSApppsTnb/YRr6gOR8WT0LZNrhLh4y3FCgoAAAAXSHboAAAAAAAAAAABhlbgnAAAAEAivKe977CQCxMOKTuj+cWTFqc2OOJU8qGr9afrgu2zDmQaX5Q0cNshc3PiBwe0qw/+D/qJk5QqM5dYeSUGeDQP`
+)
+
+func ledger(sequence int32) hProtocol.Ledger {
+	return hProtocol.Ledger{
+		ID:       fmt.Sprintf("ledger%d", sequence),
+		PT:       fmt.Sprint(int64(sequence) << 32),
+		Sequence: sequence,
+	}
+}
+
+func newTestClient(server *Server) *horizonclient.Client {
+	return &horizonclient.Client{HorizonURL: server.URL + "/", HTTP: server.Client()}
+}
+
+func sequences(page hProtocol.LedgersPage) []int32 {
+	var sequences []int32
+	for _, ledger := range page.Embedded.Records {
+		sequences = append(sequences, ledger.Sequence)
+	}
+	return sequences
+}
+
+func TestPaging(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newTestClient(server)
+
+	for sequence := int32(1); sequence <= 5; sequence++ {
+		server.AddLedger(ledger(sequence))
+	}
+
+	page, err := client.Ledgers(horizonclient.LedgerRequest{Limit: 2})
+	require.NoError(t, err)
+	assert.Equal(t, []int32{1, 2}, sequences(page))
+
+	page, err = client.NextLedgersPage(page)
+	require.NoError(t, err)
+	assert.Equal(t, []int32{3, 4}, sequences(page))
+
+	prev, err := client.PrevLedgersPage(page)
+	require.NoError(t, err)
+	assert.Equal(t, []int32{2, 1}, sequences(prev))
+
+	page, err = client.Ledgers(horizonclient.LedgerRequest{Order: horizonclient.OrderDesc, Cursor: fmt.Sprint(int64(3) << 32)})
+	require.NoError(t, err)
+	assert.Equal(t, []int32{2, 1}, sequences(page))
+
+	detail, err := client.LedgerDetail(4)
+	require.NoError(t, err)
+	assert.Equal(t, "ledger4", detail.ID)
+
+	// Invalid paging parameters are rejected
+	_, err = client.Ledgers(horizonclient.LedgerRequest{Limit: 201})
+	if assert.IsType(t, &horizonclient.Error{}, err) {
+		p := err.(*horizonclient.Error).Problem
+		assert.Equal(t, "https://stellar.org/horizon-errors/bad_request", p.Type)
+		assert.Equal(t, "limit", p.Extras["invalid_field"])
+	}
+
+	_, err = client.LedgerDetail(6)
+	if assert.IsType(t, &horizonclient.Error{}, err) {
+		assert.Equal(t, http.StatusNotFound, err.(*horizonclient.Error).Problem.Status)
+	}
+}
+
+func TestFilters(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newTestClient(server)
+
+	server.AddAccount(hProtocol.Account{HistoryAccount: hProtocol.HistoryAccount{AccountID: alice}, Sequence: "10"})
+	server.AddTransaction(hProtocol.Transaction{ID: "tx1", Hash: "tx1", PT: "4294971392", Ledger: 1, Account: alice})
+	server.AddTransaction(hProtocol.Transaction{ID: "tx2", Hash: "tx2", PT: "8589938688", Ledger: 2, Account: bob})
+
+	payment := operations.Payment{Base: operations.Base{ID: "4294971393", PT: "4294971393", Type: "payment", TransactionHash: "tx1"}}
+	server.AddOperation(payment, alice, bob)
+	offer := operations.ManageOffer{CreatePassiveOffer: operations.CreatePassiveOffer{Base: operations.Base{ID: "8589938689", PT: "8589938689", Type: "manage_offer", TransactionHash: "tx2"}}}
+	server.AddOperation(offer)
+
+	credited := effects.AccountCredited{Base: effects.Base{ID: "0004294971393-0000000001", PT: "4294971393-1", Account: bob, Type: "account_credited"}}
+	server.AddEffect(credited)
+
+	server.AddTrade(hProtocol.Trade{ID: "trade1", PT: "8589938689-0", BaseOfferID: "7", BaseAccount: bob, BaseAssetType: "native", CounterAssetType: "credit_alphanum4", CounterAssetCode: "USD", CounterAssetIssuer: alice, CounterAccount: alice})
+
+	account, err := client.AccountDetail(horizonclient.AccountRequest{AccountID: alice})
+	require.NoError(t, err)
+	assert.Equal(t, "10", account.Sequence)
+
+	_, err = client.AccountDetail(horizonclient.AccountRequest{AccountID: bob})
+	assert.Error(t, err)
+
+	txs, err := client.Transactions(horizonclient.TransactionRequest{ForAccount: bob})
+	require.NoError(t, err)
+	if assert.Len(t, txs.Embedded.Records, 1) {
+		assert.Equal(t, "tx2", txs.Embedded.Records[0].Hash)
+	}
+
+	txs, err = client.Transactions(horizonclient.TransactionRequest{ForLedger: 1})
+	require.NoError(t, err)
+	if assert.Len(t, txs.Embedded.Records, 1) {
+		assert.Equal(t, "tx1", txs.Embedded.Records[0].Hash)
+	}
+
+	// Operations are listed for their participants, or the source account of their transaction
+	ops, err := client.Operations(horizonclient.OperationRequest{ForAccount: bob})
+	require.NoError(t, err)
+	if assert.Len(t, ops.Embedded.Records, 2) {
+		assert.IsType(t, operations.Payment{}, ops.Embedded.Records[0])
+		assert.IsType(t, operations.ManageOffer{}, ops.Embedded.Records[1])
+	}
+
+	ops, err = client.Payments(horizonclient.OperationRequest{ForLedger: 2})
+	require.NoError(t, err)
+	assert.Empty(t, ops.Embedded.Records)
+
+	effs, err := client.Effects(horizonclient.EffectRequest{ForTransaction: "tx1"})
+	require.NoError(t, err)
+	if assert.Len(t, effs.Embedded.Records, 1) {
+		assert.IsType(t, effects.AccountCredited{}, effs.Embedded.Records[0])
+	}
+
+	trades, err := client.Trades(horizonclient.TradeRequest{
+		BaseAssetType:      horizonclient.AssetTypeNative,
+		CounterAssetType:   horizonclient.AssetType4,
+		CounterAssetCode:   "USD",
+		CounterAssetIssuer: alice,
+	})
+	require.NoError(t, err)
+	assert.Len(t, trades.Embedded.Records, 1)
+
+	trades, err = client.Trades(horizonclient.TradeRequest{ForOfferID: "8"})
+	require.NoError(t, err)
+	assert.Empty(t, trades.Embedded.Records)
+
+	server.AddAsset(hProtocol.AssetStat{Asset: base.Asset{Type: "credit_alphanum4", Code: "USD", Issuer: alice}, PT: "USD_" + alice + "_credit_alphanum4"})
+	assets, err := client.Assets(horizonclient.AssetRequest{ForAssetCode: "USD"})
+	require.NoError(t, err)
+	assert.Len(t, assets.Embedded.Records, 1)
+}
+
+func TestStream(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newTestClient(server)
+
+	server.AddLedger(ledger(1))
+	server.AddLedger(ledger(2))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var streamed []int32
+	err := client.StreamLedgers(ctx, horizonclient.LedgerRequest{Cursor: fmt.Sprint(int64(1) << 32)}, func(l hProtocol.Ledger) error {
+		streamed = append(streamed, l.Sequence)
+		switch l.Sequence {
+		case 2:
+			// Ledgers added while the stream is open are streamed
+			go server.AddLedger(ledger(3))
+		case 3:
+			cancel()
+		}
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{2, 3}, streamed)
+}
+
+func TestSubmit(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client := newTestClient(server)
+
+	server.AddLedger(ledger(7))
+	server.FailNext("/transactions", Timeout)
+
+	_, err := client.SubmitTransaction(txXdr)
+	if assert.IsType(t, &horizonclient.Error{}, err) {
+		assert.Equal(t, http.StatusGatewayTimeout, err.(*horizonclient.Error).Problem.Status)
+	}
+
+	resp, err := client.SubmitTransaction(txXdr)
+	require.NoError(t, err)
+	assert.Equal(t, int32(7), resp.Ledger)
+	assert.Len(t, resp.Hash, 64)
+
+	_, err = client.SubmitTransaction("AAAA")
+	if assert.IsType(t, &horizonclient.Error{}, err) {
+		envelope, envErr := err.(*horizonclient.Error).EnvelopeXDR()
+		assert.NoError(t, envErr)
+		assert.Equal(t, "AAAA", envelope)
+	}
+
+	server.SubmitHandler = func(transactionXDR string) (hProtocol.TransactionSuccess, error) {
+		return hProtocol.TransactionSuccess{}, TransactionFailed(transactionXDR, "", "tx_bad_seq")
+	}
+	_, err = client.SubmitTransaction(txXdr)
+	if assert.IsType(t, &horizonclient.Error{}, err) {
+		codes, codesErr := err.(*horizonclient.Error).ResultCodes()
+		require.NoError(t, codesErr)
+		assert.Equal(t, "tx_bad_seq", codes.TransactionCode)
+	}
+}
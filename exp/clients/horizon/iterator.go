@@ -0,0 +1,266 @@
+package horizonclient
+
+import (
+	"context"
+	"reflect"
+
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/protocols/horizon/operations"
+	"github.com/stellar/go/support/errors"
+)
+
+// IteratorOptions configures an Iterator.
+type IteratorOptions struct {
+	// MaxRecords is the number of records after which the iterator stops. Zero means the
+	// iterator walks every record matching the request.
+	MaxRecords int
+	// Prefetch loads the next page in the background while the records of the current page are
+	// being consumed.
+	Prefetch bool
+}
+
+// Iterator lazily walks the records returned by a list endpoint, loading pages from horizon as
+// they are needed. The page size is the Limit of the request the iterator was created with.
+//
+//	it, err := horizonclient.NewIterator(ctx, client, horizonclient.TransactionRequest{ForAccount: id}, horizonclient.IteratorOptions{})
+//	if err != nil {
+//		return err
+//	}
+//	defer it.Close()
+//	for it.Next() {
+//		tx := it.Record().(hProtocol.Transaction)
+//	}
+//	if it.Err() != nil {
+//		return it.Err()
+//	}
+type Iterator struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	fetch   func() ([]interface{}, error)
+	options IteratorOptions
+
+	records []interface{}
+	pending chan pageResult
+	count   int
+	record  interface{}
+	last    bool
+	err     error
+}
+
+type pageResult struct {
+	records []interface{}
+	err     error
+}
+
+// NewIterator returns an Iterator over the records matching request, which must be an
+// EffectRequest, AssetRequest, LedgerRequest, OfferRequest, OperationRequest, TransactionRequest
+// or TradeRequest. Payments are iterated with an OperationRequest on which SetPaymentsEndpoint
+// was called. Records have the type found in the corresponding page, for example
+// hProtocol.Transaction or operations.Operation.
+//
+// The iterator stops once MaxRecords records were returned, the last page was reached or ctx
+// is cancelled. Pages are loaded with ctx: cancelling it, or closing the iterator, cancels the
+// request in flight.
+func NewIterator(ctx context.Context, client ClientInterface, request HorizonRequest, options IteratorOptions) (*Iterator, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	fetch, err := pageFetcher(ctx, client, request)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+
+	return &Iterator{ctx: ctx, cancel: cancel, fetch: fetch, options: options}, nil
+}
+
+// Next advances the iterator to the next record, which is then available through Record. It
+// returns false when there are no more records, or when an error occurred, in which case it is
+// returned by Err.
+func (it *Iterator) Next() bool {
+	if it.err != nil || it.reachedMaxRecords() {
+		return false
+	}
+
+	if len(it.records) == 0 {
+		if it.last {
+			return false
+		}
+
+		if err := it.ctx.Err(); err != nil {
+			it.err = err
+			return false
+		}
+
+		if it.pending == nil {
+			it.load()
+		}
+
+		select {
+		case <-it.ctx.Done():
+			it.err = it.ctx.Err()
+			return false
+		case page := <-it.pending:
+			it.pending = nil
+			if page.err != nil {
+				it.err = page.err
+				return false
+			}
+			if len(page.records) == 0 {
+				it.last = true
+				return false
+			}
+			it.records = page.records
+		}
+
+		if it.options.Prefetch && !it.reachedMaxRecords(len(it.records)) {
+			it.load()
+		}
+	}
+
+	it.record, it.records = it.records[0], it.records[1:]
+	it.count++
+	return true
+}
+
+// Record returns the current record.
+func (it *Iterator) Record() interface{} {
+	return it.record
+}
+
+// Err returns the error that stopped the iterator, if any.
+func (it *Iterator) Err() error {
+	return it.err
+}
+
+// Close releases the resources held by the iterator. It must be called if the iterator is not
+// walked until Next returns false.
+func (it *Iterator) Close() {
+	it.cancel()
+}
+
+// load starts loading the next page.
+func (it *Iterator) load() {
+	it.pending = make(chan pageResult, 1)
+	go func(pending chan<- pageResult) {
+		records, err := it.fetch()
+		pending <- pageResult{records: records, err: err}
+	}(it.pending)
+}
+
+// reachedMaxRecords returns true if MaxRecords records are returned once the extra records
+// are consumed.
+func (it *Iterator) reachedMaxRecords(extra ...int) bool {
+	if it.options.MaxRecords <= 0 {
+		return false
+	}
+
+	count := it.count
+	for _, n := range extra {
+		count += n
+	}
+	return count >= it.options.MaxRecords
+}
+
+// pager loads the pages of a request for an Iterator. Pages are one of the page types of
+// protocols/horizon, whose records are embedded in Embedded.Records.
+type pager struct {
+	// first loads the first page matching the request.
+	first func() (interface{}, error)
+	// next loads the page following page.
+	next func(page interface{}) (interface{}, error)
+}
+
+// fetcher returns a function that loads the records of the first page, then of the following
+// page each time it is called.
+func (p pager) fetcher() func() ([]interface{}, error) {
+	var page interface{}
+	return func() ([]interface{}, error) {
+		var next interface{}
+		var err error
+		if page == nil {
+			next, err = p.first()
+		} else {
+			next, err = p.next(page)
+		}
+		if err != nil {
+			return nil, err
+		}
+		page = next
+		return pageRecords(next), nil
+	}
+}
+
+// pageRecords returns the records embedded in page.
+func pageRecords(page interface{}) []interface{} {
+	embedded := reflect.ValueOf(page).FieldByName("Embedded").FieldByName("Records")
+	records := make([]interface{}, embedded.Len())
+	for i := range records {
+		records[i] = embedded.Index(i).Interface()
+	}
+	return records
+}
+
+// pageFetcher returns a function that loads the records of the first page matching request,
+// then of the following page each time it is called. Pages are loaded with ctx.
+func pageFetcher(ctx context.Context, client ClientInterface, request HorizonRequest) (func() ([]interface{}, error), error) {
+	var p pager
+	switch request := request.(type) {
+	case EffectRequest:
+		p = pager{
+			first: func() (interface{}, error) { return client.EffectsContext(ctx, request) },
+			next: func(page interface{}) (interface{}, error) {
+				return client.NextEffectsPageContext(ctx, page.(hProtocol.EffectsPage))
+			},
+		}
+	case AssetRequest:
+		p = pager{
+			first: func() (interface{}, error) { return client.AssetsContext(ctx, request) },
+			next: func(page interface{}) (interface{}, error) {
+				return client.NextAssetsPageContext(ctx, page.(hProtocol.AssetsPage))
+			},
+		}
+	case LedgerRequest:
+		p = pager{
+			first: func() (interface{}, error) { return client.LedgersContext(ctx, request) },
+			next: func(page interface{}) (interface{}, error) {
+				return client.NextLedgersPageContext(ctx, page.(hProtocol.LedgersPage))
+			},
+		}
+	case OfferRequest:
+		p = pager{
+			first: func() (interface{}, error) { return client.OffersContext(ctx, request) },
+			next: func(page interface{}) (interface{}, error) {
+				return client.NextOffersPageContext(ctx, page.(hProtocol.OffersPage))
+			},
+		}
+	case OperationRequest:
+		p = pager{
+			first: func() (interface{}, error) {
+				if request.endpoint == "payments" {
+					return client.PaymentsContext(ctx, request)
+				}
+				return client.OperationsContext(ctx, request)
+			},
+			next: func(page interface{}) (interface{}, error) {
+				return client.NextOperationsPageContext(ctx, page.(operations.OperationsPage))
+			},
+		}
+	case TransactionRequest:
+		p = pager{
+			first: func() (interface{}, error) { return client.TransactionsContext(ctx, request) },
+			next: func(page interface{}) (interface{}, error) {
+				return client.NextTransactionsPageContext(ctx, page.(hProtocol.TransactionsPage))
+			},
+		}
+	case TradeRequest:
+		p = pager{
+			first: func() (interface{}, error) { return client.TradesContext(ctx, request) },
+			next: func(page interface{}) (interface{}, error) {
+				return client.NextTradesPageContext(ctx, page.(hProtocol.TradesPage))
+			},
+		}
+	default:
+		return nil, errors.Errorf("Unsupported request type %T", request)
+	}
+
+	return p.fetcher(), nil
+}
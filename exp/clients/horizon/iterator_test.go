@@ -0,0 +1,185 @@
+package horizonclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/protocols/horizon/operations"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/http/httptest"
+	"github.com/stellar/go/support/render/hal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func ledgersPage(next string, sequences ...int32) hProtocol.LedgersPage {
+	var page hProtocol.LedgersPage
+	page.Links.Next = hal.NewLink(next)
+	for _, sequence := range sequences {
+		page.Embedded.Records = append(page.Embedded.Records, hProtocol.Ledger{Sequence: sequence})
+	}
+	return page
+}
+
+// iterate returns the sequences of the ledgers returned by it.
+func iterate(it *Iterator) []int32 {
+	var sequences []int32
+	for it.Next() {
+		sequences = append(sequences, it.Record().(hProtocol.Ledger).Sequence)
+	}
+	return sequences
+}
+
+func TestIterator(t *testing.T) {
+	for _, prefetch := range []bool{false, true} {
+		hmock := &MockClient{}
+		request := LedgerRequest{Limit: 2}
+		first := ledgersPage("/ledgers?cursor=2", 1, 2)
+		second := ledgersPage("/ledgers?cursor=4", 3, 4)
+		third := ledgersPage("/ledgers?cursor=5", 5)
+		last := ledgersPage("/ledgers?cursor=5")
+
+		hmock.On("LedgersContext", mock.Anything, request).Return(first, nil).Once()
+		hmock.On("NextLedgersPageContext", mock.Anything, first).Return(second, nil).Once()
+		hmock.On("NextLedgersPageContext", mock.Anything, second).Return(third, nil).Once()
+		hmock.On("NextLedgersPageContext", mock.Anything, third).Return(last, nil).Once()
+
+		it, err := NewIterator(context.Background(), hmock, request, IteratorOptions{Prefetch: prefetch})
+		require.NoError(t, err)
+		assert.Equal(t, []int32{1, 2, 3, 4, 5}, iterate(it))
+		assert.NoError(t, it.Err())
+		assert.False(t, it.Next())
+		it.Close()
+
+		hmock.AssertExpectations(t)
+	}
+}
+
+func TestIteratorMaxRecords(t *testing.T) {
+	for _, prefetch := range []bool{false, true} {
+		hmock := &MockClient{}
+		request := LedgerRequest{Limit: 2}
+		first := ledgersPage("/ledgers?cursor=2", 1, 2)
+		second := ledgersPage("/ledgers?cursor=4", 3, 4)
+
+		// The page following the last needed record is never loaded
+		hmock.On("LedgersContext", mock.Anything, request).Return(first, nil).Once()
+		hmock.On("NextLedgersPageContext", mock.Anything, first).Return(second, nil).Once()
+
+		it, err := NewIterator(context.Background(), hmock, request, IteratorOptions{MaxRecords: 3, Prefetch: prefetch})
+		require.NoError(t, err)
+		assert.Equal(t, []int32{1, 2, 3}, iterate(it))
+		assert.NoError(t, it.Err())
+		it.Close()
+
+		hmock.AssertExpectations(t)
+	}
+}
+
+func TestIteratorErrors(t *testing.T) {
+	hmock := &MockClient{}
+	request := LedgerRequest{Limit: 2}
+	first := ledgersPage("/ledgers?cursor=2", 1, 2)
+
+	hmock.On("LedgersContext", mock.Anything, request).Return(first, nil).Once()
+	hmock.On("NextLedgersPageContext", mock.Anything, first).Return(hProtocol.LedgersPage{}, errors.New("kaboom")).Once()
+
+	it, err := NewIterator(context.Background(), hmock, request, IteratorOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []int32{1, 2}, iterate(it))
+	assert.EqualError(t, it.Err(), "kaboom")
+	it.Close()
+
+	// Cancelling the context stops the iterator
+	ctx, cancel := context.WithCancel(context.Background())
+	hmock.On("LedgersContext", mock.Anything, request).Return(first, nil).Once()
+
+	it, err = NewIterator(ctx, hmock, request, IteratorOptions{})
+	require.NoError(t, err)
+	assert.True(t, it.Next())
+	cancel()
+	assert.True(t, it.Next())
+	assert.False(t, it.Next())
+	assert.Equal(t, context.Canceled, it.Err())
+
+	// Cancelling the context cancels the request in flight
+	ctx, cancel = context.WithCancel(context.Background())
+	requestCancelled := make(chan struct{})
+	hmock.On("LedgersContext", mock.Anything, request).
+		Run(func(args mock.Arguments) {
+			requestCtx := args.Get(0).(context.Context)
+			cancel()
+			<-requestCtx.Done()
+			close(requestCancelled)
+		}).
+		Return(hProtocol.LedgersPage{}, context.Canceled).Once()
+
+	it, err = NewIterator(ctx, hmock, request, IteratorOptions{})
+	require.NoError(t, err)
+	assert.False(t, it.Next())
+	assert.Equal(t, context.Canceled, it.Err())
+	select {
+	case <-requestCancelled:
+	case <-time.After(time.Second):
+		t.Fatal("the request of the iterator was not cancelled")
+	}
+
+	_, err = NewIterator(context.Background(), hmock, AccountRequest{}, IteratorOptions{})
+	assert.EqualError(t, err, "Unsupported request type horizonclient.AccountRequest")
+
+	hmock.AssertExpectations(t)
+}
+
+func TestIteratorPayments(t *testing.T) {
+	hmock := &MockClient{}
+	request := OperationRequest{ForAccount: "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU"}
+	request.SetPaymentsEndpoint()
+
+	var page operations.OperationsPage
+	page.Embedded.Records = []operations.Operation{operations.Payment{Amount: "10"}}
+	hmock.On("PaymentsContext", mock.Anything, request).Return(page, nil).Once()
+	hmock.On("NextOperationsPageContext", mock.Anything, mock.Anything).Return(operations.OperationsPage{}, nil).Once()
+
+	it, err := NewIterator(context.Background(), hmock, request, IteratorOptions{})
+	require.NoError(t, err)
+	require.True(t, it.Next())
+	assert.Equal(t, "10", it.Record().(operations.Payment).Amount)
+	assert.False(t, it.Next())
+	assert.NoError(t, it.Err())
+
+	hmock.AssertExpectations(t)
+}
+
+func TestNextPrevPage(t *testing.T) {
+	hmock := httptest.NewClient()
+	client := &Client{
+		HorizonURL: "https://localhost/",
+		HTTP:       hmock,
+	}
+
+	var page hProtocol.TransactionsPage
+	page.Links.Next = hal.NewLink("https://localhost/transactions?cursor=2&limit=1&order=asc")
+	page.Links.Prev = hal.NewLink("https://localhost/transactions?cursor=1&limit=1&order=desc")
+
+	hmock.On("GET", "https://localhost/transactions?cursor=2&limit=1&order=asc").
+		ReturnString(200, txPageResponse)
+
+	next, err := client.NextTransactionsPage(page)
+	if assert.NoError(t, err) {
+		assert.NotEmpty(t, next.Embedded.Records)
+	}
+
+	hmock.On("GET", "https://localhost/transactions?cursor=1&limit=1&order=desc").
+		ReturnString(200, txPageResponse)
+
+	prev, err := client.PrevTransactionsPage(page)
+	if assert.NoError(t, err) {
+		assert.Equal(t, next, prev)
+	}
+
+	_, err = client.NextTransactionsPage(hProtocol.TransactionsPage{})
+	assert.EqualError(t, err, "No page link provided")
+}
@@ -40,8 +40,9 @@ func (lr LedgerRequest) BuildURL() (endpoint string, err error) {
 	return endpoint, err
 }
 
-// LedgerHandler is a function that is called when a new ledger is received
-type LedgerHandler func(hProtocol.Ledger)
+// LedgerHandler is a function that is called when a new ledger is received.
+// Returning an error stops the stream, and the error is returned by the Stream method.
+type LedgerHandler func(hProtocol.Ledger) error
 
 // StreamLedgers streams stellar ledgers. It can be used to stream all ledgers. Use context.WithCancel
 // to stop streaming or context.Background() if you want to stream indefinitely.
@@ -60,7 +61,6 @@ func (lr LedgerRequest) StreamLedgers(ctx context.Context, client *Client,
 		if err != nil {
 			return errors.Wrap(err, "Error unmarshaling data for ledger request")
 		}
-		handler(ledger)
-		return nil
+		return handler(ledger)
 	})
 }
@@ -119,8 +119,9 @@ func ExampleClient_StreamLedgers() {
 		cancel()
 	}()
 
-	printHandler := func(ledger hProtocol.Ledger) {
+	printHandler := func(ledger hProtocol.Ledger) error {
 		fmt.Println(ledger)
+		return nil
 	}
 	err := client.StreamLedgers(ctx, ledgerRequest, printHandler)
 	if err != nil {
@@ -142,10 +143,11 @@ func TestLedgerRequestStreamLedgers(t *testing.T) {
 	).ReturnString(200, ledgerStreamResponse)
 
 	ledgers := make([]hProtocol.Ledger, 1)
-	err := client.StreamLedgers(ctx, ledgerRequest, func(ledger hProtocol.Ledger) {
+	err := client.StreamLedgers(ctx, ledgerRequest, func(ledger hProtocol.Ledger) error {
 		ledgers[0] = ledger
 		cancel()
 
+		return nil
 	})
 
 	if assert.NoError(t, err) {
@@ -161,10 +163,11 @@ func TestLedgerRequestStreamLedgers(t *testing.T) {
 		"https://localhost/ledgers?cursor=now",
 	).ReturnString(500, ledgerStreamResponse)
 
-	err = client.StreamLedgers(ctx, ledgerRequest, func(ledger hProtocol.Ledger) {
+	err = client.StreamLedgers(ctx, ledgerRequest, func(ledger hProtocol.Ledger) error {
 		ledgers[0] = ledger
 		cancel()
 
+		return nil
 	})
 
 	if assert.Error(t, err) {
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"net/http"
 	"net/url"
+	"sync"
 	"time"
 
 	hProtocol "github.com/stellar/go/protocols/horizon"
@@ -105,30 +106,103 @@ type Client struct {
 	horizonTimeOut time.Duration
 	AppName        string
 	AppVersion     string
+	// StreamStateHandler, if set, is called whenever the connection state of a stream changes.
+	// err is the error that caused the stream to disconnect or close, if any.
+	StreamStateHandler func(state StreamState, err error)
+	// MaxRetries is the number of times a request is sent again when horizon answers it with 429
+	// Too Many Requests or 503 Service Unavailable. Zero means requests are not retried.
+	MaxRetries int
+	// WaitForRateLimit makes the client wait until the rate limit resets, instead of sending a
+	// request that horizon would reject, once no request remains. See RateLimit.
+	WaitForRateLimit bool
+	// AccountCacheTTL is how long the responses of AccountDetail and AccountData are cached.
+	// Zero disables the cache. The cache is cleared whenever a transaction is submitted.
+	AccountCacheTTL time.Duration
+
+	rateLimitMutex sync.Mutex
+	rateLimit      RateLimit
+	accountCache   accountCache
 }
 
 // ClientInterface contains methods implemented by the horizon client
 type ClientInterface interface {
 	AccountDetail(request AccountRequest) (hProtocol.Account, error)
+	AccountDetailContext(ctx context.Context, request AccountRequest) (hProtocol.Account, error)
 	AccountData(request AccountRequest) (hProtocol.AccountData, error)
+	AccountDataContext(ctx context.Context, request AccountRequest) (hProtocol.AccountData, error)
+	AccountDataValue(request AccountRequest) ([]byte, error)
+	AccountDataValueContext(ctx context.Context, request AccountRequest) ([]byte, error)
+	AccountSigners(request AccountRequest) ([]hProtocol.Signer, error)
+	AccountSignersContext(ctx context.Context, request AccountRequest) ([]hProtocol.Signer, error)
+	AccountThresholds(request AccountRequest) (hProtocol.AccountThresholds, error)
+	AccountThresholdsContext(ctx context.Context, request AccountRequest) (hProtocol.AccountThresholds, error)
 	Effects(request EffectRequest) (hProtocol.EffectsPage, error)
+	EffectsContext(ctx context.Context, request EffectRequest) (hProtocol.EffectsPage, error)
+	NextEffectsPage(page hProtocol.EffectsPage) (hProtocol.EffectsPage, error)
+	NextEffectsPageContext(ctx context.Context, page hProtocol.EffectsPage) (hProtocol.EffectsPage, error)
+	PrevEffectsPage(page hProtocol.EffectsPage) (hProtocol.EffectsPage, error)
+	PrevEffectsPageContext(ctx context.Context, page hProtocol.EffectsPage) (hProtocol.EffectsPage, error)
 	Assets(request AssetRequest) (hProtocol.AssetsPage, error)
+	AssetsContext(ctx context.Context, request AssetRequest) (hProtocol.AssetsPage, error)
+	NextAssetsPage(page hProtocol.AssetsPage) (hProtocol.AssetsPage, error)
+	NextAssetsPageContext(ctx context.Context, page hProtocol.AssetsPage) (hProtocol.AssetsPage, error)
+	PrevAssetsPage(page hProtocol.AssetsPage) (hProtocol.AssetsPage, error)
+	PrevAssetsPageContext(ctx context.Context, page hProtocol.AssetsPage) (hProtocol.AssetsPage, error)
 	Ledgers(request LedgerRequest) (hProtocol.LedgersPage, error)
+	LedgersContext(ctx context.Context, request LedgerRequest) (hProtocol.LedgersPage, error)
+	NextLedgersPage(page hProtocol.LedgersPage) (hProtocol.LedgersPage, error)
+	NextLedgersPageContext(ctx context.Context, page hProtocol.LedgersPage) (hProtocol.LedgersPage, error)
+	PrevLedgersPage(page hProtocol.LedgersPage) (hProtocol.LedgersPage, error)
+	PrevLedgersPageContext(ctx context.Context, page hProtocol.LedgersPage) (hProtocol.LedgersPage, error)
 	LedgerDetail(sequence uint32) (hProtocol.Ledger, error)
+	LedgerDetailContext(ctx context.Context, sequence uint32) (hProtocol.Ledger, error)
 	Metrics() (hProtocol.Metrics, error)
+	MetricsContext(ctx context.Context) (hProtocol.Metrics, error)
+	Root() (hProtocol.Root, error)
+	RootContext(ctx context.Context) (hProtocol.Root, error)
 	Stream(ctx context.Context, request StreamRequest, handler func(interface{})) error
 	FeeStats() (hProtocol.FeeStats, error)
+	FeeStatsContext(ctx context.Context) (hProtocol.FeeStats, error)
 	Offers(request OfferRequest) (hProtocol.OffersPage, error)
+	OffersContext(ctx context.Context, request OfferRequest) (hProtocol.OffersPage, error)
+	NextOffersPage(page hProtocol.OffersPage) (hProtocol.OffersPage, error)
+	NextOffersPageContext(ctx context.Context, page hProtocol.OffersPage) (hProtocol.OffersPage, error)
+	PrevOffersPage(page hProtocol.OffersPage) (hProtocol.OffersPage, error)
+	PrevOffersPageContext(ctx context.Context, page hProtocol.OffersPage) (hProtocol.OffersPage, error)
+	OfferDetail(offerID string) (hProtocol.Offer, error)
+	OfferDetailContext(ctx context.Context, offerID string) (hProtocol.Offer, error)
 	Operations(request OperationRequest) (operations.OperationsPage, error)
+	OperationsContext(ctx context.Context, request OperationRequest) (operations.OperationsPage, error)
+	NextOperationsPage(page operations.OperationsPage) (operations.OperationsPage, error)
+	NextOperationsPageContext(ctx context.Context, page operations.OperationsPage) (operations.OperationsPage, error)
+	PrevOperationsPage(page operations.OperationsPage) (operations.OperationsPage, error)
+	PrevOperationsPageContext(ctx context.Context, page operations.OperationsPage) (operations.OperationsPage, error)
 	OperationDetail(id string) (operations.Operation, error)
+	OperationDetailContext(ctx context.Context, id string) (operations.Operation, error)
 	SubmitTransaction(transactionXdr string) (hProtocol.TransactionSuccess, error)
+	SubmitTransactionContext(ctx context.Context, transactionXdr string) (hProtocol.TransactionSuccess, error)
 	Transactions(request TransactionRequest) (hProtocol.TransactionsPage, error)
+	TransactionsContext(ctx context.Context, request TransactionRequest) (hProtocol.TransactionsPage, error)
+	NextTransactionsPage(page hProtocol.TransactionsPage) (hProtocol.TransactionsPage, error)
+	NextTransactionsPageContext(ctx context.Context, page hProtocol.TransactionsPage) (hProtocol.TransactionsPage, error)
+	PrevTransactionsPage(page hProtocol.TransactionsPage) (hProtocol.TransactionsPage, error)
+	PrevTransactionsPageContext(ctx context.Context, page hProtocol.TransactionsPage) (hProtocol.TransactionsPage, error)
 	TransactionDetail(txHash string) (hProtocol.Transaction, error)
+	TransactionDetailContext(ctx context.Context, txHash string) (hProtocol.Transaction, error)
 	OrderBook(request OrderBookRequest) (hProtocol.OrderBookSummary, error)
+	OrderBookContext(ctx context.Context, request OrderBookRequest) (hProtocol.OrderBookSummary, error)
 	Paths(request PathsRequest) (hProtocol.PathsPage, error)
+	PathsContext(ctx context.Context, request PathsRequest) (hProtocol.PathsPage, error)
 	Payments(request OperationRequest) (operations.OperationsPage, error)
+	PaymentsContext(ctx context.Context, request OperationRequest) (operations.OperationsPage, error)
 	TradeAggregations(request TradeAggregationRequest) (hProtocol.TradeAggregationsPage, error)
+	TradeAggregationsContext(ctx context.Context, request TradeAggregationRequest) (hProtocol.TradeAggregationsPage, error)
 	Trades(request TradeRequest) (hProtocol.TradesPage, error)
+	TradesContext(ctx context.Context, request TradeRequest) (hProtocol.TradesPage, error)
+	NextTradesPage(page hProtocol.TradesPage) (hProtocol.TradesPage, error)
+	NextTradesPageContext(ctx context.Context, page hProtocol.TradesPage) (hProtocol.TradesPage, error)
+	PrevTradesPage(page hProtocol.TradesPage) (hProtocol.TradesPage, error)
+	PrevTradesPageContext(ctx context.Context, page hProtocol.TradesPage) (hProtocol.TradesPage, error)
 	StreamTransactions(ctx context.Context, request TransactionRequest, handler TransactionHandler) error
 	StreamTrades(ctx context.Context, request TradeRequest, handler TradeHandler) error
 	StreamEffects(ctx context.Context, request EffectRequest, handler EffectHandler) error
@@ -207,12 +281,15 @@ type feeStatsRequest struct {
 	endpoint string
 }
 
+type rootRequest struct{}
+
 // OfferRequest struct contains data for getting offers made by an account from an horizon server
 type OfferRequest struct {
 	ForAccount string
 	Order      Order
 	Cursor     string
 	Limit      uint
+	forOfferID string
 }
 
 // OperationRequest struct contains data for getting operation details from an horizon servers
@@ -1,14 +1,19 @@
 package horizonclient
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"testing"
 
+	"github.com/jarcoal/httpmock"
 	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/protocols/horizon/effects"
 	"github.com/stellar/go/protocols/horizon/operations"
 	"github.com/stellar/go/support/errors"
 	"github.com/stellar/go/support/http/httptest"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func ExampleClient_AccountDetail() {
@@ -361,6 +366,43 @@ func TestAccountDetail(t *testing.T) {
 	}
 }
 
+func TestRequestContext(t *testing.T) {
+	hmock := httptest.NewClient()
+	client := &Client{
+		HorizonURL: "https://localhost/",
+		HTTP:       hmock,
+	}
+
+	type contextKey string
+	hmock.On(
+		"GET",
+		"https://localhost/accounts/GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU",
+	).Return(func(req *http.Request) (*http.Response, error) {
+		// The context of the request holds the values of the context of the call
+		if req.Context().Value(contextKey("trace")) != "abc" {
+			return nil, errors.New("context not passed")
+		}
+		if err := req.Context().Err(); err != nil {
+			return nil, err
+		}
+		return httpmock.NewStringResponse(200, accountResponse), nil
+	})
+
+	accountRequest := AccountRequest{AccountID: "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU"}
+	ctx := context.WithValue(context.Background(), contextKey("trace"), "abc")
+	account, err := client.AccountDetailContext(ctx, accountRequest)
+	require.NoError(t, err)
+	assert.Equal(t, "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU", account.AccountID)
+
+	// Requests are cancelled with their context
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+	_, err = client.AccountDetailContext(ctx, accountRequest)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), context.Canceled.Error())
+	}
+}
+
 func TestAccountData(t *testing.T) {
 	hmock := httptest.NewClient()
 	client := &Client{
@@ -424,10 +466,17 @@ func TestEffectsRequest(t *testing.T) {
 		"https://localhost/effects",
 	).ReturnString(200, effectsResponse)
 
-	effects, err := client.Effects(effectRequest)
+	effs, err := client.Effects(effectRequest)
 	if assert.NoError(t, err) {
-		assert.IsType(t, effects, hProtocol.EffectsPage{})
-
+		assert.IsType(t, effs, hProtocol.EffectsPage{})
+		records := effs.Embedded.Records
+		if assert.Len(t, records, 3) {
+			assert.IsType(t, effects.AccountDebited{}, records[0])
+			assert.IsType(t, effects.AccountCredited{}, records[1])
+			assert.IsType(t, effects.AccountRemoved{}, records[2])
+			assert.Equal(t, "9999.9999900", records[1].(effects.AccountCredited).Amount)
+			assert.Equal(t, "GANHAS5OMPLKD6VYU4LK7MBHSHB2Q37ZHAYWOBJRUXGDHMPJF3XNT45Y", records[2].GetAccount())
+		}
 	}
 
 	effectRequest = EffectRequest{ForAccount: "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU"}
@@ -436,9 +485,9 @@ func TestEffectsRequest(t *testing.T) {
 		"https://localhost/accounts/GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU/effects",
 	).ReturnString(200, effectsResponse)
 
-	effects, err = client.Effects(effectRequest)
+	effs, err = client.Effects(effectRequest)
 	if assert.NoError(t, err) {
-		assert.IsType(t, effects, hProtocol.EffectsPage{})
+		assert.IsType(t, effs, hProtocol.EffectsPage{})
 	}
 
 	// too many parameters
@@ -19,24 +19,132 @@ func (m *MockClient) AccountDetail(request AccountRequest) (hProtocol.Account, e
 	return a.Get(0).(hProtocol.Account), a.Error(1)
 }
 
+// AccountDetailContext is a mocking method
+func (m *MockClient) AccountDetailContext(ctx context.Context, request AccountRequest) (hProtocol.Account, error) {
+	a := m.Called(ctx, request)
+	return a.Get(0).(hProtocol.Account), a.Error(1)
+}
+
 // AccountData is a mocking method
 func (m *MockClient) AccountData(request AccountRequest) (hProtocol.AccountData, error) {
 	a := m.Called(request)
 	return a.Get(0).(hProtocol.AccountData), a.Error(1)
 }
 
+// AccountDataContext is a mocking method
+func (m *MockClient) AccountDataContext(ctx context.Context, request AccountRequest) (hProtocol.AccountData, error) {
+	a := m.Called(ctx, request)
+	return a.Get(0).(hProtocol.AccountData), a.Error(1)
+}
+
+// AccountDataValue is a mocking method
+func (m *MockClient) AccountDataValue(request AccountRequest) ([]byte, error) {
+	a := m.Called(request)
+	return a.Get(0).([]byte), a.Error(1)
+}
+
+// AccountDataValueContext is a mocking method
+func (m *MockClient) AccountDataValueContext(ctx context.Context, request AccountRequest) ([]byte, error) {
+	a := m.Called(ctx, request)
+	return a.Get(0).([]byte), a.Error(1)
+}
+
+// AccountSigners is a mocking method
+func (m *MockClient) AccountSigners(request AccountRequest) ([]hProtocol.Signer, error) {
+	a := m.Called(request)
+	return a.Get(0).([]hProtocol.Signer), a.Error(1)
+}
+
+// AccountSignersContext is a mocking method
+func (m *MockClient) AccountSignersContext(ctx context.Context, request AccountRequest) ([]hProtocol.Signer, error) {
+	a := m.Called(ctx, request)
+	return a.Get(0).([]hProtocol.Signer), a.Error(1)
+}
+
+// AccountThresholds is a mocking method
+func (m *MockClient) AccountThresholds(request AccountRequest) (hProtocol.AccountThresholds, error) {
+	a := m.Called(request)
+	return a.Get(0).(hProtocol.AccountThresholds), a.Error(1)
+}
+
+// AccountThresholdsContext is a mocking method
+func (m *MockClient) AccountThresholdsContext(ctx context.Context, request AccountRequest) (hProtocol.AccountThresholds, error) {
+	a := m.Called(ctx, request)
+	return a.Get(0).(hProtocol.AccountThresholds), a.Error(1)
+}
+
 // Effects is a mocking method
 func (m *MockClient) Effects(request EffectRequest) (hProtocol.EffectsPage, error) {
 	a := m.Called(request)
 	return a.Get(0).(hProtocol.EffectsPage), a.Error(1)
 }
 
+// EffectsContext is a mocking method
+func (m *MockClient) EffectsContext(ctx context.Context, request EffectRequest) (hProtocol.EffectsPage, error) {
+	a := m.Called(ctx, request)
+	return a.Get(0).(hProtocol.EffectsPage), a.Error(1)
+}
+
+// NextEffectsPage is a mocking method
+func (m *MockClient) NextEffectsPage(page hProtocol.EffectsPage) (hProtocol.EffectsPage, error) {
+	a := m.Called(page)
+	return a.Get(0).(hProtocol.EffectsPage), a.Error(1)
+}
+
+// NextEffectsPageContext is a mocking method
+func (m *MockClient) NextEffectsPageContext(ctx context.Context, page hProtocol.EffectsPage) (hProtocol.EffectsPage, error) {
+	a := m.Called(ctx, page)
+	return a.Get(0).(hProtocol.EffectsPage), a.Error(1)
+}
+
+// PrevEffectsPage is a mocking method
+func (m *MockClient) PrevEffectsPage(page hProtocol.EffectsPage) (hProtocol.EffectsPage, error) {
+	a := m.Called(page)
+	return a.Get(0).(hProtocol.EffectsPage), a.Error(1)
+}
+
+// PrevEffectsPageContext is a mocking method
+func (m *MockClient) PrevEffectsPageContext(ctx context.Context, page hProtocol.EffectsPage) (hProtocol.EffectsPage, error) {
+	a := m.Called(ctx, page)
+	return a.Get(0).(hProtocol.EffectsPage), a.Error(1)
+}
+
 // Assets is a mocking method
 func (m *MockClient) Assets(request AssetRequest) (hProtocol.AssetsPage, error) {
 	a := m.Called(request)
 	return a.Get(0).(hProtocol.AssetsPage), a.Error(1)
 }
 
+// AssetsContext is a mocking method
+func (m *MockClient) AssetsContext(ctx context.Context, request AssetRequest) (hProtocol.AssetsPage, error) {
+	a := m.Called(ctx, request)
+	return a.Get(0).(hProtocol.AssetsPage), a.Error(1)
+}
+
+// NextAssetsPage is a mocking method
+func (m *MockClient) NextAssetsPage(page hProtocol.AssetsPage) (hProtocol.AssetsPage, error) {
+	a := m.Called(page)
+	return a.Get(0).(hProtocol.AssetsPage), a.Error(1)
+}
+
+// NextAssetsPageContext is a mocking method
+func (m *MockClient) NextAssetsPageContext(ctx context.Context, page hProtocol.AssetsPage) (hProtocol.AssetsPage, error) {
+	a := m.Called(ctx, page)
+	return a.Get(0).(hProtocol.AssetsPage), a.Error(1)
+}
+
+// PrevAssetsPage is a mocking method
+func (m *MockClient) PrevAssetsPage(page hProtocol.AssetsPage) (hProtocol.AssetsPage, error) {
+	a := m.Called(page)
+	return a.Get(0).(hProtocol.AssetsPage), a.Error(1)
+}
+
+// PrevAssetsPageContext is a mocking method
+func (m *MockClient) PrevAssetsPageContext(ctx context.Context, page hProtocol.AssetsPage) (hProtocol.AssetsPage, error) {
+	a := m.Called(ctx, page)
+	return a.Get(0).(hProtocol.AssetsPage), a.Error(1)
+}
+
 // Stream is a mocking method
 func (m *MockClient) Stream(ctx context.Context,
 	request StreamRequest,
@@ -52,90 +160,324 @@ func (m *MockClient) Ledgers(request LedgerRequest) (hProtocol.LedgersPage, erro
 	return a.Get(0).(hProtocol.LedgersPage), a.Error(1)
 }
 
+// LedgersContext is a mocking method
+func (m *MockClient) LedgersContext(ctx context.Context, request LedgerRequest) (hProtocol.LedgersPage, error) {
+	a := m.Called(ctx, request)
+	return a.Get(0).(hProtocol.LedgersPage), a.Error(1)
+}
+
+// NextLedgersPage is a mocking method
+func (m *MockClient) NextLedgersPage(page hProtocol.LedgersPage) (hProtocol.LedgersPage, error) {
+	a := m.Called(page)
+	return a.Get(0).(hProtocol.LedgersPage), a.Error(1)
+}
+
+// NextLedgersPageContext is a mocking method
+func (m *MockClient) NextLedgersPageContext(ctx context.Context, page hProtocol.LedgersPage) (hProtocol.LedgersPage, error) {
+	a := m.Called(ctx, page)
+	return a.Get(0).(hProtocol.LedgersPage), a.Error(1)
+}
+
+// PrevLedgersPage is a mocking method
+func (m *MockClient) PrevLedgersPage(page hProtocol.LedgersPage) (hProtocol.LedgersPage, error) {
+	a := m.Called(page)
+	return a.Get(0).(hProtocol.LedgersPage), a.Error(1)
+}
+
+// PrevLedgersPageContext is a mocking method
+func (m *MockClient) PrevLedgersPageContext(ctx context.Context, page hProtocol.LedgersPage) (hProtocol.LedgersPage, error) {
+	a := m.Called(ctx, page)
+	return a.Get(0).(hProtocol.LedgersPage), a.Error(1)
+}
+
 // LedgerDetail is a mocking method
 func (m *MockClient) LedgerDetail(sequence uint32) (hProtocol.Ledger, error) {
 	a := m.Called(sequence)
 	return a.Get(0).(hProtocol.Ledger), a.Error(1)
 }
 
+// LedgerDetailContext is a mocking method
+func (m *MockClient) LedgerDetailContext(ctx context.Context, sequence uint32) (hProtocol.Ledger, error) {
+	a := m.Called(ctx, sequence)
+	return a.Get(0).(hProtocol.Ledger), a.Error(1)
+}
+
 // Metrics is a mocking method
 func (m *MockClient) Metrics() (hProtocol.Metrics, error) {
 	a := m.Called()
 	return a.Get(0).(hProtocol.Metrics), a.Error(1)
 }
 
+// MetricsContext is a mocking method
+func (m *MockClient) MetricsContext(ctx context.Context) (hProtocol.Metrics, error) {
+	a := m.Called(ctx)
+	return a.Get(0).(hProtocol.Metrics), a.Error(1)
+}
+
+// Root is a mocking method
+func (m *MockClient) Root() (hProtocol.Root, error) {
+	a := m.Called()
+	return a.Get(0).(hProtocol.Root), a.Error(1)
+}
+
+// RootContext is a mocking method
+func (m *MockClient) RootContext(ctx context.Context) (hProtocol.Root, error) {
+	a := m.Called(ctx)
+	return a.Get(0).(hProtocol.Root), a.Error(1)
+}
+
 // FeeStats is a mocking method
 func (m *MockClient) FeeStats() (hProtocol.FeeStats, error) {
 	a := m.Called()
 	return a.Get(0).(hProtocol.FeeStats), a.Error(1)
 }
 
+// FeeStatsContext is a mocking method
+func (m *MockClient) FeeStatsContext(ctx context.Context) (hProtocol.FeeStats, error) {
+	a := m.Called(ctx)
+	return a.Get(0).(hProtocol.FeeStats), a.Error(1)
+}
+
 // Offers is a mocking method
 func (m *MockClient) Offers(request OfferRequest) (hProtocol.OffersPage, error) {
 	a := m.Called(request)
 	return a.Get(0).(hProtocol.OffersPage), a.Error(1)
 }
 
+// OffersContext is a mocking method
+func (m *MockClient) OffersContext(ctx context.Context, request OfferRequest) (hProtocol.OffersPage, error) {
+	a := m.Called(ctx, request)
+	return a.Get(0).(hProtocol.OffersPage), a.Error(1)
+}
+
+// NextOffersPage is a mocking method
+func (m *MockClient) NextOffersPage(page hProtocol.OffersPage) (hProtocol.OffersPage, error) {
+	a := m.Called(page)
+	return a.Get(0).(hProtocol.OffersPage), a.Error(1)
+}
+
+// NextOffersPageContext is a mocking method
+func (m *MockClient) NextOffersPageContext(ctx context.Context, page hProtocol.OffersPage) (hProtocol.OffersPage, error) {
+	a := m.Called(ctx, page)
+	return a.Get(0).(hProtocol.OffersPage), a.Error(1)
+}
+
+// PrevOffersPage is a mocking method
+func (m *MockClient) PrevOffersPage(page hProtocol.OffersPage) (hProtocol.OffersPage, error) {
+	a := m.Called(page)
+	return a.Get(0).(hProtocol.OffersPage), a.Error(1)
+}
+
+// PrevOffersPageContext is a mocking method
+func (m *MockClient) PrevOffersPageContext(ctx context.Context, page hProtocol.OffersPage) (hProtocol.OffersPage, error) {
+	a := m.Called(ctx, page)
+	return a.Get(0).(hProtocol.OffersPage), a.Error(1)
+}
+
+// OfferDetail is a mocking method
+func (m *MockClient) OfferDetail(offerID string) (hProtocol.Offer, error) {
+	a := m.Called(offerID)
+	return a.Get(0).(hProtocol.Offer), a.Error(1)
+}
+
+// OfferDetailContext is a mocking method
+func (m *MockClient) OfferDetailContext(ctx context.Context, offerID string) (hProtocol.Offer, error) {
+	a := m.Called(ctx, offerID)
+	return a.Get(0).(hProtocol.Offer), a.Error(1)
+}
+
 // Operations is a mocking method
 func (m *MockClient) Operations(request OperationRequest) (operations.OperationsPage, error) {
 	a := m.Called(request)
 	return a.Get(0).(operations.OperationsPage), a.Error(1)
 }
 
+// OperationsContext is a mocking method
+func (m *MockClient) OperationsContext(ctx context.Context, request OperationRequest) (operations.OperationsPage, error) {
+	a := m.Called(ctx, request)
+	return a.Get(0).(operations.OperationsPage), a.Error(1)
+}
+
+// NextOperationsPage is a mocking method
+func (m *MockClient) NextOperationsPage(page operations.OperationsPage) (operations.OperationsPage, error) {
+	a := m.Called(page)
+	return a.Get(0).(operations.OperationsPage), a.Error(1)
+}
+
+// NextOperationsPageContext is a mocking method
+func (m *MockClient) NextOperationsPageContext(ctx context.Context, page operations.OperationsPage) (operations.OperationsPage, error) {
+	a := m.Called(ctx, page)
+	return a.Get(0).(operations.OperationsPage), a.Error(1)
+}
+
+// PrevOperationsPage is a mocking method
+func (m *MockClient) PrevOperationsPage(page operations.OperationsPage) (operations.OperationsPage, error) {
+	a := m.Called(page)
+	return a.Get(0).(operations.OperationsPage), a.Error(1)
+}
+
+// PrevOperationsPageContext is a mocking method
+func (m *MockClient) PrevOperationsPageContext(ctx context.Context, page operations.OperationsPage) (operations.OperationsPage, error) {
+	a := m.Called(ctx, page)
+	return a.Get(0).(operations.OperationsPage), a.Error(1)
+}
+
 // OperationDetail is a mocking method
 func (m *MockClient) OperationDetail(id string) (operations.Operation, error) {
 	a := m.Called(id)
 	return a.Get(0).(operations.Operation), a.Error(1)
 }
 
+// OperationDetailContext is a mocking method
+func (m *MockClient) OperationDetailContext(ctx context.Context, id string) (operations.Operation, error) {
+	a := m.Called(ctx, id)
+	return a.Get(0).(operations.Operation), a.Error(1)
+}
+
 // SubmitTransaction is a mocking method
 func (m *MockClient) SubmitTransaction(transactionXdr string) (hProtocol.TransactionSuccess, error) {
 	a := m.Called(transactionXdr)
 	return a.Get(0).(hProtocol.TransactionSuccess), a.Error(1)
 }
 
+// SubmitTransactionContext is a mocking method
+func (m *MockClient) SubmitTransactionContext(ctx context.Context, transactionXdr string) (hProtocol.TransactionSuccess, error) {
+	a := m.Called(ctx, transactionXdr)
+	return a.Get(0).(hProtocol.TransactionSuccess), a.Error(1)
+}
+
 // Transactions is a mocking method
 func (m *MockClient) Transactions(request TransactionRequest) (hProtocol.TransactionsPage, error) {
 	a := m.Called(request)
 	return a.Get(0).(hProtocol.TransactionsPage), a.Error(1)
 }
 
+// TransactionsContext is a mocking method
+func (m *MockClient) TransactionsContext(ctx context.Context, request TransactionRequest) (hProtocol.TransactionsPage, error) {
+	a := m.Called(ctx, request)
+	return a.Get(0).(hProtocol.TransactionsPage), a.Error(1)
+}
+
+// NextTransactionsPage is a mocking method
+func (m *MockClient) NextTransactionsPage(page hProtocol.TransactionsPage) (hProtocol.TransactionsPage, error) {
+	a := m.Called(page)
+	return a.Get(0).(hProtocol.TransactionsPage), a.Error(1)
+}
+
+// NextTransactionsPageContext is a mocking method
+func (m *MockClient) NextTransactionsPageContext(ctx context.Context, page hProtocol.TransactionsPage) (hProtocol.TransactionsPage, error) {
+	a := m.Called(ctx, page)
+	return a.Get(0).(hProtocol.TransactionsPage), a.Error(1)
+}
+
+// PrevTransactionsPage is a mocking method
+func (m *MockClient) PrevTransactionsPage(page hProtocol.TransactionsPage) (hProtocol.TransactionsPage, error) {
+	a := m.Called(page)
+	return a.Get(0).(hProtocol.TransactionsPage), a.Error(1)
+}
+
+// PrevTransactionsPageContext is a mocking method
+func (m *MockClient) PrevTransactionsPageContext(ctx context.Context, page hProtocol.TransactionsPage) (hProtocol.TransactionsPage, error) {
+	a := m.Called(ctx, page)
+	return a.Get(0).(hProtocol.TransactionsPage), a.Error(1)
+}
+
 // TransactionDetail is a mocking method
 func (m *MockClient) TransactionDetail(txHash string) (hProtocol.Transaction, error) {
 	a := m.Called(txHash)
 	return a.Get(0).(hProtocol.Transaction), a.Error(1)
 }
 
+// TransactionDetailContext is a mocking method
+func (m *MockClient) TransactionDetailContext(ctx context.Context, txHash string) (hProtocol.Transaction, error) {
+	a := m.Called(ctx, txHash)
+	return a.Get(0).(hProtocol.Transaction), a.Error(1)
+}
+
 // OrderBook is a mocking method
 func (m *MockClient) OrderBook(request OrderBookRequest) (hProtocol.OrderBookSummary, error) {
 	a := m.Called(request)
 	return a.Get(0).(hProtocol.OrderBookSummary), a.Error(1)
 }
 
+// OrderBookContext is a mocking method
+func (m *MockClient) OrderBookContext(ctx context.Context, request OrderBookRequest) (hProtocol.OrderBookSummary, error) {
+	a := m.Called(ctx, request)
+	return a.Get(0).(hProtocol.OrderBookSummary), a.Error(1)
+}
+
 // Paths is a mocking method
 func (m *MockClient) Paths(request PathsRequest) (hProtocol.PathsPage, error) {
 	a := m.Called(request)
 	return a.Get(0).(hProtocol.PathsPage), a.Error(1)
 }
 
+// PathsContext is a mocking method
+func (m *MockClient) PathsContext(ctx context.Context, request PathsRequest) (hProtocol.PathsPage, error) {
+	a := m.Called(ctx, request)
+	return a.Get(0).(hProtocol.PathsPage), a.Error(1)
+}
+
 // Payments is a mocking method
 func (m *MockClient) Payments(request OperationRequest) (operations.OperationsPage, error) {
 	a := m.Called(request)
 	return a.Get(0).(operations.OperationsPage), a.Error(1)
 }
 
+// PaymentsContext is a mocking method
+func (m *MockClient) PaymentsContext(ctx context.Context, request OperationRequest) (operations.OperationsPage, error) {
+	a := m.Called(ctx, request)
+	return a.Get(0).(operations.OperationsPage), a.Error(1)
+}
+
 // TradeAggregations is a mocking method
 func (m *MockClient) TradeAggregations(request TradeAggregationRequest) (hProtocol.TradeAggregationsPage, error) {
 	a := m.Called(request)
 	return a.Get(0).(hProtocol.TradeAggregationsPage), a.Error(1)
 }
 
+// TradeAggregationsContext is a mocking method
+func (m *MockClient) TradeAggregationsContext(ctx context.Context, request TradeAggregationRequest) (hProtocol.TradeAggregationsPage, error) {
+	a := m.Called(ctx, request)
+	return a.Get(0).(hProtocol.TradeAggregationsPage), a.Error(1)
+}
+
 // Trades is a mocking method
 func (m *MockClient) Trades(request TradeRequest) (hProtocol.TradesPage, error) {
 	a := m.Called(request)
 	return a.Get(0).(hProtocol.TradesPage), a.Error(1)
 }
 
+// TradesContext is a mocking method
+func (m *MockClient) TradesContext(ctx context.Context, request TradeRequest) (hProtocol.TradesPage, error) {
+	a := m.Called(ctx, request)
+	return a.Get(0).(hProtocol.TradesPage), a.Error(1)
+}
+
+// NextTradesPage is a mocking method
+func (m *MockClient) NextTradesPage(page hProtocol.TradesPage) (hProtocol.TradesPage, error) {
+	a := m.Called(page)
+	return a.Get(0).(hProtocol.TradesPage), a.Error(1)
+}
+
+// NextTradesPageContext is a mocking method
+func (m *MockClient) NextTradesPageContext(ctx context.Context, page hProtocol.TradesPage) (hProtocol.TradesPage, error) {
+	a := m.Called(ctx, page)
+	return a.Get(0).(hProtocol.TradesPage), a.Error(1)
+}
+
+// PrevTradesPage is a mocking method
+func (m *MockClient) PrevTradesPage(page hProtocol.TradesPage) (hProtocol.TradesPage, error) {
+	a := m.Called(page)
+	return a.Get(0).(hProtocol.TradesPage), a.Error(1)
+}
+
+// PrevTradesPageContext is a mocking method
+func (m *MockClient) PrevTradesPageContext(ctx context.Context, page hProtocol.TradesPage) (hProtocol.TradesPage, error) {
+	a := m.Called(ctx, page)
+	return a.Get(0).(hProtocol.TradesPage), a.Error(1)
+}
+
 // StreamTransactions is a mocking method
 func (m *MockClient) StreamTransactions(ctx context.Context, request TransactionRequest, handler TransactionHandler) error {
 	return m.Called(ctx, request, handler).Error(0)
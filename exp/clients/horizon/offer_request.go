@@ -11,7 +11,15 @@ import (
 )
 
 // BuildURL creates the endpoint to be queried based on the data in the OfferRequest struct.
+// If forOfferID is set, the endpoint for the details of that offer is returned.
 func (or OfferRequest) BuildURL() (endpoint string, err error) {
+	if or.forOfferID != "" {
+		if or.ForAccount != "" {
+			return endpoint, errors.New("Invalid request. Too many parameters")
+		}
+		return fmt.Sprintf("offers/%s", or.forOfferID), nil
+	}
+
 	if or.ForAccount == "" {
 		return endpoint, errors.New(`"ForAccount" parameter required`)
 	}
@@ -30,8 +38,9 @@ func (or OfferRequest) BuildURL() (endpoint string, err error) {
 	return endpoint, err
 }
 
-// OfferHandler is a function that is called when a new offer is received
-type OfferHandler func(hProtocol.Offer)
+// OfferHandler is a function that is called when a new offer is received.
+// Returning an error stops the stream, and the error is returned by the Stream method.
+type OfferHandler func(hProtocol.Offer) error
 
 // StreamOffers streams offers processed by the Stellar network for an account. Use context.WithCancel
 // to stop streaming or context.Background() if you want to stream indefinitely.
@@ -50,7 +59,6 @@ func (or OfferRequest) StreamOffers(ctx context.Context, client *Client, handler
 		if err != nil {
 			return errors.Wrap(err, "Error unmarshaling data for offers request")
 		}
-		handler(offer)
-		return nil
+		return handler(offer)
 	})
 }
@@ -27,6 +27,37 @@ func TestOfferRequestBuildUrl(t *testing.T) {
 	// It should return valid offers endpoint and no errors
 	require.NoError(t, err)
 	assert.Equal(t, "accounts/GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU/offers?cursor=now&order=desc", endpoint)
+
+	er = OfferRequest{forOfferID: "12345"}
+	endpoint, err = er.BuildURL()
+
+	// It should return valid offer details endpoint and no errors
+	require.NoError(t, err)
+	assert.Equal(t, "offers/12345", endpoint)
+
+	er = OfferRequest{ForAccount: "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU", forOfferID: "12345"}
+	_, err = er.BuildURL()
+	assert.EqualError(t, err, "Invalid request. Too many parameters")
+}
+
+func TestOfferDetail(t *testing.T) {
+	hmock := httptest.NewClient()
+	client := &Client{
+		HorizonURL: "https://localhost/",
+		HTTP:       hmock,
+	}
+
+	hmock.On("GET", "https://localhost/offers/12345").
+		ReturnString(200, `{"id": 12345, "paging_token": "12345", "seller": "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU", "amount": "10.0000000"}`)
+
+	offer, err := client.OfferDetail("12345")
+	if assert.NoError(t, err) {
+		assert.Equal(t, int64(12345), offer.ID)
+		assert.Equal(t, "10.0000000", offer.Amount)
+	}
+
+	_, err = client.OfferDetail("")
+	assert.EqualError(t, err, "No offer ID provided")
 }
 
 func ExampleClient_StreamOffers() {
@@ -41,8 +72,9 @@ func ExampleClient_StreamOffers() {
 		cancel()
 	}()
 
-	printHandler := func(offer hProtocol.Offer) {
+	printHandler := func(offer hProtocol.Offer) error {
 		fmt.Println(offer)
+		return nil
 	}
 	err := client.StreamOffers(ctx, offerRequest, printHandler)
 	if err != nil {
@@ -68,9 +100,10 @@ func TestOfferRequestStreamOffers(t *testing.T) {
 	).ReturnString(200, offerStreamResponse)
 
 	offers := make([]hProtocol.Offer, 1)
-	err := client.StreamOffers(ctx, orRequest, func(offer hProtocol.Offer) {
+	err := client.StreamOffers(ctx, orRequest, func(offer hProtocol.Offer) error {
 		offers[0] = offer
 		cancel()
+		return nil
 	})
 
 	if assert.NoError(t, err) {
@@ -88,8 +121,9 @@ func TestOfferRequestStreamOffers(t *testing.T) {
 	).ReturnString(500, offerStreamResponse)
 
 	offers = make([]hProtocol.Offer, 1)
-	err = client.StreamOffers(ctx, orRequest, func(offer hProtocol.Offer) {
+	err = client.StreamOffers(ctx, orRequest, func(offer hProtocol.Offer) error {
 		cancel()
+		return nil
 	})
 
 	if assert.Error(t, err) {
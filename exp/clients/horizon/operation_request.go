@@ -72,8 +72,9 @@ func (op *OperationRequest) SetOperationsEndpoint() *OperationRequest {
 	return op.setEndpoint("operations")
 }
 
-// OperationHandler is a function that is called when a new operation is received
-type OperationHandler func(operations.Operation)
+// OperationHandler is a function that is called when a new operation is received.
+// Returning an error stops the stream, and the error is returned by the Stream method.
+type OperationHandler func(operations.Operation) error
 
 // StreamOperations streams stellar operations. It can be used to stream all operations or operations
 // for and account. Use context.WithCancel to stop streaming or context.Background() if you want to
@@ -98,7 +99,6 @@ func (op OperationRequest) StreamOperations(ctx context.Context, client *Client,
 			return errors.Wrap(err, "Unmarshaling to the correct operation type")
 		}
 
-		handler(ops)
-		return nil
+		return handler(ops)
 	})
 }
@@ -81,8 +81,9 @@ func ExampleClient_StreamOperations() {
 		cancel()
 	}()
 
-	printHandler := func(op operations.Operation) {
+	printHandler := func(op operations.Operation) error {
 		fmt.Println(op)
+		return nil
 	}
 	err := client.StreamOperations(ctx, opRequest, printHandler)
 	if err != nil {
@@ -102,8 +103,9 @@ func ExampleClient_StreamPayments() {
 		cancel()
 	}()
 
-	printHandler := func(op operations.Operation) {
+	printHandler := func(op operations.Operation) error {
 		fmt.Println(op)
+		return nil
 	}
 	err := client.StreamPayments(ctx, opRequest, printHandler)
 	if err != nil {
@@ -128,9 +130,10 @@ func TestOperationRequestStreamOperations(t *testing.T) {
 	).ReturnString(200, operationStreamResponse)
 
 	operationStream := make([]operations.Operation, 1)
-	err := client.StreamOperations(ctx, operationRequest, func(op operations.Operation) {
+	err := client.StreamOperations(ctx, operationRequest, func(op operations.Operation) error {
 		operationStream[0] = op
 		cancel()
+		return nil
 	})
 
 	if assert.NoError(t, err) {
@@ -146,9 +149,10 @@ func TestOperationRequestStreamOperations(t *testing.T) {
 		"https://localhost/accounts/GAIH3ULLFQ4DGSECF2AR555KZ4KNDGEKN4AFI4SU2M7B43MGK3QJZNSR/payments?cursor=now",
 	).ReturnString(200, operationStreamResponse)
 
-	err = client.StreamPayments(ctx, operationRequest, func(op operations.Operation) {
+	err = client.StreamPayments(ctx, operationRequest, func(op operations.Operation) error {
 		operationStream[0] = op
 		cancel()
+		return nil
 	})
 
 	if assert.NoError(t, err) {
@@ -166,9 +170,10 @@ func TestOperationRequestStreamOperations(t *testing.T) {
 		"https://localhost/operations?cursor=now",
 	).ReturnString(500, operationStreamResponse)
 
-	err = client.StreamOperations(ctx, operationRequest, func(op operations.Operation) {
+	err = client.StreamOperations(ctx, operationRequest, func(op operations.Operation) error {
 		operationStream[0] = op
 		cancel()
+		return nil
 	})
 
 	if assert.Error(t, err) {
@@ -37,8 +37,9 @@ func (obr OrderBookRequest) BuildURL() (endpoint string, err error) {
 	return endpoint, err
 }
 
-// OrderBookHandler is a function that is called when a new order summary is received
-type OrderBookHandler func(hProtocol.OrderBookSummary)
+// OrderBookHandler is a function that is called when a new order summary is received.
+// Returning an error stops the stream, and the error is returned by the Stream method.
+type OrderBookHandler func(hProtocol.OrderBookSummary) error
 
 // StreamOrderBooks streams the orderbook for a given asset pair. Use context.WithCancel
 // to stop streaming or context.Background() if you want to stream indefinitely.
@@ -56,7 +57,6 @@ func (obr OrderBookRequest) StreamOrderBooks(ctx context.Context, client *Client
 		if err != nil {
 			return errors.Wrap(err, "Error unmarshaling data for orderbook request")
 		}
-		handler(orderbook)
-		return nil
+		return handler(orderbook)
 	})
 }
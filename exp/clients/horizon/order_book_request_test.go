@@ -47,8 +47,9 @@ func ExampleClient_StreamOrderBooks() {
 		cancel()
 	}()
 
-	printHandler := func(orderbook hProtocol.OrderBookSummary) {
+	printHandler := func(orderbook hProtocol.OrderBookSummary) error {
 		fmt.Println(orderbook)
+		return nil
 	}
 	err := client.StreamOrderBooks(ctx, orderbookRequest, printHandler)
 	if err != nil {
@@ -71,9 +72,10 @@ func TestOrderBookRequestStreamOrderBooks(t *testing.T) {
 	).ReturnString(200, orderbookStreamResponse)
 
 	orderbooks := make([]hProtocol.OrderBookSummary, 1)
-	err := client.StreamOrderBooks(ctx, orderbookRequest, func(orderbook hProtocol.OrderBookSummary) {
+	err := client.StreamOrderBooks(ctx, orderbookRequest, func(orderbook hProtocol.OrderBookSummary) error {
 		orderbooks[0] = orderbook
 		cancel()
+		return nil
 	})
 
 	if assert.NoError(t, err) {
@@ -92,8 +94,9 @@ func TestOrderBookRequestStreamOrderBooks(t *testing.T) {
 		"https://localhost/order_book?cursor=now",
 	).ReturnString(500, orderbookStreamResponse)
 
-	err = client.StreamOrderBooks(ctx, orderbookRequest, func(orderbook hProtocol.OrderBookSummary) {
+	err = client.StreamOrderBooks(ctx, orderbookRequest, func(orderbook hProtocol.OrderBookSummary) error {
 		cancel()
+		return nil
 	})
 
 	if assert.Error(t, err) {
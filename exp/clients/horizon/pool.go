@@ -0,0 +1,882 @@
+package horizonclient
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/protocols/horizon/operations"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/render/hal"
+	"github.com/stellar/go/xdr"
+)
+
+const (
+	// DefaultPoolHealthCheckInterval is the default interval between two health checks of the
+	// servers of a Pool.
+	DefaultPoolHealthCheckInterval = 30 * time.Second
+
+	// DefaultPoolMaxIngestionLag is the default number of ledgers by which the history of a
+	// server of a Pool can lag behind its stellar-core instance.
+	DefaultPoolMaxIngestionLag = 10
+)
+
+// ErrEmptyPool is returned by the methods of a Pool that has no client.
+var ErrEmptyPool = errors.New("No horizon client in the pool")
+
+// Pool is a horizon client that sends requests to several horizon servers of the same network.
+//
+// The health of the servers is checked with their root resource: a server is healthy when its
+// history, history_latest_ledger, does not lag behind the latest ledger of its stellar-core
+// instance, core_latest_ledger, by more than MaxIngestionLag ledgers. Requests are sent to the
+// healthy server with the most recent history. When a server fails to handle a request, because
+// it can not be reached, is overloaded or answers with a server error, the server is considered
+// unhealthy until the next health check and the request is sent to the next server. Transaction
+// submissions whose outcome is unknown are only sent again once the transaction is not found in
+// a ledger, see SubmitTransaction.
+type Pool struct {
+	// Clients are the clients of the servers of the pool.
+	Clients []*Client
+	// HealthCheckInterval is the interval between two health checks of the servers. Health checks
+	// are made when requests are sent. It defaults to DefaultPoolHealthCheckInterval.
+	HealthCheckInterval time.Duration
+	// MaxIngestionLag is the number of ledgers by which the history of a healthy server can lag
+	// behind its stellar-core instance. It defaults to DefaultPoolMaxIngestionLag.
+	MaxIngestionLag int32
+
+	mutex   sync.Mutex
+	health  map[*Client]serverHealth
+	checked time.Time
+}
+
+// serverHealth is the health of a server of a Pool, as found by its last health check.
+type serverHealth struct {
+	healthy           bool
+	ledger            int32
+	networkPassphrase string
+}
+
+// CheckHealth loads the root resource of all the servers of the pool to check their health.
+func (p *Pool) CheckHealth() {
+	maxIngestionLag := p.MaxIngestionLag
+	if maxIngestionLag == 0 {
+		maxIngestionLag = DefaultPoolMaxIngestionLag
+	}
+
+	health := make([]serverHealth, len(p.Clients))
+	var wg sync.WaitGroup
+	for i, client := range p.Clients {
+		wg.Add(1)
+		go func(i int, client *Client) {
+			defer wg.Done()
+			root, err := client.Root()
+			health[i] = serverHealth{
+				healthy:           err == nil && root.CoreSequence > 0 && root.CoreSequence-root.HorizonSequence <= maxIngestionLag,
+				ledger:            root.HorizonSequence,
+				networkPassphrase: root.NetworkPassphrase,
+			}
+		}(i, client)
+	}
+	wg.Wait()
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.health = map[*Client]serverHealth{}
+	for i, client := range p.Clients {
+		p.health[client] = health[i]
+	}
+	p.checked = time.Now()
+}
+
+// clients returns the clients of the pool in the order requests are sent to them: the clients of
+// the healthy servers, most recent history first, then the clients of the unhealthy servers.
+func (p *Pool) clients() []*Client {
+	interval := p.HealthCheckInterval
+	if interval == 0 {
+		interval = DefaultPoolHealthCheckInterval
+	}
+
+	p.mutex.Lock()
+	stale := time.Since(p.checked) >= interval
+	p.mutex.Unlock()
+	if stale {
+		p.CheckHealth()
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	clients := append([]*Client(nil), p.Clients...)
+	sort.SliceStable(clients, func(i, j int) bool {
+		a, b := p.health[clients[i]], p.health[clients[j]]
+		if a.healthy != b.healthy {
+			return a.healthy
+		}
+		return a.healthy && a.ledger > b.ledger
+	})
+	return clients
+}
+
+// markUnhealthy marks the server of client as unhealthy until the next health check.
+func (p *Pool) markUnhealthy(client *Client) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	health := p.health[client]
+	health.healthy = false
+	p.health[client] = health
+}
+
+// networkPassphrase returns the network passphrase of the servers, as found by the last health
+// check, or an empty string if no server returned it.
+func (p *Pool) networkPassphrase() string {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for _, health := range p.health {
+		if health.networkPassphrase != "" {
+			return health.networkPassphrase
+		}
+	}
+	return ""
+}
+
+// do calls send with the clients of the pool, in order, until a server handles the request or
+// ctx is done.
+func (p *Pool) do(ctx context.Context, send func(client *Client) error) (err error) {
+	clients := p.clients()
+	if len(clients) == 0 {
+		return ErrEmptyPool
+	}
+
+	for _, client := range clients {
+		err = send(client)
+		if ctx.Err() != nil || !serverFailed(err) {
+			return err
+		}
+		p.markUnhealthy(client)
+	}
+	return err
+}
+
+// stream calls send with the client of the server with the most recent history. Streams
+// reconnect to the same server when they are disconnected.
+func (p *Pool) stream(send func(client *Client) error) error {
+	clients := p.clients()
+	if len(clients) == 0 {
+		return ErrEmptyPool
+	}
+	return send(clients[0])
+}
+
+// serverFailed returns true if err means that a server could not handle a request, which can
+// then be sent to another server.
+func serverFailed(err error) bool {
+	switch err := errors.Cause(err).(type) {
+	case nil:
+		return false
+	case net.Error:
+		return true
+	case *Error:
+		return err.Problem.Status >= http.StatusInternalServerError ||
+			err.Problem.Status == http.StatusTooManyRequests
+	}
+	return false
+}
+
+// rebaseLinks returns links pointing to the server of client instead of any server of the pool.
+func (p *Pool) rebaseLinks(links hal.Links, client *Client) hal.Links {
+	links.Self = p.rebaseLink(links.Self, client)
+	links.Next = p.rebaseLink(links.Next, client)
+	links.Prev = p.rebaseLink(links.Prev, client)
+	return links
+}
+
+func (p *Pool) rebaseLink(link hal.Link, client *Client) hal.Link {
+	for _, c := range p.Clients {
+		prefix := c.getHorizonURL()
+		if strings.HasPrefix(link.Href, prefix) {
+			link.Href = client.getHorizonURL() + strings.TrimPrefix(link.Href, prefix)
+			return link
+		}
+	}
+	return link
+}
+
+// AccountDetail is Client.AccountDetail, sent to the servers of the pool.
+func (p *Pool) AccountDetail(request AccountRequest) (account hProtocol.Account, err error) {
+	return p.AccountDetailContext(context.Background(), request)
+}
+
+// AccountDetailContext is Client.AccountDetailContext, sent to the servers of the pool.
+func (p *Pool) AccountDetailContext(ctx context.Context, request AccountRequest) (account hProtocol.Account, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		account, err = client.AccountDetailContext(ctx, request)
+		return
+	})
+	return
+}
+
+// AccountData is Client.AccountData, sent to the servers of the pool.
+func (p *Pool) AccountData(request AccountRequest) (accountData hProtocol.AccountData, err error) {
+	return p.AccountDataContext(context.Background(), request)
+}
+
+// AccountDataContext is Client.AccountDataContext, sent to the servers of the pool.
+func (p *Pool) AccountDataContext(ctx context.Context, request AccountRequest) (accountData hProtocol.AccountData, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		accountData, err = client.AccountDataContext(ctx, request)
+		return
+	})
+	return
+}
+
+// AccountDataValue is Client.AccountDataValue, sent to the servers of the pool.
+func (p *Pool) AccountDataValue(request AccountRequest) (value []byte, err error) {
+	return p.AccountDataValueContext(context.Background(), request)
+}
+
+// AccountDataValueContext is Client.AccountDataValueContext, sent to the servers of the pool.
+func (p *Pool) AccountDataValueContext(ctx context.Context, request AccountRequest) (value []byte, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		value, err = client.AccountDataValueContext(ctx, request)
+		return
+	})
+	return
+}
+
+// AccountSigners is Client.AccountSigners, sent to the servers of the pool.
+func (p *Pool) AccountSigners(request AccountRequest) (signers []hProtocol.Signer, err error) {
+	return p.AccountSignersContext(context.Background(), request)
+}
+
+// AccountSignersContext is Client.AccountSignersContext, sent to the servers of the pool.
+func (p *Pool) AccountSignersContext(ctx context.Context, request AccountRequest) (signers []hProtocol.Signer, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		signers, err = client.AccountSignersContext(ctx, request)
+		return
+	})
+	return
+}
+
+// AccountThresholds is Client.AccountThresholds, sent to the servers of the pool.
+func (p *Pool) AccountThresholds(request AccountRequest) (thresholds hProtocol.AccountThresholds, err error) {
+	return p.AccountThresholdsContext(context.Background(), request)
+}
+
+// AccountThresholdsContext is Client.AccountThresholdsContext, sent to the servers of the pool.
+func (p *Pool) AccountThresholdsContext(ctx context.Context, request AccountRequest) (thresholds hProtocol.AccountThresholds, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		thresholds, err = client.AccountThresholdsContext(ctx, request)
+		return
+	})
+	return
+}
+
+// Effects is Client.Effects, sent to the servers of the pool.
+func (p *Pool) Effects(request EffectRequest) (page hProtocol.EffectsPage, err error) {
+	return p.EffectsContext(context.Background(), request)
+}
+
+// EffectsContext is Client.EffectsContext, sent to the servers of the pool.
+func (p *Pool) EffectsContext(ctx context.Context, request EffectRequest) (page hProtocol.EffectsPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		page, err = client.EffectsContext(ctx, request)
+		return
+	})
+	return
+}
+
+// NextEffectsPage is Client.NextEffectsPage, sent to the servers of the pool.
+func (p *Pool) NextEffectsPage(page hProtocol.EffectsPage) (next hProtocol.EffectsPage, err error) {
+	return p.NextEffectsPageContext(context.Background(), page)
+}
+
+// NextEffectsPageContext is Client.NextEffectsPageContext, sent to the servers of the pool.
+func (p *Pool) NextEffectsPageContext(ctx context.Context, page hProtocol.EffectsPage) (next hProtocol.EffectsPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		page.Links = p.rebaseLinks(page.Links, client)
+		next, err = client.NextEffectsPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// PrevEffectsPage is Client.PrevEffectsPage, sent to the servers of the pool.
+func (p *Pool) PrevEffectsPage(page hProtocol.EffectsPage) (prev hProtocol.EffectsPage, err error) {
+	return p.PrevEffectsPageContext(context.Background(), page)
+}
+
+// PrevEffectsPageContext is Client.PrevEffectsPageContext, sent to the servers of the pool.
+func (p *Pool) PrevEffectsPageContext(ctx context.Context, page hProtocol.EffectsPage) (prev hProtocol.EffectsPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		page.Links = p.rebaseLinks(page.Links, client)
+		prev, err = client.PrevEffectsPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// Assets is Client.Assets, sent to the servers of the pool.
+func (p *Pool) Assets(request AssetRequest) (page hProtocol.AssetsPage, err error) {
+	return p.AssetsContext(context.Background(), request)
+}
+
+// AssetsContext is Client.AssetsContext, sent to the servers of the pool.
+func (p *Pool) AssetsContext(ctx context.Context, request AssetRequest) (page hProtocol.AssetsPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		page, err = client.AssetsContext(ctx, request)
+		return
+	})
+	return
+}
+
+// NextAssetsPage is Client.NextAssetsPage, sent to the servers of the pool.
+func (p *Pool) NextAssetsPage(page hProtocol.AssetsPage) (next hProtocol.AssetsPage, err error) {
+	return p.NextAssetsPageContext(context.Background(), page)
+}
+
+// NextAssetsPageContext is Client.NextAssetsPageContext, sent to the servers of the pool.
+func (p *Pool) NextAssetsPageContext(ctx context.Context, page hProtocol.AssetsPage) (next hProtocol.AssetsPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		page.Links = p.rebaseLinks(page.Links, client)
+		next, err = client.NextAssetsPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// PrevAssetsPage is Client.PrevAssetsPage, sent to the servers of the pool.
+func (p *Pool) PrevAssetsPage(page hProtocol.AssetsPage) (prev hProtocol.AssetsPage, err error) {
+	return p.PrevAssetsPageContext(context.Background(), page)
+}
+
+// PrevAssetsPageContext is Client.PrevAssetsPageContext, sent to the servers of the pool.
+func (p *Pool) PrevAssetsPageContext(ctx context.Context, page hProtocol.AssetsPage) (prev hProtocol.AssetsPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		page.Links = p.rebaseLinks(page.Links, client)
+		prev, err = client.PrevAssetsPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// Ledgers is Client.Ledgers, sent to the servers of the pool.
+func (p *Pool) Ledgers(request LedgerRequest) (page hProtocol.LedgersPage, err error) {
+	return p.LedgersContext(context.Background(), request)
+}
+
+// LedgersContext is Client.LedgersContext, sent to the servers of the pool.
+func (p *Pool) LedgersContext(ctx context.Context, request LedgerRequest) (page hProtocol.LedgersPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		page, err = client.LedgersContext(ctx, request)
+		return
+	})
+	return
+}
+
+// NextLedgersPage is Client.NextLedgersPage, sent to the servers of the pool.
+func (p *Pool) NextLedgersPage(page hProtocol.LedgersPage) (next hProtocol.LedgersPage, err error) {
+	return p.NextLedgersPageContext(context.Background(), page)
+}
+
+// NextLedgersPageContext is Client.NextLedgersPageContext, sent to the servers of the pool.
+func (p *Pool) NextLedgersPageContext(ctx context.Context, page hProtocol.LedgersPage) (next hProtocol.LedgersPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		page.Links = p.rebaseLinks(page.Links, client)
+		next, err = client.NextLedgersPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// PrevLedgersPage is Client.PrevLedgersPage, sent to the servers of the pool.
+func (p *Pool) PrevLedgersPage(page hProtocol.LedgersPage) (prev hProtocol.LedgersPage, err error) {
+	return p.PrevLedgersPageContext(context.Background(), page)
+}
+
+// PrevLedgersPageContext is Client.PrevLedgersPageContext, sent to the servers of the pool.
+func (p *Pool) PrevLedgersPageContext(ctx context.Context, page hProtocol.LedgersPage) (prev hProtocol.LedgersPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		page.Links = p.rebaseLinks(page.Links, client)
+		prev, err = client.PrevLedgersPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// LedgerDetail is Client.LedgerDetail, sent to the servers of the pool.
+func (p *Pool) LedgerDetail(sequence uint32) (ledger hProtocol.Ledger, err error) {
+	return p.LedgerDetailContext(context.Background(), sequence)
+}
+
+// LedgerDetailContext is Client.LedgerDetailContext, sent to the servers of the pool.
+func (p *Pool) LedgerDetailContext(ctx context.Context, sequence uint32) (ledger hProtocol.Ledger, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		ledger, err = client.LedgerDetailContext(ctx, sequence)
+		return
+	})
+	return
+}
+
+// Metrics is Client.Metrics, sent to the servers of the pool.
+func (p *Pool) Metrics() (metrics hProtocol.Metrics, err error) {
+	return p.MetricsContext(context.Background())
+}
+
+// MetricsContext is Client.MetricsContext, sent to the servers of the pool.
+func (p *Pool) MetricsContext(ctx context.Context) (metrics hProtocol.Metrics, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		metrics, err = client.MetricsContext(ctx)
+		return
+	})
+	return
+}
+
+// Root is Client.Root, sent to the servers of the pool.
+func (p *Pool) Root() (root hProtocol.Root, err error) {
+	return p.RootContext(context.Background())
+}
+
+// RootContext is Client.RootContext, sent to the servers of the pool.
+func (p *Pool) RootContext(ctx context.Context) (root hProtocol.Root, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		root, err = client.RootContext(ctx)
+		return
+	})
+	return
+}
+
+// Stream is Client.Stream, sent to the server with the most recent history.
+func (p *Pool) Stream(ctx context.Context, request StreamRequest, handler func(interface{})) error {
+	return p.stream(func(client *Client) error {
+		return client.Stream(ctx, request, handler)
+	})
+}
+
+// FeeStats is Client.FeeStats, sent to the servers of the pool.
+func (p *Pool) FeeStats() (feeStats hProtocol.FeeStats, err error) {
+	return p.FeeStatsContext(context.Background())
+}
+
+// FeeStatsContext is Client.FeeStatsContext, sent to the servers of the pool.
+func (p *Pool) FeeStatsContext(ctx context.Context) (feeStats hProtocol.FeeStats, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		feeStats, err = client.FeeStatsContext(ctx)
+		return
+	})
+	return
+}
+
+// Offers is Client.Offers, sent to the servers of the pool.
+func (p *Pool) Offers(request OfferRequest) (page hProtocol.OffersPage, err error) {
+	return p.OffersContext(context.Background(), request)
+}
+
+// OffersContext is Client.OffersContext, sent to the servers of the pool.
+func (p *Pool) OffersContext(ctx context.Context, request OfferRequest) (page hProtocol.OffersPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		page, err = client.OffersContext(ctx, request)
+		return
+	})
+	return
+}
+
+// NextOffersPage is Client.NextOffersPage, sent to the servers of the pool.
+func (p *Pool) NextOffersPage(page hProtocol.OffersPage) (next hProtocol.OffersPage, err error) {
+	return p.NextOffersPageContext(context.Background(), page)
+}
+
+// NextOffersPageContext is Client.NextOffersPageContext, sent to the servers of the pool.
+func (p *Pool) NextOffersPageContext(ctx context.Context, page hProtocol.OffersPage) (next hProtocol.OffersPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		page.Links = p.rebaseLinks(page.Links, client)
+		next, err = client.NextOffersPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// PrevOffersPage is Client.PrevOffersPage, sent to the servers of the pool.
+func (p *Pool) PrevOffersPage(page hProtocol.OffersPage) (prev hProtocol.OffersPage, err error) {
+	return p.PrevOffersPageContext(context.Background(), page)
+}
+
+// PrevOffersPageContext is Client.PrevOffersPageContext, sent to the servers of the pool.
+func (p *Pool) PrevOffersPageContext(ctx context.Context, page hProtocol.OffersPage) (prev hProtocol.OffersPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		page.Links = p.rebaseLinks(page.Links, client)
+		prev, err = client.PrevOffersPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// OfferDetail is Client.OfferDetail, sent to the servers of the pool.
+func (p *Pool) OfferDetail(offerID string) (offer hProtocol.Offer, err error) {
+	return p.OfferDetailContext(context.Background(), offerID)
+}
+
+// OfferDetailContext is Client.OfferDetailContext, sent to the servers of the pool.
+func (p *Pool) OfferDetailContext(ctx context.Context, offerID string) (offer hProtocol.Offer, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		offer, err = client.OfferDetailContext(ctx, offerID)
+		return
+	})
+	return
+}
+
+// Operations is Client.Operations, sent to the servers of the pool.
+func (p *Pool) Operations(request OperationRequest) (page operations.OperationsPage, err error) {
+	return p.OperationsContext(context.Background(), request)
+}
+
+// OperationsContext is Client.OperationsContext, sent to the servers of the pool.
+func (p *Pool) OperationsContext(ctx context.Context, request OperationRequest) (page operations.OperationsPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		page, err = client.OperationsContext(ctx, request)
+		return
+	})
+	return
+}
+
+// NextOperationsPage is Client.NextOperationsPage, sent to the servers of the pool.
+func (p *Pool) NextOperationsPage(page operations.OperationsPage) (next operations.OperationsPage, err error) {
+	return p.NextOperationsPageContext(context.Background(), page)
+}
+
+// NextOperationsPageContext is Client.NextOperationsPageContext, sent to the servers of the pool.
+func (p *Pool) NextOperationsPageContext(ctx context.Context, page operations.OperationsPage) (next operations.OperationsPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		page.Links = p.rebaseLinks(page.Links, client)
+		next, err = client.NextOperationsPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// PrevOperationsPage is Client.PrevOperationsPage, sent to the servers of the pool.
+func (p *Pool) PrevOperationsPage(page operations.OperationsPage) (prev operations.OperationsPage, err error) {
+	return p.PrevOperationsPageContext(context.Background(), page)
+}
+
+// PrevOperationsPageContext is Client.PrevOperationsPageContext, sent to the servers of the pool.
+func (p *Pool) PrevOperationsPageContext(ctx context.Context, page operations.OperationsPage) (prev operations.OperationsPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		page.Links = p.rebaseLinks(page.Links, client)
+		prev, err = client.PrevOperationsPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// OperationDetail is Client.OperationDetail, sent to the servers of the pool.
+func (p *Pool) OperationDetail(id string) (op operations.Operation, err error) {
+	return p.OperationDetailContext(context.Background(), id)
+}
+
+// OperationDetailContext is Client.OperationDetailContext, sent to the servers of the pool.
+func (p *Pool) OperationDetailContext(ctx context.Context, id string) (op operations.Operation, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		op, err = client.OperationDetailContext(ctx, id)
+		return
+	})
+	return
+}
+
+// SubmitTransaction is Client.SubmitTransaction, sent to the servers of the pool.
+//
+// A submission rejected by an overloaded or failing server is sent to the next server. When the
+// outcome of a submission is unknown, because the server timed out or the connection was lost,
+// the transaction may already be in a ledger: it is looked up by hash on each following server
+// before being submitted to it, and a tx_bad_seq rejection is replaced by the result of the
+// transaction when it is then found. A transaction found in a ledger but failed results in a
+// *SubmissionError. The hash is computed with the network passphrase of the servers; when it is
+// unknown, the error of the submission is returned instead of failing over.
+func (p *Pool) SubmitTransaction(transactionXdr string) (txSuccess hProtocol.TransactionSuccess, err error) {
+	return p.SubmitTransactionContext(context.Background(), transactionXdr)
+}
+
+// SubmitTransactionContext is Client.SubmitTransactionContext, sent to the servers of the pool.
+// See SubmitTransaction.
+func (p *Pool) SubmitTransactionContext(ctx context.Context, transactionXdr string) (txSuccess hProtocol.TransactionSuccess, err error) {
+	clients := p.clients()
+	if len(clients) == 0 {
+		return txSuccess, ErrEmptyPool
+	}
+
+	// hash is set once a submission may have been included in a ledger
+	var hash string
+	for _, client := range clients {
+		if hash != "" {
+			tx, found, lookupErr := lookupTransaction(ctx, client, hash)
+			if found {
+				return transactionResult(tx)
+			}
+			if lookupErr != nil {
+				if ctx.Err() != nil || !serverFailed(lookupErr) {
+					return
+				}
+				p.markUnhealthy(client)
+				continue
+			}
+		}
+
+		txSuccess, err = client.SubmitTransactionContext(ctx, transactionXdr)
+		if err == nil || ctx.Err() != nil {
+			return
+		}
+
+		if hash != "" && isBadSequence(err) {
+			// The sequence number may have been consumed by an earlier submission
+			if tx, found, _ := lookupTransaction(ctx, client, hash); found {
+				return transactionResult(tx)
+			}
+			return
+		}
+
+		if !serverFailed(err) {
+			return
+		}
+		p.markUnhealthy(client)
+
+		if hash == "" && isUnknownOutcome(err) {
+			var txe xdr.TransactionEnvelope
+			if xdr.SafeUnmarshalBase64(transactionXdr, &txe) != nil {
+				return
+			}
+			var hashErr error
+			hash, hashErr = transactionHash(txe, p.networkPassphrase())
+			if hashErr != nil {
+				return
+			}
+		}
+	}
+	return
+}
+
+// lookupTransaction loads the transaction with the given hash from the server of client, and
+// returns whether it was found.
+func lookupTransaction(ctx context.Context, client *Client, hash string) (hProtocol.Transaction, bool, error) {
+	tx, err := client.TransactionDetailContext(ctx, hash)
+	found, err := transactionFound(err)
+	return tx, found, err
+}
+
+// Transactions is Client.Transactions, sent to the servers of the pool.
+func (p *Pool) Transactions(request TransactionRequest) (page hProtocol.TransactionsPage, err error) {
+	return p.TransactionsContext(context.Background(), request)
+}
+
+// TransactionsContext is Client.TransactionsContext, sent to the servers of the pool.
+func (p *Pool) TransactionsContext(ctx context.Context, request TransactionRequest) (page hProtocol.TransactionsPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		page, err = client.TransactionsContext(ctx, request)
+		return
+	})
+	return
+}
+
+// NextTransactionsPage is Client.NextTransactionsPage, sent to the servers of the pool.
+func (p *Pool) NextTransactionsPage(page hProtocol.TransactionsPage) (next hProtocol.TransactionsPage, err error) {
+	return p.NextTransactionsPageContext(context.Background(), page)
+}
+
+// NextTransactionsPageContext is Client.NextTransactionsPageContext, sent to the servers of the pool.
+func (p *Pool) NextTransactionsPageContext(ctx context.Context, page hProtocol.TransactionsPage) (next hProtocol.TransactionsPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		page.Links = p.rebaseLinks(page.Links, client)
+		next, err = client.NextTransactionsPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// PrevTransactionsPage is Client.PrevTransactionsPage, sent to the servers of the pool.
+func (p *Pool) PrevTransactionsPage(page hProtocol.TransactionsPage) (prev hProtocol.TransactionsPage, err error) {
+	return p.PrevTransactionsPageContext(context.Background(), page)
+}
+
+// PrevTransactionsPageContext is Client.PrevTransactionsPageContext, sent to the servers of the pool.
+func (p *Pool) PrevTransactionsPageContext(ctx context.Context, page hProtocol.TransactionsPage) (prev hProtocol.TransactionsPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		page.Links = p.rebaseLinks(page.Links, client)
+		prev, err = client.PrevTransactionsPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// TransactionDetail is Client.TransactionDetail, sent to the servers of the pool.
+func (p *Pool) TransactionDetail(txHash string) (tx hProtocol.Transaction, err error) {
+	return p.TransactionDetailContext(context.Background(), txHash)
+}
+
+// TransactionDetailContext is Client.TransactionDetailContext, sent to the servers of the pool.
+func (p *Pool) TransactionDetailContext(ctx context.Context, txHash string) (tx hProtocol.Transaction, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		tx, err = client.TransactionDetailContext(ctx, txHash)
+		return
+	})
+	return
+}
+
+// OrderBook is Client.OrderBook, sent to the servers of the pool.
+func (p *Pool) OrderBook(request OrderBookRequest) (orderBook hProtocol.OrderBookSummary, err error) {
+	return p.OrderBookContext(context.Background(), request)
+}
+
+// OrderBookContext is Client.OrderBookContext, sent to the servers of the pool.
+func (p *Pool) OrderBookContext(ctx context.Context, request OrderBookRequest) (orderBook hProtocol.OrderBookSummary, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		orderBook, err = client.OrderBookContext(ctx, request)
+		return
+	})
+	return
+}
+
+// Paths is Client.Paths, sent to the servers of the pool.
+func (p *Pool) Paths(request PathsRequest) (paths hProtocol.PathsPage, err error) {
+	return p.PathsContext(context.Background(), request)
+}
+
+// PathsContext is Client.PathsContext, sent to the servers of the pool.
+func (p *Pool) PathsContext(ctx context.Context, request PathsRequest) (paths hProtocol.PathsPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		paths, err = client.PathsContext(ctx, request)
+		return
+	})
+	return
+}
+
+// Payments is Client.Payments, sent to the servers of the pool.
+func (p *Pool) Payments(request OperationRequest) (page operations.OperationsPage, err error) {
+	return p.PaymentsContext(context.Background(), request)
+}
+
+// PaymentsContext is Client.PaymentsContext, sent to the servers of the pool.
+func (p *Pool) PaymentsContext(ctx context.Context, request OperationRequest) (page operations.OperationsPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		page, err = client.PaymentsContext(ctx, request)
+		return
+	})
+	return
+}
+
+// TradeAggregations is Client.TradeAggregations, sent to the servers of the pool.
+func (p *Pool) TradeAggregations(request TradeAggregationRequest) (tradeAggregations hProtocol.TradeAggregationsPage, err error) {
+	return p.TradeAggregationsContext(context.Background(), request)
+}
+
+// TradeAggregationsContext is Client.TradeAggregationsContext, sent to the servers of the pool.
+func (p *Pool) TradeAggregationsContext(ctx context.Context, request TradeAggregationRequest) (tradeAggregations hProtocol.TradeAggregationsPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		tradeAggregations, err = client.TradeAggregationsContext(ctx, request)
+		return
+	})
+	return
+}
+
+// Trades is Client.Trades, sent to the servers of the pool.
+func (p *Pool) Trades(request TradeRequest) (page hProtocol.TradesPage, err error) {
+	return p.TradesContext(context.Background(), request)
+}
+
+// TradesContext is Client.TradesContext, sent to the servers of the pool.
+func (p *Pool) TradesContext(ctx context.Context, request TradeRequest) (page hProtocol.TradesPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		page, err = client.TradesContext(ctx, request)
+		return
+	})
+	return
+}
+
+// NextTradesPage is Client.NextTradesPage, sent to the servers of the pool.
+func (p *Pool) NextTradesPage(page hProtocol.TradesPage) (next hProtocol.TradesPage, err error) {
+	return p.NextTradesPageContext(context.Background(), page)
+}
+
+// NextTradesPageContext is Client.NextTradesPageContext, sent to the servers of the pool.
+func (p *Pool) NextTradesPageContext(ctx context.Context, page hProtocol.TradesPage) (next hProtocol.TradesPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		page.Links = p.rebaseLinks(page.Links, client)
+		next, err = client.NextTradesPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// PrevTradesPage is Client.PrevTradesPage, sent to the servers of the pool.
+func (p *Pool) PrevTradesPage(page hProtocol.TradesPage) (prev hProtocol.TradesPage, err error) {
+	return p.PrevTradesPageContext(context.Background(), page)
+}
+
+// PrevTradesPageContext is Client.PrevTradesPageContext, sent to the servers of the pool.
+func (p *Pool) PrevTradesPageContext(ctx context.Context, page hProtocol.TradesPage) (prev hProtocol.TradesPage, err error) {
+	err = p.do(ctx, func(client *Client) (err error) {
+		page.Links = p.rebaseLinks(page.Links, client)
+		prev, err = client.PrevTradesPageContext(ctx, page)
+		return
+	})
+	return
+}
+
+// StreamTransactions is Client.StreamTransactions, sent to the server with the most recent history.
+func (p *Pool) StreamTransactions(ctx context.Context, request TransactionRequest, handler TransactionHandler) error {
+	return p.stream(func(client *Client) error {
+		return client.StreamTransactions(ctx, request, handler)
+	})
+}
+
+// StreamTrades is Client.StreamTrades, sent to the server with the most recent history.
+func (p *Pool) StreamTrades(ctx context.Context, request TradeRequest, handler TradeHandler) error {
+	return p.stream(func(client *Client) error {
+		return client.StreamTrades(ctx, request, handler)
+	})
+}
+
+// StreamEffects is Client.StreamEffects, sent to the server with the most recent history.
+func (p *Pool) StreamEffects(ctx context.Context, request EffectRequest, handler EffectHandler) error {
+	return p.stream(func(client *Client) error {
+		return client.StreamEffects(ctx, request, handler)
+	})
+}
+
+// StreamOperations is Client.StreamOperations, sent to the server with the most recent history.
+func (p *Pool) StreamOperations(ctx context.Context, request OperationRequest, handler OperationHandler) error {
+	return p.stream(func(client *Client) error {
+		return client.StreamOperations(ctx, request, handler)
+	})
+}
+
+// StreamPayments is Client.StreamPayments, sent to the server with the most recent history.
+func (p *Pool) StreamPayments(ctx context.Context, request OperationRequest, handler OperationHandler) error {
+	return p.stream(func(client *Client) error {
+		return client.StreamPayments(ctx, request, handler)
+	})
+}
+
+// StreamOffers is Client.StreamOffers, sent to the server with the most recent history.
+func (p *Pool) StreamOffers(ctx context.Context, request OfferRequest, handler OfferHandler) error {
+	return p.stream(func(client *Client) error {
+		return client.StreamOffers(ctx, request, handler)
+	})
+}
+
+// StreamLedgers is Client.StreamLedgers, sent to the server with the most recent history.
+func (p *Pool) StreamLedgers(ctx context.Context, request LedgerRequest, handler LedgerHandler) error {
+	return p.stream(func(client *Client) error {
+		return client.StreamLedgers(ctx, request, handler)
+	})
+}
+
+// StreamOrderBooks is Client.StreamOrderBooks, sent to the server with the most recent history.
+func (p *Pool) StreamOrderBooks(ctx context.Context, request OrderBookRequest, handler OrderBookHandler) error {
+	return p.stream(func(client *Client) error {
+		return client.StreamOrderBooks(ctx, request, handler)
+	})
+}
+
+var _ ClientInterface = &Pool{}
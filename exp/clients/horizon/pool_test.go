@@ -0,0 +1,224 @@
+package horizonclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stellar/go/exp/clients/horizon/horizontest"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/render/problem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const poolTxXdr = `AAAAABB90WssODNIgi6BHveqzxTRmIpvAFRyVNM+Hm2GVuCcAAAAZAAABD0AAuV/AAAAAAAAAAAAAAABAAAAAAAAAAAAAAAAyTBGxOgfSApppsTnb/YRr6gOR8WT0LZNrhLh4y3FCgoAAAAXSHboAAAAAAAAAAABhlbgnAAAAEAivKe977CQCxMOKTuj+cWTFqc2OOJU8qGr9afrgu2zDmQaX5Q0cNshc3PiBwe0qw/+D/qJk5QqM5dYeSUGeDQP`
+
+// newTestPool returns a pool of servers, each with the ledgers up to one of sequences, and
+// reporting that its history is ingested up to that ledger.
+func newTestPool(sequences ...int32) (*Pool, []*horizontest.Server) {
+	pool := &Pool{}
+	var servers []*horizontest.Server
+	for _, sequence := range sequences {
+		server := horizontest.NewServer()
+		for s := int32(1); s <= sequence; s++ {
+			server.AddLedger(hProtocol.Ledger{
+				ID:       fmt.Sprintf("ledger%d", s),
+				PT:       fmt.Sprint(int64(s) << 32),
+				Sequence: s,
+			})
+		}
+		servers = append(servers, server)
+		pool.Clients = append(pool.Clients, &Client{HorizonURL: server.URL, HTTP: server.Client()})
+	}
+	return pool, servers
+}
+
+func closeServers(servers []*horizontest.Server) {
+	for _, server := range servers {
+		server.Close()
+	}
+}
+
+func TestPoolRouting(t *testing.T) {
+	pool, servers := newTestPool(3, 5, 4)
+	defer closeServers(servers)
+
+	// Requests are sent to the server with the most recent history
+	page, err := pool.Ledgers(LedgerRequest{Order: OrderDesc, Limit: 1})
+	require.NoError(t, err)
+	if assert.Len(t, page.Embedded.Records, 1) {
+		assert.Equal(t, int32(5), page.Embedded.Records[0].Sequence)
+	}
+
+	// Servers whose history lags behind stellar-core are skipped
+	servers[1].SetRoot(hProtocol.Root{HorizonSequence: 5, CoreSequence: 20})
+	pool.CheckHealth()
+	page, err = pool.Ledgers(LedgerRequest{Order: OrderDesc, Limit: 1})
+	require.NoError(t, err)
+	if assert.Len(t, page.Embedded.Records, 1) {
+		assert.Equal(t, int32(4), page.Embedded.Records[0].Sequence)
+	}
+
+	_, err = (&Pool{}).Ledgers(LedgerRequest{})
+	assert.Equal(t, ErrEmptyPool, err)
+}
+
+func TestPoolFailover(t *testing.T) {
+	pool, servers := newTestPool(5, 4)
+	defer closeServers(servers)
+
+	servers[0].FailNext("/ledgers/2", problem.ServerError)
+	ledger, err := pool.LedgerDetail(2)
+	require.NoError(t, err)
+	assert.Equal(t, "ledger2", ledger.ID)
+
+	// The failed server is not used until the next health check
+	_, err = pool.LedgerDetail(5)
+	if assert.IsType(t, &Error{}, err) {
+		assert.Equal(t, http.StatusNotFound, err.(*Error).Problem.Status)
+	}
+
+	pool.CheckHealth()
+	ledger, err = pool.LedgerDetail(5)
+	require.NoError(t, err)
+	assert.Equal(t, "ledger5", ledger.ID)
+
+	// Requests cancelled by their context are not sent to another server
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = pool.LedgerDetailContext(ctx, 5)
+	assert.Error(t, err)
+	ledger, err = pool.LedgerDetail(5)
+	require.NoError(t, err)
+	assert.Equal(t, "ledger5", ledger.ID)
+
+	// Client errors are not sent to another server
+	servers[0].FailNext("/ledgers/3", problem.NotFound)
+	_, err = pool.LedgerDetail(3)
+	if assert.IsType(t, &Error{}, err) {
+		assert.Equal(t, http.StatusNotFound, err.(*Error).Problem.Status)
+	}
+
+	// The last error is returned when all the servers fail
+	servers[0].FailNext("/ledgers/1", problem.ServerError)
+	servers[1].FailNext("/ledgers/1", horizontest.RateLimitExceeded)
+	_, err = pool.LedgerDetail(1)
+	if assert.IsType(t, &Error{}, err) {
+		assert.Equal(t, http.StatusTooManyRequests, err.(*Error).Problem.Status)
+	}
+}
+
+func TestPoolSubmitTransaction(t *testing.T) {
+	pool, servers := newTestPool(5, 4)
+	defer closeServers(servers)
+
+	servers[0].FailNext("/transactions", horizontest.Timeout)
+	resp, err := pool.SubmitTransaction(poolTxXdr)
+	require.NoError(t, err)
+	assert.Equal(t, int32(4), resp.Ledger)
+
+	// Rejected transactions are not submitted again
+	pool.CheckHealth()
+	servers[0].SubmitHandler = func(transactionXDR string) (hProtocol.TransactionSuccess, error) {
+		return hProtocol.TransactionSuccess{}, horizontest.TransactionFailed(transactionXDR, "", "tx_bad_seq")
+	}
+	_, err = pool.SubmitTransaction(poolTxXdr)
+	if assert.IsType(t, &Error{}, err) {
+		codes, codesErr := err.(*Error).ResultCodes()
+		require.NoError(t, codesErr)
+		assert.Equal(t, "tx_bad_seq", codes.TransactionCode)
+	}
+}
+
+func TestPoolSubmitTransactionIncluded(t *testing.T) {
+	pool, servers := newTestPool(5, 4)
+	defer closeServers(servers)
+	_, hash := submitterTx(t)
+
+	// include adds the transaction to the ledgers of both servers, which share the same network
+	include := func() {
+		for _, server := range servers {
+			server.AddTransaction(hProtocol.Transaction{
+				ID:         hash,
+				PT:         "21474840576",
+				Hash:       hash,
+				Ledger:     5,
+				Successful: true,
+			})
+		}
+	}
+
+	// The transaction is included in a ledger, then the first server times out: it is found on
+	// the second server instead of being submitted again
+	submissions := 0
+	servers[0].SubmitHandler = func(transactionXDR string) (hProtocol.TransactionSuccess, error) {
+		submissions++
+		include()
+		return hProtocol.TransactionSuccess{}, horizontest.Timeout
+	}
+	servers[1].SubmitHandler = func(transactionXDR string) (hProtocol.TransactionSuccess, error) {
+		submissions++
+		return hProtocol.TransactionSuccess{}, horizontest.TransactionFailed(transactionXDR, "", "tx_bad_seq")
+	}
+
+	resp, err := pool.SubmitTransaction(poolTxXdr)
+	require.NoError(t, err)
+	assert.Equal(t, hash, resp.Hash)
+	assert.Equal(t, int32(5), resp.Ledger)
+	assert.Equal(t, 1, submissions)
+}
+
+func TestPoolSubmitTransactionBadSequence(t *testing.T) {
+	pool, servers := newTestPool(5, 4)
+	defer closeServers(servers)
+	_, hash := submitterTx(t)
+
+	// The first submission times out and the transaction is not in a ledger yet when it is
+	// looked up, but it is by the time it is submitted to the second server
+	servers[0].FailNext("/transactions", horizontest.Timeout)
+	servers[1].SubmitHandler = func(transactionXDR string) (hProtocol.TransactionSuccess, error) {
+		servers[1].AddTransaction(hProtocol.Transaction{
+			ID:         hash,
+			PT:         "17179873280",
+			Hash:       hash,
+			Ledger:     4,
+			Successful: true,
+		})
+		return hProtocol.TransactionSuccess{}, horizontest.TransactionFailed(transactionXDR, "", "tx_bad_seq")
+	}
+
+	resp, err := pool.SubmitTransaction(poolTxXdr)
+	require.NoError(t, err)
+	assert.Equal(t, hash, resp.Hash)
+	assert.Equal(t, int32(4), resp.Ledger)
+
+	// Without the network passphrase, the transaction can not be looked up and is not
+	// submitted to another server
+	servers[0].SetRoot(hProtocol.Root{HorizonSequence: 5, CoreSequence: 5})
+	servers[1].SetRoot(hProtocol.Root{HorizonSequence: 4, CoreSequence: 4})
+	pool.CheckHealth()
+	servers[0].FailNext("/transactions", horizontest.Timeout)
+	_, err = pool.SubmitTransaction(poolTxXdr)
+	if assert.IsType(t, &Error{}, err) {
+		assert.Equal(t, http.StatusGatewayTimeout, err.(*Error).Problem.Status)
+	}
+}
+
+func TestPoolNextPage(t *testing.T) {
+	pool, servers := newTestPool(5, 4)
+	defer closeServers(servers)
+
+	page, err := pool.Ledgers(LedgerRequest{Limit: 2})
+	require.NoError(t, err)
+
+	// The next page is loaded from another server when the first one fails
+	servers[0].FailNext("/ledgers", problem.ServerError)
+	page, err = pool.NextLedgersPage(page)
+	require.NoError(t, err)
+	if assert.Len(t, page.Embedded.Records, 2) {
+		assert.Equal(t, int32(3), page.Embedded.Records[0].Sequence)
+		assert.Equal(t, int32(4), page.Embedded.Records[1].Sequence)
+	}
+}
@@ -0,0 +1,133 @@
+package horizonclient
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+var (
+	// RequestRetryDelay is the delay before a request rejected with 429 Too Many Requests or 503
+	// Service Unavailable is sent again, when Client.MaxRetries allows it. The delay doubles after
+	// each attempt, unless horizon asks for a longer one with the Retry-After header.
+	RequestRetryDelay = time.Second
+
+	// RequestMaxRetryDelay is the maximum delay before a request is sent again.
+	RequestMaxRetryDelay = time.Minute
+)
+
+// RateLimit is the rate limit of a client, as reported by the X-Ratelimit headers of the last
+// response of horizon. Limit is zero when horizon did not report a rate limit.
+type RateLimit struct {
+	// Limit is the number of requests allowed per period.
+	Limit int
+	// Remaining is the number of requests that can be sent before Reset.
+	Remaining int
+	// Reset is the time at which the whole limit is available again.
+	Reset time.Time
+}
+
+// parseRateLimit reads the rate limit reported by horizon in header. ok is false when the
+// headers are missing or invalid.
+func parseRateLimit(header http.Header, now time.Time) (rateLimit RateLimit, ok bool) {
+	limit, err := strconv.Atoi(header.Get("X-Ratelimit-Limit"))
+	if err != nil {
+		return rateLimit, false
+	}
+	remaining, err := strconv.Atoi(header.Get("X-Ratelimit-Remaining"))
+	if err != nil {
+		return rateLimit, false
+	}
+	reset, err := strconv.Atoi(header.Get("X-Ratelimit-Reset"))
+	if err != nil {
+		return rateLimit, false
+	}
+
+	return RateLimit{
+		Limit:     limit,
+		Remaining: remaining,
+		Reset:     now.Add(time.Duration(reset) * time.Second),
+	}, true
+}
+
+// RateLimit returns the rate limit reported by the last response of horizon, minus the requests
+// sent since.
+func (c *Client) RateLimit() RateLimit {
+	c.rateLimitMutex.Lock()
+	defer c.rateLimitMutex.Unlock()
+	return c.rateLimit
+}
+
+// updateRateLimit records the rate limit reported by a response of horizon.
+func (c *Client) updateRateLimit(resp *http.Response) {
+	rateLimit, ok := parseRateLimit(resp.Header, time.Now())
+	if !ok {
+		return
+	}
+
+	c.rateLimitMutex.Lock()
+	defer c.rateLimitMutex.Unlock()
+	c.rateLimit = rateLimit
+}
+
+// reserveRequest counts a request in the remaining rate limit. When WaitForRateLimit is set and
+// no request remains, it first waits until the rate limit resets, or until ctx is done, in which
+// case the error of ctx is returned.
+func (c *Client) reserveRequest(ctx context.Context) error {
+	c.rateLimitMutex.Lock()
+	defer c.rateLimitMutex.Unlock()
+
+	for c.rateLimit.Limit > 0 && c.rateLimit.Remaining <= 0 {
+		wait := time.Until(c.rateLimit.Reset)
+		if wait <= 0 {
+			c.rateLimit.Remaining = c.rateLimit.Limit
+			break
+		}
+		if !c.WaitForRateLimit {
+			return nil
+		}
+
+		c.rateLimitMutex.Unlock()
+		select {
+		case <-ctx.Done():
+			c.rateLimitMutex.Lock()
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+		c.rateLimitMutex.Lock()
+	}
+
+	if c.rateLimit.Remaining > 0 {
+		c.rateLimit.Remaining--
+	}
+	return nil
+}
+
+// shouldRetry returns true if a request that got resp can be sent again after attempt retries.
+func (c *Client) shouldRetry(resp *http.Response, attempt int) bool {
+	if attempt >= c.MaxRetries {
+		return false
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable
+}
+
+// retryDelay returns how long to wait before sending again a request that got resp, after
+// attempt retries.
+func retryDelay(resp *http.Response, attempt int) time.Duration {
+	delay := RequestRetryDelay
+	for i := 0; i < attempt && delay < RequestMaxRetryDelay; i++ {
+		delay *= 2
+	}
+
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+		if retryAfter := time.Duration(seconds) * time.Second; retryAfter > delay {
+			delay = retryAfter
+		}
+	}
+
+	if delay > RequestMaxRetryDelay {
+		delay = RequestMaxRetryDelay
+	}
+	return delay
+}
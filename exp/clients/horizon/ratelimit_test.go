@@ -0,0 +1,156 @@
+package horizonclient
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stellar/go/support/http/httptest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func rateLimitedResponse(status int, body string, remaining string) *http.Response {
+	resp := httpmock.NewStringResponse(status, body)
+	resp.Header.Set("X-RateLimit-Limit", "100")
+	resp.Header.Set("X-RateLimit-Remaining", remaining)
+	resp.Header.Set("X-RateLimit-Reset", "30")
+	return resp
+}
+
+func TestRateLimit(t *testing.T) {
+	hmock := httptest.NewClient()
+	client := &Client{
+		HorizonURL: "https://localhost/",
+		HTTP:       hmock,
+	}
+
+	hmock.On("GET", "https://localhost/ledgers").
+		Return(func(req *http.Request) (*http.Response, error) {
+			return rateLimitedResponse(200, `{}`, "42"), nil
+		})
+
+	assert.Equal(t, RateLimit{}, client.RateLimit())
+
+	_, err := client.Ledgers(LedgerRequest{})
+	require.NoError(t, err)
+
+	rateLimit := client.RateLimit()
+	assert.Equal(t, 100, rateLimit.Limit)
+	assert.Equal(t, 42, rateLimit.Remaining)
+	assert.WithinDuration(t, time.Now().Add(30*time.Second), rateLimit.Reset, 2*time.Second)
+
+	// Requests are counted until horizon reports the rate limit again
+	require.NoError(t, client.reserveRequest(context.Background()))
+	assert.Equal(t, 41, client.RateLimit().Remaining)
+}
+
+func TestWaitForRateLimit(t *testing.T) {
+	hmock := httptest.NewClient()
+	client := &Client{
+		HorizonURL: "https://localhost/",
+		HTTP:       hmock,
+	}
+
+	hmock.On("GET", "https://localhost/ledgers").ReturnString(200, `{}`)
+
+	// Requests are sent when the rate limit is exhausted, unless WaitForRateLimit is set
+	client.rateLimit = RateLimit{Limit: 10, Remaining: 0, Reset: time.Now().Add(time.Hour)}
+	_, err := client.Ledgers(LedgerRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, 0, client.RateLimit().Remaining)
+
+	client.WaitForRateLimit = true
+	client.rateLimit = RateLimit{Limit: 10, Remaining: 0, Reset: time.Now().Add(50 * time.Millisecond)}
+	start := time.Now()
+	_, err = client.Ledgers(LedgerRequest{})
+	assert.NoError(t, err)
+	assert.True(t, time.Since(start) >= 50*time.Millisecond)
+	assert.Equal(t, 9, client.RateLimit().Remaining)
+
+	// Waiting stops when the context of the request is done
+	client.rateLimit = RateLimit{Limit: 10, Remaining: 0, Reset: time.Now().Add(time.Hour)}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = client.LedgersContext(ctx, LedgerRequest{})
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, 0, client.RateLimit().Remaining)
+}
+
+func TestRetry(t *testing.T) {
+	defer func(delay time.Duration) { RequestRetryDelay = delay }(RequestRetryDelay)
+	RequestRetryDelay = time.Millisecond
+
+	hmock := httptest.NewClient()
+	client := &Client{
+		HorizonURL: "https://localhost/",
+		HTTP:       hmock,
+		MaxRetries: 2,
+	}
+
+	attempts := 0
+	hmock.On("GET", "https://localhost/ledgers").
+		Return(func(req *http.Request) (*http.Response, error) {
+			attempts++
+			switch attempts {
+			case 1:
+				return rateLimitedResponse(429, `{"status": 429}`, "0"), nil
+			case 2:
+				return httpmock.NewStringResponse(503, `{"status": 503}`), nil
+			}
+			return rateLimitedResponse(200, `{}`, "99"), nil
+		})
+
+	_, err := client.Ledgers(LedgerRequest{})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, attempts)
+	assert.Equal(t, 99, client.RateLimit().Remaining)
+
+	// The last response is returned once MaxRetries is reached
+	attempts = 0
+	client.MaxRetries = 1
+	_, err = client.Ledgers(LedgerRequest{})
+	if assert.IsType(t, &Error{}, err) {
+		assert.Equal(t, 503, err.(*Error).Problem.Status)
+	}
+	assert.Equal(t, 2, attempts)
+
+	// Retries stop when the context of the request is done
+	attempts = 0
+	client.MaxRetries = 5
+	RequestRetryDelay = time.Hour
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = client.LedgersContext(ctx, LedgerRequest{})
+	assert.Equal(t, context.DeadlineExceeded, err)
+	assert.Equal(t, 1, attempts)
+
+	// Other errors are not retried
+	hmock.On("GET", "https://localhost/ledgers/1").ReturnString(500, `{"status": 500}`)
+	_, err = client.LedgerDetail(1)
+	if assert.IsType(t, &Error{}, err) {
+		assert.Equal(t, 500, err.(*Error).Problem.Status)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	defer func(delay, max time.Duration) {
+		RequestRetryDelay = delay
+		RequestMaxRetryDelay = max
+	}(RequestRetryDelay, RequestMaxRetryDelay)
+	RequestRetryDelay = time.Second
+	RequestMaxRetryDelay = 10 * time.Second
+
+	resp := httpmock.NewStringResponse(503, "")
+	assert.Equal(t, time.Second, retryDelay(resp, 0))
+	assert.Equal(t, 4*time.Second, retryDelay(resp, 2))
+	assert.Equal(t, 10*time.Second, retryDelay(resp, 5))
+
+	// Retry-After is honoured up to RequestMaxRetryDelay
+	resp.Header.Set("Retry-After", "3")
+	assert.Equal(t, 3*time.Second, retryDelay(resp, 0))
+	resp.Header.Set("Retry-After", "60")
+	assert.Equal(t, 10*time.Second, retryDelay(resp, 0))
+}
@@ -0,0 +1,6 @@
+package horizonclient
+
+// BuildURL returns the url of the root resource of a running horizon instance
+func (rr rootRequest) BuildURL() (endpoint string, err error) {
+	return "", nil
+}
@@ -0,0 +1,287 @@
+package horizonclient
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/manucorporat/sse"
+	"github.com/stellar/go/support/errors"
+)
+
+// StreamState is the connection state of a stream, reported to Client.StreamStateHandler.
+type StreamState string
+
+const (
+	// StreamConnecting is reported before a stream connects, or reconnects, to horizon.
+	StreamConnecting StreamState = "connecting"
+	// StreamConnected is reported once horizon accepted the connection.
+	StreamConnected StreamState = "connected"
+	// StreamDisconnected is reported when a stream lost its connection. It reconnects after a
+	// delay.
+	StreamDisconnected StreamState = "disconnected"
+	// StreamClosed is reported when a stream stops, because its context was cancelled or because
+	// of an error it can not recover from.
+	StreamClosed StreamState = "closed"
+)
+
+var (
+	// StreamRetryDelay is the delay before a stream reconnects to horizon. Horizon can change it
+	// with the `retry` field of its events. The delay doubles after each failed attempt.
+	StreamRetryDelay = time.Second
+
+	// StreamMaxRetryDelay is the maximum delay before a stream reconnects to horizon.
+	StreamMaxRetryDelay = time.Minute
+)
+
+// eventStream holds the state of a stream across reconnections.
+type eventStream struct {
+	client      *Client
+	url         *url.URL
+	query       url.Values
+	handler     func(data []byte) error
+	lastEventID string
+	retryDelay  time.Duration
+}
+
+// retryableError is an error after which a stream reconnects.
+type retryableError struct {
+	error
+}
+
+// stream handles connections to endpoints that support streaming on an horizon server.
+//
+// When the connection is lost, or horizon is temporarily unavailable, the stream reconnects with
+// an exponential backoff and resumes from the last event it received. It stops when ctx is
+// cancelled, when horizon rejects the request or when handler returns an error, which is then
+// returned.
+func (c *Client) stream(
+	ctx context.Context,
+	streamURL string,
+	handler func(data []byte) error,
+) error {
+	su, err := url.Parse(streamURL)
+	if err != nil {
+		return errors.Wrap(err, "Error parsing stream url")
+	}
+
+	query := su.Query()
+	if query.Get("cursor") == "" {
+		query.Set("cursor", "now")
+	}
+
+	s := &eventStream{
+		client:     c,
+		url:        su,
+		query:      query,
+		handler:    handler,
+		retryDelay: StreamRetryDelay,
+	}
+
+	err = s.run(ctx)
+	c.reportStreamState(StreamClosed, err)
+	return err
+}
+
+// reportStreamState calls StreamStateHandler, if it is set.
+func (c *Client) reportStreamState(state StreamState, err error) {
+	if c.StreamStateHandler != nil {
+		c.StreamStateHandler(state, err)
+	}
+}
+
+func (s *eventStream) run(ctx context.Context) error {
+	failures := 0
+
+	for {
+		s.client.reportStreamState(StreamConnecting, nil)
+		connected, err := s.connect(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if connected {
+			failures = 0
+		}
+
+		if err != nil {
+			if _, ok := err.(retryableError); !ok {
+				return err
+			}
+			failures++
+		}
+
+		s.client.reportStreamState(StreamDisconnected, err)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(s.backoff(failures)):
+		}
+	}
+}
+
+// backoff returns how long to wait before reconnecting after the given number of consecutive
+// failed attempts. The delay is randomised to spread the reconnections of many clients.
+func (s *eventStream) backoff(failures int) time.Duration {
+	delay := s.retryDelay
+	for i := 1; i < failures && delay < StreamMaxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > StreamMaxRetryDelay {
+		delay = StreamMaxRetryDelay
+	}
+
+	if delay < 2 {
+		return delay
+	}
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)))
+}
+
+// connect opens a connection to horizon and passes the events it receives to the handler, until
+// the connection is closed. It returns whether horizon accepted the connection.
+func (s *eventStream) connect(ctx context.Context) (bool, error) {
+	// updates the url with new cursor
+	s.url.RawQuery = s.query.Encode()
+	req, err := http.NewRequest("GET", s.url.String(), nil)
+	if err != nil {
+		return false, errors.Wrap(err, "Error creating HTTP request")
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	if s.lastEventID != "" {
+		req.Header.Set("Last-Event-ID", s.lastEventID)
+	}
+	// to do: confirm name and version
+	s.client.setClientAppHeaders(req)
+
+	// We can use c.HTTP here because we set Timeout per request not on the client. See sendRequest()
+	resp, err := s.client.HTTP.Do(req.WithContext(ctx))
+	if err != nil {
+		return false, retryableError{errors.Wrap(err, "Error sending HTTP request")}
+	}
+	defer resp.Body.Close()
+	s.client.updateRateLimit(resp)
+
+	// Expected statusCode are 200-299
+	if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
+		err = fmt.Errorf("Got bad HTTP status code %d", resp.StatusCode)
+		switch resp.StatusCode {
+		case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return false, retryableError{err}
+		}
+		return false, err
+	}
+	s.client.reportStreamState(StreamConnected, nil)
+
+	reader := bufio.NewReader(resp.Body)
+
+	// Read events one by one. Return when there is no more data to be read from resp.Body
+	// (io.EOF).
+	for {
+		// Read until empty line = event delimiter. The perfect solution would be to read
+		// as many bytes as possible and forward them to sse.Decode. However this
+		// requires much more complicated code.
+		// We could also write our own `sse` package that works fine with streams directly
+		// (github.com/manucorporat/sse is just using io/ioutils.ReadAll).
+		var buffer bytes.Buffer
+		nonEmptylinesRead := 0
+		for {
+			// Check if ctx is not cancelled
+			select {
+			case <-ctx.Done():
+				return true, nil
+			default:
+				// Continue
+			}
+
+			line, err := reader.ReadString('\n')
+			if err != nil {
+				if err == io.EOF || err == io.ErrUnexpectedEOF {
+					// We catch EOF errors to handle two possible situations:
+					// - The last line before closing the stream was not empty. This should never
+					//   happen in Horizon as it always sends an empty line after each event.
+					// - The stream was closed by the server/proxy because the connection was idle.
+					//
+					// In the former case, that (again) should never happen in Horizon, we need to
+					// check if there are any events we need to decode. We do this in the `if`
+					// statement below just in case if Horizon behaviour changes in a future.
+					//
+					// From spec:
+					// > Once the end of the file is reached, the user agent must dispatch the
+					// > event one final time, as defined below.
+					if nonEmptylinesRead == 0 {
+						return true, nil
+					}
+				} else {
+					return true, retryableError{errors.Wrap(err, "Error reading line")}
+				}
+			}
+
+			// The retry field is handled here as github.com/manucorporat/sse stores its value in
+			// the event ID.
+			if strings.HasPrefix(line, "retry:") {
+				s.setRetryDelay(strings.TrimPrefix(line, "retry:"))
+				continue
+			}
+			buffer.WriteString(line)
+
+			if strings.TrimRight(line, "\n\r") == "" {
+				break
+			}
+
+			nonEmptylinesRead++
+		}
+
+		events, err := sse.Decode(strings.NewReader(buffer.String()))
+		if err != nil {
+			return true, errors.Wrap(err, "Error decoding event")
+		}
+
+		// Right now len(events) should always be 1. This loop will be helpful after writing
+		// new SSE decoder that can handle io.Reader without using ioutils.ReadAll().
+		for _, event := range events {
+			if event.Event != "message" {
+				continue
+			}
+
+			// Update cursor with event ID
+			if event.Id != "" {
+				s.query.Set("cursor", event.Id)
+				s.lastEventID = event.Id
+			}
+
+			switch data := event.Data.(type) {
+			case string:
+				err = s.handler([]byte(data))
+				err = errors.Wrap(err, "Handler error")
+			case []byte:
+				err = s.handler(data)
+				err = errors.Wrap(err, "Handler error")
+			default:
+				err = errors.New("Invalid event.Data type")
+			}
+			if err != nil {
+				return true, err
+			}
+		}
+	}
+}
+
+// setRetryDelay sets the reconnection delay from the value of a retry field, in milliseconds.
+// Invalid values are ignored.
+func (s *eventStream) setRetryDelay(value string) {
+	ms, err := strconv.ParseUint(strings.TrimSpace(value), 10, 32)
+	if err != nil {
+		return
+	}
+
+	s.retryDelay = time.Duration(ms) * time.Millisecond
+}
@@ -0,0 +1,109 @@
+package horizonclient
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/http/httptest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStreamReconnect(t *testing.T) {
+	hmock := httptest.NewClient()
+	var states []StreamState
+	client := &Client{
+		HorizonURL: "https://localhost/",
+		HTTP:       hmock,
+		StreamStateHandler: func(state StreamState, err error) {
+			states = append(states, state)
+		},
+	}
+
+	// The first connection is closed by horizon after one event
+	hmock.On("GET", "https://localhost/ledgers?cursor=now").
+		ReturnString(200, "retry: 1\nevent: open\ndata: \"hello\"\n\nid: 1\ndata: {\"sequence\": 1}\n\n")
+
+	// The stream resumes from the last event
+	hmock.On("GET", "https://localhost/ledgers?cursor=1").
+		Return(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "1", req.Header.Get("Last-Event-ID"))
+			return httpmock.NewStringResponse(200, "id: 2\ndata: {\"sequence\": 2}\n\n"), nil
+		})
+
+	stop := errors.New("stop")
+	var sequences []int32
+	err := client.StreamLedgers(context.Background(), LedgerRequest{}, func(ledger hProtocol.Ledger) error {
+		sequences = append(sequences, ledger.Sequence)
+		if ledger.Sequence == 2 {
+			return stop
+		}
+		return nil
+	})
+
+	// Handler errors stop the stream
+	if assert.Error(t, err) {
+		assert.Equal(t, stop, errors.Cause(err))
+	}
+	assert.Equal(t, []int32{1, 2}, sequences)
+	assert.Equal(t, []StreamState{
+		StreamConnecting, StreamConnected, StreamDisconnected,
+		StreamConnecting, StreamConnected, StreamClosed,
+	}, states)
+}
+
+func TestStreamRetriesUnavailableHorizon(t *testing.T) {
+	defer func(delay time.Duration) { StreamRetryDelay = delay }(StreamRetryDelay)
+	StreamRetryDelay = time.Millisecond
+
+	hmock := httptest.NewClient()
+	client := &Client{
+		HorizonURL: "https://localhost/",
+		HTTP:       hmock,
+	}
+
+	attempts := 0
+	hmock.On("GET", "https://localhost/ledgers?cursor=now").
+		Return(func(req *http.Request) (*http.Response, error) {
+			attempts++
+			switch attempts {
+			case 1:
+				return nil, errors.New("connection refused")
+			case 2:
+				return httpmock.NewStringResponse(503, "unavailable"), nil
+			}
+			return httpmock.NewStringResponse(200, "id: 1\ndata: {\"sequence\": 1}\n\n"), nil
+		})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var sequence int32
+	err := client.StreamLedgers(ctx, LedgerRequest{}, func(ledger hProtocol.Ledger) error {
+		sequence = ledger.Sequence
+		cancel()
+		return nil
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), sequence)
+	assert.Equal(t, 3, attempts)
+}
+
+func TestStreamBackoff(t *testing.T) {
+	defer func(delay time.Duration) { StreamMaxRetryDelay = delay }(StreamMaxRetryDelay)
+	StreamMaxRetryDelay = 10 * time.Second
+
+	s := &eventStream{retryDelay: time.Second}
+	for failures, max := range []time.Duration{time.Second, time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second} {
+		delay := s.backoff(failures)
+		assert.True(t, delay >= max/2 && delay <= max, "%d failures: %s", failures, delay)
+	}
+
+	s.setRetryDelay(" 250\n")
+	assert.Equal(t, 250*time.Millisecond, s.retryDelay)
+	s.setRetryDelay("soon")
+	assert.Equal(t, 250*time.Millisecond, s.retryDelay)
+}
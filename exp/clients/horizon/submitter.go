@@ -0,0 +1,293 @@
+package horizonclient
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/stellar/go/network"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+const (
+	// DefaultSubmitAttempts is the number of times a Submitter submits a transaction before
+	// giving up, when MaxAttempts is not set.
+	DefaultSubmitAttempts = 5
+
+	// DefaultSubmitPollInterval is how long a Submitter waits before checking whether a
+	// transaction that timed out made it into a ledger, when PollInterval is not set.
+	DefaultSubmitPollInterval = 5 * time.Second
+)
+
+// ErrSubmissionTimeout is the error returned by Submitter.Submit when horizon keeps timing out, or
+// the submissions keep failing before a response is received, and the transaction can not be
+// found in any ledger.
+var ErrSubmissionTimeout = errors.New("transaction submission timed out")
+
+// SubmissionError is the error returned by Submitter.Submit when a transaction is rejected by
+// horizon, or fails once it is included in a ledger.
+type SubmissionError struct {
+	// Codes are the result codes of the transaction.
+	Codes hProtocol.TransactionResultCodes
+	// Problem is the horizon error the codes were extracted from. It is nil when the transaction
+	// was included in a ledger.
+	Problem *Error
+}
+
+func (e *SubmissionError) Error() string {
+	if len(e.Codes.OperationCodes) == 0 {
+		return fmt.Sprintf("transaction submission failed: %s", e.Codes.TransactionCode)
+	}
+	return fmt.Sprintf("transaction submission failed: %s %v", e.Codes.TransactionCode, e.Codes.OperationCodes)
+}
+
+// BadSequence returns true if the transaction was rejected because its sequence number is not
+// the next sequence number of its source account.
+func (e *SubmissionError) BadSequence() bool {
+	return e.Codes.TransactionCode == "tx_bad_seq"
+}
+
+// InsufficientFee returns true if the transaction was rejected because its fee is too low.
+func (e *SubmissionError) InsufficientFee() bool {
+	return e.Codes.TransactionCode == "tx_insufficient_fee"
+}
+
+// Submitter submits transactions to horizon. It keeps track of the sequence number of each
+// source account, so that several transactions can be built for an account without loading
+// it from horizon each time, and resubmits a transaction when the outcome of a submission is
+// unknown until the transaction is found in a ledger.
+type Submitter struct {
+	Client ClientInterface
+	// NetworkPassphrase is used to compute the hash of the submitted transactions.
+	NetworkPassphrase string
+	// MaxAttempts is the number of times a transaction is submitted before giving up.
+	MaxAttempts int
+	// PollInterval is how long to wait after a timeout before checking whether the transaction
+	// was included in a ledger and submitting it again.
+	PollInterval time.Duration
+
+	mutex     sync.Mutex
+	sequences map[string]xdr.SequenceNumber
+}
+
+// NextSequence returns the sequence number to use for the next transaction of accountID. The
+// account is loaded from horizon the first time it is used, and after its sequence number was
+// reset.
+func (s *Submitter) NextSequence(accountID string) (xdr.SequenceNumber, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	seq, ok := s.sequences[accountID]
+	if !ok {
+		account, err := s.Client.AccountDetail(AccountRequest{AccountID: accountID})
+		if err != nil {
+			return 0, errors.Wrap(err, "failed to load account")
+		}
+
+		seq, err = account.GetSequenceNumber()
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	seq++
+	if s.sequences == nil {
+		s.sequences = make(map[string]xdr.SequenceNumber)
+	}
+	s.sequences[accountID] = seq
+	return seq, nil
+}
+
+// ResetSequence forgets the sequence number of accountID, so that it is loaded from horizon
+// again by the next call to NextSequence.
+func (s *Submitter) ResetSequence(accountID string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.sequences, accountID)
+}
+
+// Submit submits the base64 encoded transaction envelope transactionXdr to horizon.
+//
+// When horizon times out, or the request fails before a response is received, Submit checks
+// whether the transaction was included in a ledger and, if it was not, submits the same envelope
+// again, up to MaxAttempts times. Transactions rejected by
+// horizon, or included in a ledger but failed, result in a *SubmissionError. The sequence number
+// of the source account is reset whenever a transaction does not make it into a ledger.
+func (s *Submitter) Submit(transactionXdr string) (hProtocol.TransactionSuccess, error) {
+	return s.SubmitContext(context.Background(), transactionXdr)
+}
+
+// SubmitContext is Submit with a context, which is passed to the requests made to horizon and
+// stops the submission when it is done.
+func (s *Submitter) SubmitContext(ctx context.Context, transactionXdr string) (hProtocol.TransactionSuccess, error) {
+	var txe xdr.TransactionEnvelope
+	err := xdr.SafeUnmarshalBase64(transactionXdr, &txe)
+	if err != nil {
+		return hProtocol.TransactionSuccess{}, errors.Wrap(err, "failed to decode transaction envelope")
+	}
+
+	hash, err := transactionHash(txe, s.NetworkPassphrase)
+	if err != nil {
+		return hProtocol.TransactionSuccess{}, err
+	}
+
+	source := txe.Tx.SourceAccount.Address()
+	resp, err := s.submit(ctx, transactionXdr, hash)
+	if err != nil {
+		// A transaction that failed once included in a ledger still consumed its sequence number
+		if subErr, ok := err.(*SubmissionError); !ok || subErr.Problem != nil {
+			s.ResetSequence(source)
+		}
+	}
+	return resp, err
+}
+
+func (s *Submitter) submit(ctx context.Context, transactionXdr, hash string) (hProtocol.TransactionSuccess, error) {
+	maxAttempts := s.MaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = DefaultSubmitAttempts
+	}
+	pollInterval := s.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DefaultSubmitPollInterval
+	}
+
+	timedOut := false
+	for attempt := 1; ; attempt++ {
+		resp, err := s.Client.SubmitTransactionContext(ctx, transactionXdr)
+		if err == nil {
+			return resp, nil
+		}
+
+		if !isUnknownOutcome(err) {
+			// An earlier attempt that timed out may have been included in a ledger since, in
+			// which case submitting the envelope again fails with a bad sequence number.
+			if timedOut {
+				if tx, found, lookupErr := s.lookup(ctx, hash); lookupErr == nil && found {
+					return transactionResult(tx)
+				}
+			}
+
+			if herr, ok := errors.Cause(err).(*Error); ok {
+				return resp, submissionError(herr)
+			}
+			return resp, err
+		}
+		timedOut = true
+
+		select {
+		case <-ctx.Done():
+			return resp, ctx.Err()
+		case <-time.After(pollInterval):
+		}
+
+		tx, found, err := s.lookup(ctx, hash)
+		if err != nil {
+			return resp, err
+		}
+		if found {
+			return transactionResult(tx)
+		}
+
+		if attempt >= maxAttempts {
+			return resp, ErrSubmissionTimeout
+		}
+	}
+}
+
+// lookup returns the transaction with the given hash, and whether it was found.
+func (s *Submitter) lookup(ctx context.Context, hash string) (hProtocol.Transaction, bool, error) {
+	tx, err := s.Client.TransactionDetailContext(ctx, hash)
+	found, err := transactionFound(err)
+	return tx, found, err
+}
+
+// transactionFound returns whether a transaction was found from the error of its lookup by hash.
+func transactionFound(err error) (bool, error) {
+	if err == nil {
+		return true, nil
+	}
+
+	if herr, ok := errors.Cause(err).(*Error); ok && isStatus(herr, http.StatusNotFound) {
+		return false, nil
+	}
+	return false, errors.Wrap(err, "failed to load transaction")
+}
+
+// transactionHash returns the hex encoded hash of the transaction of txe on the network whose
+// passphrase is networkPassphrase.
+func transactionHash(txe xdr.TransactionEnvelope, networkPassphrase string) (string, error) {
+	if networkPassphrase == "" {
+		return "", errors.New("network passphrase is not set")
+	}
+
+	hash, err := network.HashTransaction(&txe.Tx, networkPassphrase)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to hash transaction")
+	}
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// submissionError converts the horizon error returned by a submission into a *SubmissionError
+// when it holds result codes.
+func submissionError(herr *Error) error {
+	codes, err := herr.ResultCodes()
+	if err != nil {
+		return herr
+	}
+
+	return &SubmissionError{Codes: *codes, Problem: herr}
+}
+
+// transactionResult returns the result of a transaction that was included in a ledger.
+func transactionResult(tx hProtocol.Transaction) (resp hProtocol.TransactionSuccess, err error) {
+	resp.Links.Transaction = tx.Links.Self
+	resp.Hash = tx.Hash
+	resp.Ledger = tx.Ledger
+	resp.Env = tx.EnvelopeXdr
+	resp.Result = tx.ResultXdr
+	resp.Meta = tx.ResultMetaXdr
+
+	if !tx.Successful {
+		err = &SubmissionError{Codes: hProtocol.TransactionResultCodes{TransactionCode: "tx_failed"}}
+	}
+	return
+}
+
+// isUnknownOutcome returns true if err leaves the outcome of a submission unknown: horizon timed
+// out, or the request failed after it may have reached horizon, for example because the
+// connection was reset or the client timed out.
+func isUnknownOutcome(err error) bool {
+	switch err := errors.Cause(err).(type) {
+	case *Error:
+		return isStatus(err, http.StatusGatewayTimeout)
+	case net.Error:
+		return true
+	}
+	return false
+}
+
+// isBadSequence returns true if err is the rejection of a submission because of its sequence
+// number.
+func isBadSequence(err error) bool {
+	herr, ok := errors.Cause(err).(*Error)
+	if !ok {
+		return false
+	}
+
+	codes, codesErr := herr.ResultCodes()
+	return codesErr == nil && codes.TransactionCode == "tx_bad_seq"
+}
+
+func isStatus(herr *Error, status int) bool {
+	if herr.Response != nil {
+		return herr.Response.StatusCode == status
+	}
+	return herr.Problem.Status == status
+}
@@ -0,0 +1,291 @@
+package horizonclient
+
+import (
+	"context"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stellar/go/exp/clients/horizon/horizontest"
+	"github.com/stellar/go/network"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/render/problem"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const submitterTxXdr = `AAAAABB90WssODNIgi6BHveqzxTRmIpvAFRyVNM+Hm2GVuCcAAAAZAAABD0AAuV/AAAAAAAAAAAAAAABAAAAAAAAAAAAAAAAyTBGxOgfSApppsTnb/YRr6gOR8WT0LZNrhLh4y3FCgoAAAAXSHboAAAAAAAAAAABhlbgnAAAAEAivKe977CQCxMOKTuj+cWTFqc2OOJU8qGr9afrgu2zDmQaX5Q0cNshc3PiBwe0qw/+D/qJk5QqM5dYeSUGeDQP`
+
+var (
+	timeoutError  = &Error{Problem: problem.P{Type: "timeout", Status: http.StatusGatewayTimeout}}
+	notFoundError = &Error{Problem: problem.P{Type: "not_found", Status: http.StatusNotFound}}
+)
+
+func newTestSubmitter(hmock *MockClient) *Submitter {
+	return &Submitter{
+		Client:            hmock,
+		NetworkPassphrase: network.TestNetworkPassphrase,
+		MaxAttempts:       2,
+		PollInterval:      time.Millisecond,
+	}
+}
+
+// submitterTx returns the source account and the hash of submitterTxXdr.
+func submitterTx(t *testing.T) (string, string) {
+	var txe xdr.TransactionEnvelope
+	require.NoError(t, xdr.SafeUnmarshalBase64(submitterTxXdr, &txe))
+	hash, err := network.HashTransaction(&txe.Tx, network.TestNetworkPassphrase)
+	require.NoError(t, err)
+	return txe.Tx.SourceAccount.Address(), hex.EncodeToString(hash[:])
+}
+
+func failedSubmission(txCode string, opCodes ...string) *Error {
+	return &Error{Problem: problem.P{
+		Type:   "transaction_failed",
+		Status: http.StatusBadRequest,
+		Extras: map[string]interface{}{
+			"result_codes": map[string]interface{}{"transaction": txCode, "operations": opCodes},
+		},
+	}}
+}
+
+func TestSubmitterNextSequence(t *testing.T) {
+	hmock := &MockClient{}
+	submitter := newTestSubmitter(hmock)
+	source, _ := submitterTx(t)
+
+	hmock.On("AccountDetail", AccountRequest{AccountID: source}).
+		Return(hProtocol.Account{Sequence: "10"}, nil).Once()
+
+	seq, err := submitter.NextSequence(source)
+	assert.NoError(t, err)
+	assert.Equal(t, xdr.SequenceNumber(11), seq)
+
+	seq, err = submitter.NextSequence(source)
+	assert.NoError(t, err)
+	assert.Equal(t, xdr.SequenceNumber(12), seq)
+
+	// The account is loaded again once its sequence number is reset
+	submitter.ResetSequence(source)
+	hmock.On("AccountDetail", AccountRequest{AccountID: source}).
+		Return(hProtocol.Account{Sequence: "20"}, nil).Once()
+
+	seq, err = submitter.NextSequence(source)
+	assert.NoError(t, err)
+	assert.Equal(t, xdr.SequenceNumber(21), seq)
+
+	hmock.AssertExpectations(t)
+}
+
+func TestSubmitterSubmit(t *testing.T) {
+	hmock := &MockClient{}
+	submitter := newTestSubmitter(hmock)
+	_, hash := submitterTx(t)
+
+	hmock.On("SubmitTransactionContext", mock.Anything, submitterTxXdr).
+		Return(hProtocol.TransactionSuccess{Hash: hash, Ledger: 3}, nil).Once()
+
+	resp, err := submitter.Submit(submitterTxXdr)
+	assert.NoError(t, err)
+	assert.Equal(t, hash, resp.Hash)
+	assert.Equal(t, int32(3), resp.Ledger)
+
+	hmock.AssertExpectations(t)
+}
+
+func TestSubmitterTimeout(t *testing.T) {
+	hmock := &MockClient{}
+	submitter := newTestSubmitter(hmock)
+	_, hash := submitterTx(t)
+
+	// The transaction is found in a ledger after a timeout
+	hmock.On("SubmitTransactionContext", mock.Anything, submitterTxXdr).
+		Return(hProtocol.TransactionSuccess{}, timeoutError).Once()
+	hmock.On("TransactionDetailContext", mock.Anything, hash).
+		Return(hProtocol.Transaction{Hash: hash, Ledger: 5, Successful: true}, nil).Once()
+
+	resp, err := submitter.Submit(submitterTxXdr)
+	assert.NoError(t, err)
+	assert.Equal(t, hash, resp.Hash)
+	assert.Equal(t, int32(5), resp.Ledger)
+
+	// The transaction is submitted again when it can not be found
+	hmock.On("SubmitTransactionContext", mock.Anything, submitterTxXdr).
+		Return(hProtocol.TransactionSuccess{}, timeoutError).Once()
+	hmock.On("TransactionDetailContext", mock.Anything, hash).
+		Return(hProtocol.Transaction{}, notFoundError).Once()
+	hmock.On("SubmitTransactionContext", mock.Anything, submitterTxXdr).
+		Return(hProtocol.TransactionSuccess{Hash: hash, Ledger: 6}, nil).Once()
+
+	resp, err = submitter.Submit(submitterTxXdr)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(6), resp.Ledger)
+
+	// A bad sequence number after a timeout means an earlier attempt made it into a ledger
+	hmock.On("SubmitTransactionContext", mock.Anything, submitterTxXdr).
+		Return(hProtocol.TransactionSuccess{}, timeoutError).Once()
+	hmock.On("TransactionDetailContext", mock.Anything, hash).
+		Return(hProtocol.Transaction{}, notFoundError).Once()
+	hmock.On("SubmitTransactionContext", mock.Anything, submitterTxXdr).
+		Return(hProtocol.TransactionSuccess{}, failedSubmission("tx_bad_seq")).Once()
+	hmock.On("TransactionDetailContext", mock.Anything, hash).
+		Return(hProtocol.Transaction{Hash: hash, Ledger: 7, Successful: true}, nil).Once()
+
+	resp, err = submitter.Submit(submitterTxXdr)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(7), resp.Ledger)
+
+	hmock.AssertExpectations(t)
+}
+
+// droppedResponseHTTP sends submissions to horizon, but loses their responses as if the connection
+// was reset.
+type droppedResponseHTTP struct {
+	*http.Client
+}
+
+func (h droppedResponseHTTP) Do(req *http.Request) (*http.Response, error) {
+	resp, err := h.Client.Do(req)
+	if err != nil || req.Method != http.MethodPost {
+		return resp, err
+	}
+
+	resp.Body.Close()
+	return nil, &url.Error{Op: "Post", URL: req.URL.String(), Err: syscall.ECONNRESET}
+}
+
+func TestSubmitterTransportError(t *testing.T) {
+	server := horizontest.NewServer()
+	defer server.Close()
+	_, hash := submitterTx(t)
+
+	// The transaction is included in a ledger, but the response never reaches the client
+	submissions := 0
+	server.SubmitHandler = func(transactionXDR string) (hProtocol.TransactionSuccess, error) {
+		submissions++
+		server.AddTransaction(hProtocol.Transaction{
+			ID:         hash,
+			PT:         "42949677056",
+			Hash:       hash,
+			Ledger:     10,
+			Successful: true,
+		})
+		return hProtocol.TransactionSuccess{Hash: hash, Ledger: 10}, nil
+	}
+
+	submitter := &Submitter{
+		Client:            &Client{HorizonURL: server.URL, HTTP: droppedResponseHTTP{server.Client()}},
+		NetworkPassphrase: network.TestNetworkPassphrase,
+		MaxAttempts:       2,
+		PollInterval:      time.Millisecond,
+	}
+
+	resp, err := submitter.Submit(submitterTxXdr)
+	require.NoError(t, err)
+	assert.Equal(t, hash, resp.Hash)
+	assert.Equal(t, int32(10), resp.Ledger)
+	assert.Equal(t, 1, submissions)
+}
+
+func TestSubmitterGivesUp(t *testing.T) {
+	hmock := &MockClient{}
+	submitter := newTestSubmitter(hmock)
+	source, hash := submitterTx(t)
+
+	hmock.On("AccountDetail", AccountRequest{AccountID: source}).
+		Return(hProtocol.Account{Sequence: "10"}, nil).Twice()
+	_, err := submitter.NextSequence(source)
+	require.NoError(t, err)
+
+	hmock.On("SubmitTransactionContext", mock.Anything, submitterTxXdr).
+		Return(hProtocol.TransactionSuccess{}, timeoutError).Twice()
+	hmock.On("TransactionDetailContext", mock.Anything, hash).
+		Return(hProtocol.Transaction{}, notFoundError).Twice()
+
+	_, err = submitter.Submit(submitterTxXdr)
+	assert.Equal(t, ErrSubmissionTimeout, err)
+
+	// The sequence number is loaded again
+	seq, err := submitter.NextSequence(source)
+	assert.NoError(t, err)
+	assert.Equal(t, xdr.SequenceNumber(11), seq)
+
+	hmock.AssertExpectations(t)
+}
+
+func TestSubmitterContext(t *testing.T) {
+	hmock := &MockClient{}
+	submitter := newTestSubmitter(hmock)
+	submitter.PollInterval = time.Hour
+
+	// The submission stops when the context is done, instead of waiting to look the transaction up
+	ctx, cancel := context.WithCancel(context.Background())
+	hmock.On("SubmitTransactionContext", ctx, submitterTxXdr).
+		Run(func(mock.Arguments) { cancel() }).
+		Return(hProtocol.TransactionSuccess{}, timeoutError).Once()
+
+	_, err := submitter.SubmitContext(ctx, submitterTxXdr)
+	assert.Equal(t, context.Canceled, err)
+
+	hmock.AssertExpectations(t)
+}
+
+func TestSubmitterRejected(t *testing.T) {
+	hmock := &MockClient{}
+	submitter := newTestSubmitter(hmock)
+	_, hash := submitterTx(t)
+
+	hmock.On("SubmitTransactionContext", mock.Anything, submitterTxXdr).
+		Return(hProtocol.TransactionSuccess{}, failedSubmission("tx_bad_seq")).Once()
+
+	_, err := submitter.Submit(submitterTxXdr)
+	if assert.IsType(t, &SubmissionError{}, err) {
+		subErr := err.(*SubmissionError)
+		assert.True(t, subErr.BadSequence())
+		assert.False(t, subErr.InsufficientFee())
+		assert.NotNil(t, subErr.Problem)
+		assert.EqualError(t, err, "transaction submission failed: tx_bad_seq")
+	}
+
+	hmock.On("SubmitTransactionContext", mock.Anything, submitterTxXdr).
+		Return(hProtocol.TransactionSuccess{}, failedSubmission("tx_failed", "op_underfunded")).Once()
+
+	_, err = submitter.Submit(submitterTxXdr)
+	assert.EqualError(t, err, "transaction submission failed: tx_failed [op_underfunded]")
+
+	// Errors without result codes are returned as is
+	hmock.On("SubmitTransactionContext", mock.Anything, submitterTxXdr).
+		Return(hProtocol.TransactionSuccess{}, notFoundError).Once()
+
+	_, err = submitter.Submit(submitterTxXdr)
+	assert.Equal(t, notFoundError, err)
+
+	// A transaction that failed in a ledger
+	hmock.On("SubmitTransactionContext", mock.Anything, submitterTxXdr).
+		Return(hProtocol.TransactionSuccess{}, timeoutError).Once()
+	hmock.On("TransactionDetailContext", mock.Anything, hash).
+		Return(hProtocol.Transaction{Hash: hash, Ledger: 8}, nil).Once()
+
+	resp, err := submitter.Submit(submitterTxXdr)
+	assert.EqualError(t, err, "transaction submission failed: tx_failed")
+	assert.Nil(t, err.(*SubmissionError).Problem)
+	assert.Equal(t, int32(8), resp.Ledger)
+
+	hmock.AssertExpectations(t)
+}
+
+func TestSubmitterInvalidEnvelope(t *testing.T) {
+	submitter := newTestSubmitter(&MockClient{})
+
+	_, err := submitter.Submit("AAAA")
+	assert.Error(t, err)
+
+	submitter.NetworkPassphrase = ""
+	_, err = submitter.Submit(submitterTxXdr)
+	assert.EqualError(t, err, "network passphrase is not set")
+}
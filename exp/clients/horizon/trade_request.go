@@ -62,8 +62,9 @@ func (tr TradeRequest) BuildURL() (endpoint string, err error) {
 	return endpoint, err
 }
 
-// TradeHandler is a function that is called when a new trade is received
-type TradeHandler func(hProtocol.Trade)
+// TradeHandler is a function that is called when a new trade is received.
+// Returning an error stops the stream, and the error is returned by the Stream method.
+type TradeHandler func(hProtocol.Trade) error
 
 // StreamTrades streams executed trades. It can be used to stream all trades, trades for an account and
 // trades for an offer. Use context.WithCancel to stop streaming or context.Background() if you want
@@ -83,7 +84,6 @@ func (tr TradeRequest) StreamTrades(ctx context.Context, client *Client,
 		if err != nil {
 			return errors.Wrap(err, "Error unmarshaling data")
 		}
-		handler(trade)
-		return nil
+		return handler(trade)
 	})
 }
@@ -141,8 +141,9 @@ func ExampleClient_StreamTrades() {
 		cancel()
 	}()
 
-	printHandler := func(tr hProtocol.Trade) {
+	printHandler := func(tr hProtocol.Trade) error {
 		fmt.Println(tr)
+		return nil
 	}
 	err := client.StreamTrades(ctx, tradeRequest, printHandler)
 
@@ -169,9 +170,10 @@ func TestTradeRequestStreamTrades(t *testing.T) {
 	).ReturnString(200, tradeStreamResponse)
 
 	trades := make([]hProtocol.Trade, 1)
-	err := client.StreamTrades(ctx, trRequest, func(tr hProtocol.Trade) {
+	err := client.StreamTrades(ctx, trRequest, func(tr hProtocol.Trade) error {
 		trades[0] = tr
 		cancel()
+		return nil
 	})
 
 	if assert.NoError(t, err) {
@@ -189,9 +191,10 @@ func TestTradeRequestStreamTrades(t *testing.T) {
 	).ReturnString(200, tradeStreamResponse)
 
 	trades = make([]hProtocol.Trade, 1)
-	err = client.StreamTrades(ctx, trRequest, func(tr hProtocol.Trade) {
+	err = client.StreamTrades(ctx, trRequest, func(tr hProtocol.Trade) error {
 		trades[0] = tr
 		cancel()
+		return nil
 	})
 
 	if assert.NoError(t, err) {
@@ -209,9 +212,10 @@ func TestTradeRequestStreamTrades(t *testing.T) {
 	).ReturnString(200, tradeStreamResponse)
 
 	trades = make([]hProtocol.Trade, 1)
-	err = client.StreamTrades(ctx, trRequest, func(tr hProtocol.Trade) {
+	err = client.StreamTrades(ctx, trRequest, func(tr hProtocol.Trade) error {
 		trades[0] = tr
 		cancel()
+		return nil
 	})
 
 	if assert.NoError(t, err) {
@@ -229,8 +233,9 @@ func TestTradeRequestStreamTrades(t *testing.T) {
 	).ReturnString(500, tradeStreamResponse)
 
 	trades = make([]hProtocol.Trade, 1)
-	err = client.StreamTrades(ctx, trRequest, func(tr hProtocol.Trade) {
+	err = client.StreamTrades(ctx, trRequest, func(tr hProtocol.Trade) error {
 		cancel()
+		return nil
 	})
 
 	if assert.Error(t, err) {
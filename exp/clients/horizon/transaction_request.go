@@ -44,8 +44,9 @@ func (tr TransactionRequest) BuildURL() (endpoint string, err error) {
 	return endpoint, err
 }
 
-// TransactionHandler is a function that is called when a new transaction is received
-type TransactionHandler func(hProtocol.Transaction)
+// TransactionHandler is a function that is called when a new transaction is received.
+// Returning an error stops the stream, and the error is returned by the Stream method.
+type TransactionHandler func(hProtocol.Transaction) error
 
 // StreamTransactions streams executed transactions. It can be used to stream all transactions and  transactions for an account. Use context.WithCancel to stop streaming or context.Background() if you want
 // to stream indefinitely. TransactionHandler is a user-supplied function that is executed for each streamed transaction received.
@@ -64,7 +65,6 @@ func (tr TransactionRequest) StreamTransactions(ctx context.Context, client *Cli
 		if err != nil {
 			return errors.Wrap(err, "Error unmarshaling data")
 		}
-		handler(transaction)
-		return nil
+		return handler(transaction)
 	})
 }
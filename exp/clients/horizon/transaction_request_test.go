@@ -69,8 +69,9 @@ func ExampleClient_StreamTransactions() {
 		cancel()
 	}()
 
-	printHandler := func(tr hProtocol.Transaction) {
+	printHandler := func(tr hProtocol.Transaction) error {
 		fmt.Println(tr)
+		return nil
 	}
 	err := client.StreamTransactions(ctx, transactionRequest, printHandler)
 	if err != nil {
@@ -95,9 +96,10 @@ func TestTransactionRequestStreamTransactions(t *testing.T) {
 	).ReturnString(200, txStreamResponse)
 
 	transactions := make([]hProtocol.Transaction, 1)
-	err := client.StreamTransactions(ctx, trRequest, func(tr hProtocol.Transaction) {
+	err := client.StreamTransactions(ctx, trRequest, func(tr hProtocol.Transaction) error {
 		transactions[0] = tr
 		cancel()
+		return nil
 	})
 
 	if assert.NoError(t, err) {
@@ -115,9 +117,10 @@ func TestTransactionRequestStreamTransactions(t *testing.T) {
 	).ReturnString(200, txStreamResponse)
 
 	transactions = make([]hProtocol.Transaction, 1)
-	err = client.StreamTransactions(ctx, trRequest, func(tr hProtocol.Transaction) {
+	err = client.StreamTransactions(ctx, trRequest, func(tr hProtocol.Transaction) error {
 		transactions[0] = tr
 		cancel()
+		return nil
 	})
 
 	if assert.NoError(t, err) {
@@ -135,8 +138,9 @@ func TestTransactionRequestStreamTransactions(t *testing.T) {
 	).ReturnString(500, txStreamResponse)
 
 	transactions = make([]hProtocol.Transaction, 1)
-	err = client.StreamTransactions(ctx, trRequest, func(tr hProtocol.Transaction) {
+	err = client.StreamTransactions(ctx, trRequest, func(tr hProtocol.Transaction) error {
 		cancel()
+		return nil
 	})
 
 	if assert.Error(t, err) {
@@ -0,0 +1,248 @@
+// Package sep10 builds and verifies the challenge transactions used by SEP-10 Stellar Web
+// Authentication, which lets a wallet prove to a server that it holds the keys of a Stellar
+// account.
+//
+// The server builds a challenge with BuildChallengeTx and hands it to the client. The client signs
+// it with the keys of its account and returns it, and the server checks it with
+// VerifyChallengeTx. Challenges are never submitted to the network.
+//
+// See https://github.com/stellar/stellar-protocol/blob/master/ecosystem/sep-0010.md
+package sep10
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"time"
+
+	horizonclient "github.com/stellar/go/exp/clients/horizon"
+	"github.com/stellar/go/exp/txnbuild"
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/network"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/strkey"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// DefaultTimeout is the time a challenge remains valid for when no timeout is given to
+// BuildChallengeTx.
+const DefaultTimeout = 5 * time.Minute
+
+// nonceLength is the number of random bytes in a challenge. Once base64 encoded, they fill the 64
+// bytes available in a ManageData value.
+const nonceLength = 48
+
+// BuildChallengeTx returns a base64 encoded SEP-10 challenge transaction for clientAccountID,
+// signed by serverSigner.
+//
+// The challenge has serverSigner as its source account, a sequence number of zero and a single
+// ManageData operation, named "<anchorName> auth", whose source account is the client's account
+// and whose value is a random nonce. It is valid from now until timeout has passed.
+func BuildChallengeTx(serverSigner *keypair.Full, clientAccountID, anchorName, networkPassphrase string, timeout time.Duration) (string, error) {
+	if serverSigner == nil {
+		return "", errors.New("No server signer provided")
+	}
+
+	_, err := strkey.Decode(strkey.VersionByteAccountID, clientAccountID)
+	if err != nil {
+		return "", errors.Wrap(err, "Invalid client account ID")
+	}
+
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	nonce := make([]byte, nonceLength)
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to generate nonce")
+	}
+
+	now := time.Now().UTC()
+	tx := txnbuild.Transaction{
+		// The sequence number is incremented to zero when the transaction is built
+		SourceAccount: &txnbuild.SimpleAccount{AccountID: serverSigner.Address(), Sequence: -1},
+		Operations: []txnbuild.Operation{
+			&txnbuild.ManageData{
+				Name:          anchorName + " auth",
+				Value:         []byte(base64.StdEncoding.EncodeToString(nonce)),
+				SourceAccount: &txnbuild.SimpleAccount{AccountID: clientAccountID},
+			},
+		},
+		Timebounds: txnbuild.NewTimebounds(now.Unix(), now.Add(timeout).Unix()),
+		Network:    networkPassphrase,
+	}
+
+	err = tx.Build()
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to build challenge transaction")
+	}
+
+	err = tx.Sign(serverSigner)
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to sign challenge transaction")
+	}
+
+	return tx.Base64()
+}
+
+// VerifyChallengeTx checks a challenge transaction built by the server serverAccountID and signed
+// by the client, and returns the ID of the client's account.
+//
+// The challenge must be well formed, be within its time bounds and carry a valid signature of the
+// server. The client's signers and thresholds are then read from Horizon: every other signature
+// must be from a signer of the client's account, and their combined weight must meet the
+// account's medium threshold.
+func VerifyChallengeTx(challengeTx, serverAccountID, networkPassphrase string, client horizonclient.ClientInterface) (string, error) {
+	var txe xdr.TransactionEnvelope
+	err := xdr.SafeUnmarshalBase64(challengeTx, &txe)
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to decode challenge transaction")
+	}
+
+	clientAccountID, err := verifyChallengeContents(txe.Tx, serverAccountID)
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := network.HashTransaction(&txe.Tx, networkPassphrase)
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to hash challenge transaction")
+	}
+
+	signatures, ok := removeSignature(txe.Signatures, serverAccountID, hash)
+	if !ok {
+		return "", errors.New("Challenge transaction is not signed by the server")
+	}
+
+	if client == nil {
+		return "", errors.New("No horizon client provided to load the client account")
+	}
+
+	account, err := client.AccountDetail(horizonclient.AccountRequest{AccountID: clientAccountID})
+	if err != nil {
+		return "", errors.Wrap(err, "Failed to load client account")
+	}
+
+	weight, err := signaturesWeight(signatures, account.Signers, hash)
+	if err != nil {
+		return "", err
+	}
+
+	threshold := int32(account.Thresholds.MedThreshold)
+	if weight == 0 || weight < threshold {
+		return "", errors.Errorf("Signatures weight %d does not meet the account's medium threshold %d", weight, threshold)
+	}
+
+	return clientAccountID, nil
+}
+
+// verifyChallengeContents checks the structure and time bounds of a challenge transaction and
+// returns the client account ID it was issued for.
+func verifyChallengeContents(tx xdr.Transaction, serverAccountID string) (string, error) {
+	if tx.SourceAccount.Address() != serverAccountID {
+		return "", errors.New("Challenge transaction source account is not the server account")
+	}
+
+	if tx.SeqNum != 0 {
+		return "", errors.New("Challenge transaction sequence number must be 0")
+	}
+
+	if tx.TimeBounds == nil {
+		return "", errors.New("Challenge transaction has no time bounds")
+	}
+
+	now := time.Now().UTC().Unix()
+	if now < int64(tx.TimeBounds.MinTime) || (tx.TimeBounds.MaxTime != 0 && now > int64(tx.TimeBounds.MaxTime)) {
+		return "", errors.New("Challenge transaction has expired")
+	}
+
+	if len(tx.Operations) != 1 {
+		return "", errors.New("Challenge transaction must have exactly one operation")
+	}
+
+	op := tx.Operations[0]
+	manageData, ok := op.Body.GetManageDataOp()
+	if !ok {
+		return "", errors.New("Challenge transaction operation must be a manage_data operation")
+	}
+
+	if op.SourceAccount == nil {
+		return "", errors.New("Challenge transaction operation has no source account")
+	}
+
+	if manageData.DataValue == nil || len(*manageData.DataValue) != base64.StdEncoding.EncodedLen(nonceLength) {
+		return "", errors.New("Challenge transaction has an invalid nonce")
+	}
+
+	return op.SourceAccount.Address(), nil
+}
+
+// removeSignature returns signatures without the one made by address, and whether such a
+// signature was found.
+func removeSignature(signatures []xdr.DecoratedSignature, address string, hash [32]byte) ([]xdr.DecoratedSignature, bool) {
+	kp, err := keypair.Parse(address)
+	if err != nil {
+		return signatures, false
+	}
+
+	for i, signature := range signatures {
+		if verifySignature(kp, signature, hash) {
+			rest := make([]xdr.DecoratedSignature, 0, len(signatures)-1)
+			rest = append(rest, signatures[:i]...)
+			return append(rest, signatures[i+1:]...), true
+		}
+	}
+
+	return signatures, false
+}
+
+// signaturesWeight returns the combined weight of the signers that made signatures. Each signer
+// is only counted once, and a signature made by a key that isn't one of the signers is an error.
+func signaturesWeight(signatures []xdr.DecoratedSignature, signers []hProtocol.Signer, hash [32]byte) (int32, error) {
+	weight := int32(0)
+	counted := map[string]bool{}
+
+	for _, signature := range signatures {
+		signer, ok := findSigner(signature, signers, hash)
+		if !ok {
+			return 0, errors.New("Challenge transaction has a signature that is not from a signer of the client account")
+		}
+
+		if !counted[signer.Key] {
+			counted[signer.Key] = true
+			weight += signer.Weight
+		}
+	}
+
+	return weight, nil
+}
+
+// findSigner returns the ed25519 signer whose key made signature.
+func findSigner(signature xdr.DecoratedSignature, signers []hProtocol.Signer, hash [32]byte) (hProtocol.Signer, bool) {
+	for _, signer := range signers {
+		if signer.Type != hProtocol.KeyTypeNames[strkey.VersionByteAccountID] {
+			continue
+		}
+
+		kp, err := keypair.Parse(signer.Key)
+		if err != nil {
+			continue
+		}
+
+		if verifySignature(kp, signature, hash) {
+			return signer, true
+		}
+	}
+
+	return hProtocol.Signer{}, false
+}
+
+// verifySignature reports whether signature was made by kp over hash.
+func verifySignature(kp keypair.KP, signature xdr.DecoratedSignature, hash [32]byte) bool {
+	if signature.Hint != xdr.SignatureHint(kp.Hint()) {
+		return false
+	}
+
+	return kp.Verify(hash[:], signature.Signature) == nil
+}
@@ -0,0 +1,251 @@
+package sep10
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	horizonclient "github.com/stellar/go/exp/clients/horizon"
+	"github.com/stellar/go/exp/txnbuild"
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/network"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	serverKP    = keypair.MustParse("SBPQUZ6G4FZNWFHKUWC5BEYWF6R52E3SEP7R3GWYSM2XTKGF5LNTWW4R").(*keypair.Full)
+	clientKP    = keypair.MustParse("SBMSVD4KKELKGZXHBUQTIROWUAPQASDX7KEJITARP4VMZ6KLUHOGPTYW").(*keypair.Full)
+	cosigner    = keypair.MustParse("SBZVMB74Z76QZ3ZOY7UTDFYKMEGKW5XFJEB6PFKBF4UYSSWHG4EDH7PY").(*keypair.Full)
+	testNetwork = network.TestNetworkPassphrase
+)
+
+func clientAccount(medThreshold byte, signers ...hProtocol.Signer) hProtocol.Account {
+	account := hProtocol.Account{Signers: signers}
+	account.AccountID = clientKP.Address()
+	account.Thresholds.MedThreshold = medThreshold
+	return account
+}
+
+func signer(kp keypair.KP, weight int32) hProtocol.Signer {
+	return hProtocol.Signer{Key: kp.Address(), Weight: weight, Type: "ed25519_public_key"}
+}
+
+// signChallenge adds the signatures of kps to a base64 encoded challenge.
+func signChallenge(t *testing.T, challenge string, kps ...*keypair.Full) string {
+	var txe xdr.TransactionEnvelope
+	require.NoError(t, xdr.SafeUnmarshalBase64(challenge, &txe))
+
+	hash, err := network.HashTransaction(&txe.Tx, testNetwork)
+	require.NoError(t, err)
+
+	for _, kp := range kps {
+		sig, err := kp.SignDecorated(hash[:])
+		require.NoError(t, err)
+		txe.Signatures = append(txe.Signatures, sig)
+	}
+
+	signed, err := xdr.MarshalBase64(txe)
+	require.NoError(t, err)
+	return signed
+}
+
+func TestBuildChallengeTx(t *testing.T) {
+	challenge, err := BuildChallengeTx(serverKP, clientKP.Address(), "SDF", testNetwork, time.Minute)
+	require.NoError(t, err)
+
+	var txe xdr.TransactionEnvelope
+	require.NoError(t, xdr.SafeUnmarshalBase64(challenge, &txe))
+
+	assert.Equal(t, serverKP.Address(), txe.Tx.SourceAccount.Address())
+	assert.Equal(t, xdr.SequenceNumber(0), txe.Tx.SeqNum)
+	require.NotNil(t, txe.Tx.TimeBounds)
+	assert.Equal(t, xdr.Uint64(60), txe.Tx.TimeBounds.MaxTime-txe.Tx.TimeBounds.MinTime)
+
+	require.Len(t, txe.Tx.Operations, 1)
+	op := txe.Tx.Operations[0]
+	assert.Equal(t, clientKP.Address(), op.SourceAccount.Address())
+	manageData := op.Body.MustManageDataOp()
+	assert.Equal(t, xdr.String64("SDF auth"), manageData.DataName)
+	require.NotNil(t, manageData.DataValue)
+	assert.Len(t, *manageData.DataValue, 64)
+	_, err = base64.StdEncoding.DecodeString(string(*manageData.DataValue))
+	assert.NoError(t, err)
+
+	require.Len(t, txe.Signatures, 1)
+	hash, err := network.HashTransaction(&txe.Tx, testNetwork)
+	require.NoError(t, err)
+	assert.NoError(t, serverKP.Verify(hash[:], txe.Signatures[0].Signature))
+
+	// Each challenge has its own nonce
+	other, err := BuildChallengeTx(serverKP, clientKP.Address(), "SDF", testNetwork, time.Minute)
+	require.NoError(t, err)
+	assert.NotEqual(t, challenge, other)
+}
+
+func TestBuildChallengeTxInvalidClient(t *testing.T) {
+	_, err := BuildChallengeTx(serverKP, "GABC", "SDF", testNetwork, time.Minute)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "Invalid client account ID")
+	}
+}
+
+func TestVerifyChallengeTx(t *testing.T) {
+	challenge, err := BuildChallengeTx(serverKP, clientKP.Address(), "SDF", testNetwork, time.Minute)
+	require.NoError(t, err)
+
+	hmock := &horizonclient.MockClient{}
+	hmock.On("AccountDetail", horizonclient.AccountRequest{AccountID: clientKP.Address()}).
+		Return(clientAccount(1, signer(clientKP, 1)), nil)
+
+	accountID, err := VerifyChallengeTx(signChallenge(t, challenge, clientKP), serverKP.Address(), testNetwork, hmock)
+	assert.NoError(t, err)
+	assert.Equal(t, clientKP.Address(), accountID)
+}
+
+func TestVerifyChallengeTxThresholds(t *testing.T) {
+	challenge, err := BuildChallengeTx(serverKP, clientKP.Address(), "SDF", testNetwork, time.Minute)
+	require.NoError(t, err)
+
+	hmock := &horizonclient.MockClient{}
+	hmock.On("AccountDetail", horizonclient.AccountRequest{AccountID: clientKP.Address()}).
+		Return(clientAccount(3, signer(clientKP, 1), signer(cosigner, 2)), nil)
+
+	_, err = VerifyChallengeTx(signChallenge(t, challenge, clientKP), serverKP.Address(), testNetwork, hmock)
+	assert.EqualError(t, err, "Signatures weight 1 does not meet the account's medium threshold 3")
+
+	// A signer is only counted once
+	_, err = VerifyChallengeTx(signChallenge(t, challenge, clientKP, clientKP), serverKP.Address(), testNetwork, hmock)
+	assert.EqualError(t, err, "Signatures weight 1 does not meet the account's medium threshold 3")
+
+	accountID, err := VerifyChallengeTx(signChallenge(t, challenge, clientKP, cosigner), serverKP.Address(), testNetwork, hmock)
+	assert.NoError(t, err)
+	assert.Equal(t, clientKP.Address(), accountID)
+}
+
+func TestVerifyChallengeTxUnknownSigner(t *testing.T) {
+	challenge, err := BuildChallengeTx(serverKP, clientKP.Address(), "SDF", testNetwork, time.Minute)
+	require.NoError(t, err)
+
+	hmock := &horizonclient.MockClient{}
+	hmock.On("AccountDetail", horizonclient.AccountRequest{AccountID: clientKP.Address()}).
+		Return(clientAccount(1, signer(clientKP, 1)), nil)
+
+	_, err = VerifyChallengeTx(signChallenge(t, challenge, cosigner), serverKP.Address(), testNetwork, hmock)
+	assert.EqualError(t, err, "Challenge transaction has a signature that is not from a signer of the client account")
+
+	_, err = VerifyChallengeTx(challenge, serverKP.Address(), testNetwork, hmock)
+	assert.EqualError(t, err, "Signatures weight 0 does not meet the account's medium threshold 1")
+}
+
+func TestVerifyChallengeTxServerSignature(t *testing.T) {
+	challenge, err := BuildChallengeTx(serverKP, clientKP.Address(), "SDF", testNetwork, time.Minute)
+	require.NoError(t, err)
+
+	var txe xdr.TransactionEnvelope
+	require.NoError(t, xdr.SafeUnmarshalBase64(challenge, &txe))
+	txe.Signatures = nil
+	unsigned, err := xdr.MarshalBase64(txe)
+	require.NoError(t, err)
+
+	_, err = VerifyChallengeTx(signChallenge(t, unsigned, clientKP), serverKP.Address(), testNetwork, &horizonclient.MockClient{})
+	assert.EqualError(t, err, "Challenge transaction is not signed by the server")
+
+	// Signed for another network
+	_, err = VerifyChallengeTx(signChallenge(t, challenge, clientKP), serverKP.Address(), network.PublicNetworkPassphrase, &horizonclient.MockClient{})
+	assert.EqualError(t, err, "Challenge transaction is not signed by the server")
+}
+
+func TestVerifyChallengeTxContents(t *testing.T) {
+	build := func(tx txnbuild.Transaction) string {
+		require.NoError(t, tx.Build())
+		require.NoError(t, tx.Sign(serverKP))
+		encoded, err := tx.Base64()
+		require.NoError(t, err)
+		return encoded
+	}
+	clientSource := &txnbuild.SimpleAccount{AccountID: clientKP.Address()}
+	nonce := []byte(base64.StdEncoding.EncodeToString(make([]byte, nonceLength)))
+	now := time.Now().UTC().Unix()
+
+	testCases := []struct {
+		name     string
+		tx       txnbuild.Transaction
+		expected string
+	}{
+		{
+			"wrong source account",
+			txnbuild.Transaction{
+				SourceAccount: &txnbuild.SimpleAccount{AccountID: clientKP.Address(), Sequence: -1},
+				Operations:    []txnbuild.Operation{&txnbuild.ManageData{Name: "SDF auth", Value: nonce, SourceAccount: clientSource}},
+				Timebounds:    txnbuild.NewTimebounds(now, now+60),
+			},
+			"Challenge transaction source account is not the server account",
+		},
+		{
+			"non-zero sequence number",
+			txnbuild.Transaction{
+				SourceAccount: &txnbuild.SimpleAccount{AccountID: serverKP.Address()},
+				Operations:    []txnbuild.Operation{&txnbuild.ManageData{Name: "SDF auth", Value: nonce, SourceAccount: clientSource}},
+				Timebounds:    txnbuild.NewTimebounds(now, now+60),
+			},
+			"Challenge transaction sequence number must be 0",
+		},
+		{
+			"no time bounds",
+			txnbuild.Transaction{
+				SourceAccount: &txnbuild.SimpleAccount{AccountID: serverKP.Address(), Sequence: -1},
+				Operations:    []txnbuild.Operation{&txnbuild.ManageData{Name: "SDF auth", Value: nonce, SourceAccount: clientSource}},
+			},
+			"Challenge transaction has no time bounds",
+		},
+		{
+			"expired",
+			txnbuild.Transaction{
+				SourceAccount: &txnbuild.SimpleAccount{AccountID: serverKP.Address(), Sequence: -1},
+				Operations:    []txnbuild.Operation{&txnbuild.ManageData{Name: "SDF auth", Value: nonce, SourceAccount: clientSource}},
+				Timebounds:    txnbuild.NewTimebounds(now-120, now-60),
+			},
+			"Challenge transaction has expired",
+		},
+		{
+			"wrong operation type",
+			txnbuild.Transaction{
+				SourceAccount: &txnbuild.SimpleAccount{AccountID: serverKP.Address(), Sequence: -1},
+				Operations:    []txnbuild.Operation{&txnbuild.BumpSequence{BumpTo: 1, SourceAccount: clientSource}},
+				Timebounds:    txnbuild.NewTimebounds(now, now+60),
+			},
+			"Challenge transaction operation must be a manage_data operation",
+		},
+		{
+			"no operation source account",
+			txnbuild.Transaction{
+				SourceAccount: &txnbuild.SimpleAccount{AccountID: serverKP.Address(), Sequence: -1},
+				Operations:    []txnbuild.Operation{&txnbuild.ManageData{Name: "SDF auth", Value: nonce}},
+				Timebounds:    txnbuild.NewTimebounds(now, now+60),
+			},
+			"Challenge transaction operation has no source account",
+		},
+		{
+			"invalid nonce",
+			txnbuild.Transaction{
+				SourceAccount: &txnbuild.SimpleAccount{AccountID: serverKP.Address(), Sequence: -1},
+				Operations:    []txnbuild.Operation{&txnbuild.ManageData{Name: "SDF auth", Value: []byte("nonce"), SourceAccount: clientSource}},
+				Timebounds:    txnbuild.NewTimebounds(now, now+60),
+			},
+			"Challenge transaction has an invalid nonce",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.tx.Network = testNetwork
+			challenge := signChallenge(t, build(tc.tx), clientKP)
+
+			_, err := VerifyChallengeTx(challenge, serverKP.Address(), testNetwork, &horizonclient.MockClient{})
+			assert.EqualError(t, err, tc.expected)
+		})
+	}
+}
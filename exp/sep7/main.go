@@ -0,0 +1,320 @@
+// Package sep7 parses and generates the `web+stellar:` URIs described by SEP-7, which let a
+// website or application ask a wallet to sign a transaction (the `tx` operation) or to make a
+// payment (the `pay` operation).
+//
+// URIs can be signed by the domain they originate from. The signing key is published as
+// URI_REQUEST_SIGNING_KEY in the domain's stellar.toml file.
+//
+// See https://github.com/stellar/stellar-protocol/blob/master/ecosystem/sep-0007.md
+package sep7
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/stellar/go/exp/txnbuild"
+	"github.com/stellar/go/support/errors"
+)
+
+// Scheme is the URI scheme of SEP-7 requests.
+const Scheme = "web+stellar"
+
+// The operations a SEP-7 URI can request.
+const (
+	OperationTx  = "tx"
+	OperationPay = "pay"
+)
+
+// The memo types accepted by the memo_type parameter of a pay URI.
+const (
+	MemoTypeText   = "MEMO_TEXT"
+	MemoTypeID     = "MEMO_ID"
+	MemoTypeHash   = "MEMO_HASH"
+	MemoTypeReturn = "MEMO_RETURN"
+)
+
+// callbackPrefix prefixes the value of the callback parameter.
+const callbackPrefix = "url:"
+
+// maxMessageLength is the maximum number of characters allowed in the msg parameter.
+const maxMessageLength = 300
+
+// URI is implemented by TxURI and PayURI.
+type URI interface {
+	// Operation returns the operation requested by the URI, OperationTx or OperationPay.
+	Operation() string
+	// String returns the encoded URI, including its signature if it has one.
+	String() string
+}
+
+// TxURI is a SEP-7 request to sign, and usually submit, a transaction.
+type TxURI struct {
+	// XDR is the base64 encoded TransactionEnvelope to sign.
+	XDR string
+	// Callback is the URL the signed transaction is posted to, instead of submitting it to the
+	// network.
+	Callback string
+	// Pubkey is the account that should sign the transaction.
+	Pubkey string
+	Params
+}
+
+// PayURI is a SEP-7 request to pay an account.
+type PayURI struct {
+	Destination string
+	// Amount is left empty to let the user choose the amount.
+	Amount string
+	// AssetCode and AssetIssuer are left empty to request lumens.
+	AssetCode   string
+	AssetIssuer string
+	Memo        string
+	// MemoType is one of the MemoType constants. Hash and return memos are base64 encoded.
+	MemoType string
+	// Callback is the URL the signed transaction is posted to, instead of submitting it to the
+	// network.
+	Callback string
+	Params
+}
+
+// Params holds the parameters shared by all SEP-7 operations.
+type Params struct {
+	// Message is shown to the user. It can be up to 300 characters long.
+	Message string
+	// NetworkPassphrase is only set for networks other than the public network.
+	NetworkPassphrase string
+	// OriginDomain is the domain whose URI_REQUEST_SIGNING_KEY signed the URI.
+	OriginDomain string
+	// Signature is the base64 encoded signature of the URI by OriginDomain.
+	Signature string
+}
+
+// Operation for TxURI returns OperationTx.
+func (u *TxURI) Operation() string {
+	return OperationTx
+}
+
+// String for TxURI returns the encoded URI.
+func (u *TxURI) String() string {
+	return u.encode(true)
+}
+
+func (u *TxURI) encode(withSignature bool) string {
+	params := []param{
+		{"xdr", u.XDR},
+		{"callback", callbackValue(u.Callback)},
+		{"pubkey", u.Pubkey},
+	}
+	return encodeURI(OperationTx, append(params, u.Params.params(withSignature)...))
+}
+
+// Operation for PayURI returns OperationPay.
+func (u *PayURI) Operation() string {
+	return OperationPay
+}
+
+// String for PayURI returns the encoded URI.
+func (u *PayURI) String() string {
+	return u.encode(true)
+}
+
+func (u *PayURI) encode(withSignature bool) string {
+	params := []param{
+		{"destination", u.Destination},
+		{"amount", u.Amount},
+		{"asset_code", u.AssetCode},
+		{"asset_issuer", u.AssetIssuer},
+		{"memo", u.Memo},
+		{"memo_type", u.MemoType},
+		{"callback", callbackValue(u.Callback)},
+	}
+	return encodeURI(OperationPay, append(params, u.Params.params(withSignature)...))
+}
+
+// Asset for PayURI returns the asset requested by the URI.
+func (u *PayURI) Asset() *txnbuild.Asset {
+	if u.AssetCode == "" {
+		return txnbuild.NewNativeAsset()
+	}
+
+	return txnbuild.NewAsset(u.AssetCode, u.AssetIssuer)
+}
+
+// Payment for PayURI returns the Payment operation requested by the URI. It returns an error if
+// the URI leaves the amount to the user, in which case the amount must be set on the returned
+// operation.
+func (u *PayURI) Payment() (txnbuild.Payment, error) {
+	payment := txnbuild.Payment{
+		Destination: u.Destination,
+		Amount:      u.Amount,
+		Asset:       u.Asset(),
+	}
+
+	if u.Amount == "" {
+		return payment, errors.New("pay URI has no amount")
+	}
+
+	return payment, payment.Validate()
+}
+
+// TxMemo for PayURI returns the memo requested by the URI, to be set on the transaction that
+// holds the payment, or nil if the URI has no memo.
+func (u *PayURI) TxMemo() (txnbuild.Memo, error) {
+	if u.Memo == "" {
+		return nil, nil
+	}
+
+	switch u.MemoType {
+	case "", MemoTypeText:
+		return txnbuild.MemoText(u.Memo), nil
+	case MemoTypeID:
+		id, err := strconv.ParseUint(u.Memo, 10, 64)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to parse id memo")
+		}
+		return txnbuild.MemoID(id), nil
+	case MemoTypeHash, MemoTypeReturn:
+		hash, err := decodeMemoHash(u.Memo)
+		if err != nil {
+			return nil, err
+		}
+		if u.MemoType == MemoTypeHash {
+			return txnbuild.MemoHash(hash), nil
+		}
+		return txnbuild.MemoReturn(hash), nil
+	}
+
+	return nil, errors.Errorf("Unknown memo type: %s", u.MemoType)
+}
+
+// Parse decodes a SEP-7 URI into a *TxURI or a *PayURI.
+func Parse(uri string) (URI, error) {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to parse URI")
+	}
+
+	if parsed.Scheme != Scheme {
+		return nil, errors.Errorf("URI scheme must be %s", Scheme)
+	}
+
+	query, err := url.ParseQuery(parsed.RawQuery)
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to parse URI parameters")
+	}
+
+	params := Params{
+		Message:           query.Get("msg"),
+		NetworkPassphrase: query.Get("network_passphrase"),
+		OriginDomain:      query.Get("origin_domain"),
+		Signature:         query.Get("signature"),
+	}
+	if len(params.Message) > maxMessageLength {
+		return nil, errors.Errorf("msg can't be longer than %d characters", maxMessageLength)
+	}
+
+	callback := query.Get("callback")
+	if callback != "" {
+		if !strings.HasPrefix(callback, callbackPrefix) {
+			return nil, errors.Errorf("callback must start with %q", callbackPrefix)
+		}
+		callback = strings.TrimPrefix(callback, callbackPrefix)
+	}
+
+	switch parsed.Opaque {
+	case OperationTx:
+		u := &TxURI{
+			XDR:      query.Get("xdr"),
+			Callback: callback,
+			Pubkey:   query.Get("pubkey"),
+			Params:   params,
+		}
+		if u.XDR == "" {
+			return nil, errors.New("tx URI has no xdr parameter")
+		}
+		return u, nil
+	case OperationPay:
+		u := &PayURI{
+			Destination: query.Get("destination"),
+			Amount:      query.Get("amount"),
+			AssetCode:   query.Get("asset_code"),
+			AssetIssuer: query.Get("asset_issuer"),
+			Memo:        query.Get("memo"),
+			MemoType:    query.Get("memo_type"),
+			Callback:    callback,
+			Params:      params,
+		}
+		if u.Destination == "" {
+			return nil, errors.New("pay URI has no destination parameter")
+		}
+		return u, nil
+	}
+
+	return nil, errors.Errorf("Unknown operation: %s", parsed.Opaque)
+}
+
+// param is a URI parameter. Parameters are encoded in the order they are listed, and empty
+// ones are left out.
+type param struct {
+	name  string
+	value string
+}
+
+func (p Params) params(withSignature bool) []param {
+	params := []param{
+		{"msg", p.Message},
+		{"network_passphrase", p.NetworkPassphrase},
+		{"origin_domain", p.OriginDomain},
+	}
+
+	// The signature must be the last parameter, as it signs everything before it
+	if withSignature {
+		params = append(params, param{"signature", p.Signature})
+	}
+
+	return params
+}
+
+func encodeURI(operation string, params []param) string {
+	var query []string
+	for _, p := range params {
+		if p.value == "" {
+			continue
+		}
+		query = append(query, fmt.Sprintf("%s=%s", p.name, queryEscape(p.value)))
+	}
+
+	return fmt.Sprintf("%s:%s?%s", Scheme, operation, strings.Join(query, "&"))
+}
+
+// queryEscape escapes s for use as a parameter value. Spaces are percent-encoded rather than
+// replaced by "+", as recommended by SEP-7.
+func queryEscape(s string) string {
+	return strings.Replace(url.QueryEscape(s), "+", "%20", -1)
+}
+
+func callbackValue(callback string) string {
+	if callback == "" {
+		return ""
+	}
+
+	return callbackPrefix + callback
+}
+
+func decodeMemoHash(memo string) ([32]byte, error) {
+	var hash [32]byte
+
+	decoded, err := base64.StdEncoding.DecodeString(memo)
+	if err != nil {
+		return hash, errors.Wrap(err, "Failed to decode hash memo")
+	}
+
+	if len(decoded) != len(hash) {
+		return hash, errors.New("Hash memo must be 32 bytes long")
+	}
+
+	copy(hash[:], decoded)
+	return hash, nil
+}
@@ -0,0 +1,141 @@
+package sep7
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stellar/go/exp/txnbuild"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const destination = "GCALNQQBXAPZ2WIRSDDBMSTAKCUH5SG6U76YBFLQLIXJTF7FE5AX7AOO"
+
+func TestPayURIString(t *testing.T) {
+	u := PayURI{
+		Destination: destination,
+		Amount:      "120.1234567",
+		Memo:        "skdjfasf",
+		Params:      Params{Message: "pay me with lumens"},
+	}
+
+	assert.Equal(t,
+		"web+stellar:pay?destination=GCALNQQBXAPZ2WIRSDDBMSTAKCUH5SG6U76YBFLQLIXJTF7FE5AX7AOO&amount=120.1234567&memo=skdjfasf&msg=pay%20me%20with%20lumens",
+		u.String(),
+	)
+}
+
+func TestTxURIRoundTrip(t *testing.T) {
+	u := &TxURI{
+		XDR:      "AAAAAP+yw+ZEuNg533pUmwlYxfrq6/BoMJqiJ8vuQhf6rHWmAAAAZAB8NHAAAAABAAAAAAAAAAAAAAABAAAAAAAAAAYAAAABSFVHAAAAAABAH0wIyY3BJBS2qHdRY5WcWlqq3KQhQ6G1lgAAAAAAAAAAAAAAAAAAAAAAAAAA",
+		Callback: "https://someSigningService.com/a?b=c",
+		Pubkey:   destination,
+		Params: Params{
+			NetworkPassphrase: "Test SDF Network ; September 2015",
+			OriginDomain:      "someDomain.com",
+		},
+	}
+
+	parsed, err := Parse(u.String())
+	require.NoError(t, err)
+	assert.Equal(t, u, parsed)
+	assert.Equal(t, OperationTx, parsed.Operation())
+}
+
+func TestPayURIRoundTrip(t *testing.T) {
+	u := &PayURI{
+		Destination: destination,
+		Amount:      "10",
+		AssetCode:   "USD",
+		AssetIssuer: "GAWSI2JO2CF36Z43UGMUJCDQ2IMR5B3P5TMS7XM7NUTU3JHG3YJUDQXA",
+		Memo:        base64.StdEncoding.EncodeToString(make([]byte, 32)),
+		MemoType:    MemoTypeHash,
+		Callback:    "https://example.com/callback",
+		Params:      Params{Message: "100% & more?"},
+	}
+
+	parsed, err := Parse(u.String())
+	require.NoError(t, err)
+	assert.Equal(t, u, parsed)
+	assert.Equal(t, OperationPay, parsed.Operation())
+}
+
+func TestParseErrors(t *testing.T) {
+	testCases := []struct {
+		uri      string
+		expected string
+	}{
+		{"https://stellar.org", "URI scheme must be web+stellar"},
+		{"web+stellar:sign?xdr=AAAA", "Unknown operation: sign"},
+		{"web+stellar:tx?pubkey=" + destination, "tx URI has no xdr parameter"},
+		{"web+stellar:pay?amount=10", "pay URI has no destination parameter"},
+		{"web+stellar:pay?destination=" + destination + "&callback=https://example.com", `callback must start with "url:"`},
+	}
+
+	for _, tc := range testCases {
+		_, err := Parse(tc.uri)
+		assert.EqualError(t, err, tc.expected, tc.uri)
+	}
+}
+
+func TestPayURIPayment(t *testing.T) {
+	u := PayURI{Destination: destination, Amount: "120.1234567"}
+	payment, err := u.Payment()
+	require.NoError(t, err)
+	assert.Equal(t, txnbuild.Payment{
+		Destination: destination,
+		Amount:      "120.1234567",
+		Asset:       txnbuild.NewNativeAsset(),
+	}, payment)
+
+	u = PayURI{
+		Destination: destination,
+		Amount:      "5",
+		AssetCode:   "USD",
+		AssetIssuer: "GAWSI2JO2CF36Z43UGMUJCDQ2IMR5B3P5TMS7XM7NUTU3JHG3YJUDQXA",
+	}
+	payment, err = u.Payment()
+	require.NoError(t, err)
+	assert.Equal(t, txnbuild.NewAsset("USD", "GAWSI2JO2CF36Z43UGMUJCDQ2IMR5B3P5TMS7XM7NUTU3JHG3YJUDQXA"), payment.Asset)
+
+	u = PayURI{Destination: destination}
+	_, err = u.Payment()
+	assert.EqualError(t, err, "pay URI has no amount")
+
+	u = PayURI{Destination: "GABC", Amount: "5"}
+	_, err = u.Payment()
+	assert.Error(t, err)
+}
+
+func TestPayURITxMemo(t *testing.T) {
+	hash := [32]byte{1, 2, 3}
+	encodedHash := base64.StdEncoding.EncodeToString(hash[:])
+
+	testCases := []struct {
+		memo     string
+		memoType string
+		expected txnbuild.Memo
+	}{
+		{"", "", nil},
+		{"hello", "", txnbuild.MemoText("hello")},
+		{"hello", MemoTypeText, txnbuild.MemoText("hello")},
+		{"42", MemoTypeID, txnbuild.MemoID(42)},
+		{encodedHash, MemoTypeHash, txnbuild.MemoHash(hash)},
+		{encodedHash, MemoTypeReturn, txnbuild.MemoReturn(hash)},
+	}
+
+	for _, tc := range testCases {
+		u := PayURI{Destination: destination, Memo: tc.memo, MemoType: tc.memoType}
+		memo, err := u.TxMemo()
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, memo)
+	}
+
+	u := PayURI{Destination: destination, Memo: "abc", MemoType: MemoTypeID}
+	_, err := u.TxMemo()
+	assert.Error(t, err)
+
+	u = PayURI{Destination: destination, Memo: "abc", MemoType: "MEMO_FOO"}
+	_, err = u.TxMemo()
+	assert.EqualError(t, err, "Unknown memo type: MEMO_FOO")
+}
@@ -0,0 +1,114 @@
+package sep7
+
+import (
+	"encoding/base64"
+	"net/url"
+	"strings"
+
+	"github.com/stellar/go/clients/stellartoml"
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/support/errors"
+)
+
+// signatureParam precedes the signature, which is always the last parameter of a signed URI.
+const signatureParam = "&signature="
+
+// signaturePrefix is prepended to a URI to form the payload that is signed: 35 zero bytes and a
+// byte holding 4, followed by the SEP-7 scheme name.
+var signaturePrefix = append(append(make([]byte, 35), 4), []byte("stellar.sep.7 - URI Scheme")...)
+
+// Sign signs uri, which must be a *TxURI or a *PayURI, with the URI_REQUEST_SIGNING_KEY of its
+// origin domain, and sets its Signature.
+func Sign(uri URI, signer *keypair.Full) error {
+	var params *Params
+	var unsigned string
+
+	switch u := uri.(type) {
+	case *TxURI:
+		params, unsigned = &u.Params, u.encode(false)
+	case *PayURI:
+		params, unsigned = &u.Params, u.encode(false)
+	default:
+		return errors.Errorf("Unsupported URI type %T", uri)
+	}
+
+	if params.OriginDomain == "" {
+		return errors.New("URI has no origin domain")
+	}
+
+	signature, err := signer.Sign(signaturePayload(unsigned))
+	if err != nil {
+		return errors.Wrap(err, "Failed to sign URI")
+	}
+
+	params.Signature = base64.StdEncoding.EncodeToString(signature)
+	return nil
+}
+
+// Verify checks that the signed URI uri was signed by the URI_REQUEST_SIGNING_KEY published in
+// the stellar.toml file of its origin domain, which is fetched using client.
+func Verify(uri string, client stellartoml.ClientInterface) error {
+	parsed, err := Parse(uri)
+	if err != nil {
+		return err
+	}
+
+	var params Params
+	switch u := parsed.(type) {
+	case *TxURI:
+		params = u.Params
+	case *PayURI:
+		params = u.Params
+	}
+
+	if params.OriginDomain == "" {
+		return errors.New("URI has no origin domain")
+	}
+
+	stoml, err := client.GetStellarToml(params.OriginDomain)
+	if err != nil {
+		return errors.Wrap(err, "Failed to get stellar.toml of origin domain")
+	}
+
+	if stoml.URIRequestSigningKey == "" {
+		return errors.Errorf("stellar.toml of %s has no URI_REQUEST_SIGNING_KEY", params.OriginDomain)
+	}
+
+	return VerifySignature(uri, stoml.URIRequestSigningKey)
+}
+
+// VerifySignature checks that the signed URI uri was signed by signingKey.
+func VerifySignature(uri string, signingKey string) error {
+	i := strings.LastIndex(uri, signatureParam)
+	if i < 0 {
+		return errors.New("URI is not signed")
+	}
+
+	encoded, err := url.QueryUnescape(uri[i+len(signatureParam):])
+	if err != nil {
+		return errors.Wrap(err, "Failed to unescape signature")
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return errors.Wrap(err, "Failed to decode signature")
+	}
+
+	kp, err := keypair.Parse(signingKey)
+	if err != nil {
+		return errors.Wrap(err, "Invalid signing key")
+	}
+
+	err = kp.Verify(signaturePayload(uri[:i]), signature)
+	if err != nil {
+		return errors.Wrap(err, "URI signature is not valid")
+	}
+
+	return nil
+}
+
+func signaturePayload(unsigned string) []byte {
+	payload := make([]byte, 0, len(signaturePrefix)+len(unsigned))
+	payload = append(payload, signaturePrefix...)
+	return append(payload, unsigned...)
+}
@@ -0,0 +1,67 @@
+package sep7
+
+import (
+	"testing"
+
+	"github.com/stellar/go/clients/stellartoml"
+	"github.com/stellar/go/keypair"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var signer = keypair.MustParse("SBPQUZ6G4FZNWFHKUWC5BEYWF6R52E3SEP7R3GWYSM2XTKGF5LNTWW4R").(*keypair.Full)
+
+func TestSignAndVerify(t *testing.T) {
+	u := &PayURI{
+		Destination: destination,
+		Amount:      "120.1234567",
+		Params: Params{
+			Message:      "pay me with lumens",
+			OriginDomain: "someDomain.com",
+		},
+	}
+
+	require.NoError(t, Sign(u, signer))
+	assert.NotEmpty(t, u.Signature)
+
+	signed := u.String()
+	assert.NoError(t, VerifySignature(signed, signer.Address()))
+
+	other := keypair.MustParse("SBMSVD4KKELKGZXHBUQTIROWUAPQASDX7KEJITARP4VMZ6KLUHOGPTYW")
+	assert.Error(t, VerifySignature(signed, other.Address()))
+
+	// Tampering with the URI invalidates the signature
+	u.Amount = "1000"
+	assert.Error(t, VerifySignature(u.String(), signer.Address()))
+
+	// Signatures survive parsing
+	parsed, err := Parse(signed)
+	require.NoError(t, err)
+	assert.Equal(t, signed, parsed.String())
+}
+
+func TestSignWithoutOriginDomain(t *testing.T) {
+	u := &TxURI{XDR: "AAAA"}
+	assert.EqualError(t, Sign(u, signer), "URI has no origin domain")
+}
+
+func TestVerify(t *testing.T) {
+	u := &TxURI{XDR: "AAAA", Params: Params{OriginDomain: "someDomain.com"}}
+	require.NoError(t, Sign(u, signer))
+
+	tomlClient := &stellartoml.MockClient{}
+	tomlClient.On("GetStellarToml", "someDomain.com").
+		Return(&stellartoml.Response{URIRequestSigningKey: signer.Address()}, nil).Once()
+	assert.NoError(t, Verify(u.String(), tomlClient))
+
+	tomlClient.On("GetStellarToml", "someDomain.com").
+		Return(&stellartoml.Response{}, nil).Once()
+	assert.EqualError(t, Verify(u.String(), tomlClient), "stellar.toml of someDomain.com has no URI_REQUEST_SIGNING_KEY")
+
+	unsigned := &TxURI{XDR: "AAAA", Params: Params{OriginDomain: "someDomain.com"}}
+	tomlClient.On("GetStellarToml", "someDomain.com").
+		Return(&stellartoml.Response{URIRequestSigningKey: signer.Address()}, nil).Once()
+	assert.EqualError(t, Verify(unsigned.String(), tomlClient), "URI is not signed")
+
+	tomlClient.AssertExpectations(t)
+}
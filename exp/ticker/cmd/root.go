@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 
 	"github.com/sirupsen/logrus"
@@ -12,7 +13,15 @@ import (
 
 var DatabaseURL string
 var Logger = hlog.New()
-var Client = horizonclient.DefaultPublicNetClient // TODO: make this configurable
+
+// Client is the horizon client used by the scrapers. It waits for the rate limit of horizon to
+// reset rather than hitting it, and retries the requests rejected anyway.
+var Client = &horizonclient.Client{ // TODO: make this configurable
+	HorizonURL:       horizonclient.DefaultPublicNetClient.HorizonURL,
+	HTTP:             http.DefaultClient,
+	MaxRetries:       5,
+	WaitForRateLimit: true,
+}
 
 var rootCmd = &cobra.Command{
 	Use:   "ticker",
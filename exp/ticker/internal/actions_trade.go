@@ -26,21 +26,22 @@ func StreamTrades(
 		Logger: l,
 		Ctx:    &ctx,
 	}
-	handler := func(trade hProtocol.Trade) {
+	handler := func(trade hProtocol.Trade) error {
 		l.Infof("New trade arrived. ID: %v; Close Time: %v\n", trade.ID, trade.LedgerCloseTime)
 		bID, cID, err := findBaseAndCounter(s, trade)
 		if err != nil {
-			return
+			return nil
 		}
 		dbTrade, err := hProtocolTradeToDBTrade(trade, bID, cID)
 		if err != nil {
-			return
+			return nil
 		}
 
 		err = s.BulkInsertTrades([]tickerdb.Trade{dbTrade})
 		if err != nil {
 			l.Errorln("Could not insert trade in database: ", trade.ID)
 		}
+		return nil
 	}
 
 	// Ensure we start streaming from the last stored trade
@@ -297,37 +297,22 @@ func (c *ScraperConfig) parallelProcessAssets(assets []hProtocol.AssetStat, para
 func (c *ScraperConfig) retrieveAssets(limit int) (assets []hProtocol.AssetStat, err error) {
 	r := horizonclient.AssetRequest{Limit: 200}
 
-	assetsPage, err := c.Client.Assets(r)
+	it, err := horizonclient.NewIterator(c.ctx(), c.Client, r, horizonclient.IteratorOptions{
+		MaxRecords: limit,
+		Prefetch:   true,
+	})
 	if err != nil {
 		return
 	}
+	defer it.Close()
 
 	c.Logger.Infoln("Fetching assets from Horizon")
 
-	for assetsPage.Links.Next.Href != assetsPage.Links.Self.Href {
-		assetsPage, err = c.Client.Assets(r)
-		if err != nil {
-			return
-		}
-		assets = append(assets, assetsPage.Embedded.Records...)
-
-		if limit != 0 { // for performance reasons, only perform these additional checks when limit != 0
-			numAssets := len(assets)
-			if numAssets >= limit {
-				diff := numAssets - limit
-				assets = assets[0 : numAssets-diff]
-				break
-			}
-		}
-
-		nextURL := assetsPage.Links.Next.Href
-		n, err := nextCursor(nextURL)
-		if err != nil {
-			return assets, err
-		}
-		c.Logger.Debugln("Cursor currently at:", n)
-
-		r = horizonclient.AssetRequest{Limit: 200, Cursor: n}
+	for it.Next() {
+		assets = append(assets, it.Record().(hProtocol.AssetStat))
+	}
+	if err = it.Err(); err != nil {
+		return
 	}
 
 	c.Logger.Infof("Fetched: %d assets\n", len(assets))
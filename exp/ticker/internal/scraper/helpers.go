@@ -1,19 +1,12 @@
 package scraper
 
-import "net/url"
+import "context"
 
-// nextCursor finds the cursor parameter on the "next" link of a hProtocol page.
-func nextCursor(nextPageURL string) (cursor string, err error) {
-	u, err := url.Parse(nextPageURL)
-	if err != nil {
-		return
+// ctx returns the context of the scraper, or a background context if none was set.
+func (c *ScraperConfig) ctx() context.Context {
+	if c.Ctx == nil {
+		return context.Background()
 	}
 
-	m, err := url.ParseQuery(u.RawQuery)
-	if err != nil {
-		return
-	}
-	cursor = m["cursor"][0]
-
-	return
+	return *c.Ctx
 }
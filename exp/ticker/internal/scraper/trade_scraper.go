@@ -9,67 +9,34 @@ import (
 	hProtocol "github.com/stellar/go/protocols/horizon"
 )
 
-// checkRecords check if a list of records contains entries older than minTime. If it does,
-// it will return a filtered page with only the passing records and lastPage = true.
-func (c *ScraperConfig) checkRecords(trades []hProtocol.Trade, minTime time.Time) (lastPage bool, cleanTrades []hProtocol.Trade) {
-	lastPage = false
-	for _, t := range trades {
-		if t.LedgerCloseTime.After(minTime) {
-			normalizeTradeAssets(&t)
-			cleanTrades = append(cleanTrades, t)
-		} else {
-			c.Logger.Debugln("Reached entries older than the acceptable time range:", t.LedgerCloseTime)
-			lastPage = true
-			return
-		}
-	}
-	return
-}
-
 // retrieveTrades retrieves trades from the Horizon API for the last timeDelta period.
 // If limit = 0, will fetch all trades within that period.
 func (c *ScraperConfig) retrieveTrades(since time.Time, limit int) (trades []hProtocol.Trade, err error) {
 	r := horizonclient.TradeRequest{Limit: 200, Order: horizonclient.OrderDesc}
 
-	tradesPage, err := c.Client.Trades(r)
+	it, err := horizonclient.NewIterator(c.ctx(), c.Client, r, horizonclient.IteratorOptions{
+		MaxRecords: limit,
+		Prefetch:   true,
+	})
 	if err != nil {
 		return
 	}
+	defer it.Close()
+
+	for it.Next() {
+		t := it.Record().(hProtocol.Trade)
 
-	for tradesPage.Links.Next.Href != tradesPage.Links.Self.Href {
 		// Enforcing time boundaries:
-		last, cleanTrades := c.checkRecords(tradesPage.Embedded.Records, since)
-		if last {
-			trades = append(trades, cleanTrades...)
+		if !t.LedgerCloseTime.After(since) {
+			c.Logger.Debugln("Reached entries older than the acceptable time range:", t.LedgerCloseTime)
 			break
-		} else {
-			trades = append(trades, tradesPage.Embedded.Records...)
-		}
-
-		// Enforcing limit of results:
-		if limit != 0 {
-			numTrades := len(trades)
-			if numTrades >= limit {
-				diff := numTrades - limit
-				trades = trades[0 : numTrades-diff]
-				break
-			}
 		}
 
-		// Finding next page's params:
-		nextURL := tradesPage.Links.Next.Href
-		n, err := nextCursor(nextURL)
-		if err != nil {
-			return trades, err
-		}
-		c.Logger.Debugln("Cursor currently at:", n)
-		r.Cursor = n
-		tradesPage, err = c.Client.Trades(r)
-		if err != nil {
-			return trades, err
-		}
+		normalizeTradeAssets(&t)
+		trades = append(trades, t)
 	}
 
+	err = it.Err()
 	return
 }
 
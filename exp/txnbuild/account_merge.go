@@ -8,7 +8,8 @@ import (
 // AccountMerge represents the Stellar merge account operation. See
 // https://www.stellar.org/developers/guides/concepts/list-of-operations.html
 type AccountMerge struct {
-	Destination string
+	Destination   string
+	SourceAccount Account
 }
 
 // BuildXDR for AccountMerge returns a fully configured XDR Operation.
@@ -22,6 +23,37 @@ func (am *AccountMerge) BuildXDR() (xdr.Operation, error) {
 
 	opType := xdr.OperationTypeAccountMerge
 	body, err := xdr.NewOperationBody(opType, xdrOp)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	}
+
+	op := xdr.Operation{Body: body}
+	err = setOpSourceAccount(&op, am.SourceAccount)
+
+	return op, errors.Wrap(err, "Failed to set operation source account")
+}
+
+// FromXDR for AccountMerge initialises the txnbuild struct from the corresponding xdr Operation.
+func (am *AccountMerge) FromXDR(xdrOp xdr.Operation) error {
+	destination, ok := xdrOp.Body.GetDestination()
+	if !ok {
+		return errors.New("Error parsing account_merge operation from xdr")
+	}
+
+	am.SourceAccount = accountFromXDR(xdrOp.SourceAccount)
+
+	am.Destination = destination.Address()
+
+	return nil
+}
+
+// Validate for AccountMerge validates the required struct fields. It returns an error if any of the fields are
+// invalid. Otherwise, it returns nil.
+func (am *AccountMerge) Validate() error {
+	err := validateStellarPublicKey("Destination", am.Destination)
+	if err != nil {
+		return err
+	}
 
-	return xdr.Operation{Body: body}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	return validateSourceAccount("SourceAccount", am.SourceAccount)
 }
@@ -1,6 +1,8 @@
 package txnbuild
 
 import (
+	"strings"
+
 	"github.com/stellar/go/support/errors"
 	"github.com/stellar/go/xdr"
 )
@@ -8,9 +10,10 @@ import (
 // AllowTrust represents the Stellar allow trust operation. See
 // https://www.stellar.org/developers/guides/concepts/list-of-operations.html
 type AllowTrust struct {
-	Trustor   string
-	Type      *Asset
-	Authorize bool
+	Trustor       string
+	Type          *Asset
+	Authorize     bool
+	SourceAccount Account
 }
 
 // BuildXDR for AllowTrust returns a fully configured XDR Operation.
@@ -44,6 +47,58 @@ func (at *AllowTrust) BuildXDR() (xdr.Operation, error) {
 
 	opType := xdr.OperationTypeAllowTrust
 	body, err := xdr.NewOperationBody(opType, xdrOp)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	}
+
+	op := xdr.Operation{Body: body}
+	err = setOpSourceAccount(&op, at.SourceAccount)
+
+	return op, errors.Wrap(err, "Failed to set operation source account")
+}
+
+// FromXDR for AllowTrust initialises the txnbuild struct from the corresponding xdr Operation.
+// The XDR operation only carries the asset code; the issuer of an allowed asset is the
+// account the operation runs on behalf of, so Type.Issuer is left for the caller to fill in.
+func (at *AllowTrust) FromXDR(xdrOp xdr.Operation) error {
+	result, ok := xdrOp.Body.GetAllowTrustOp()
+	if !ok {
+		return errors.New("Error parsing allow_trust operation from xdr")
+	}
+
+	at.SourceAccount = accountFromXDR(xdrOp.SourceAccount)
+
+	at.Trustor = result.Trustor.Address()
+	at.Authorize = result.Authorize
+
+	var code string
+	switch result.Asset.Type {
+	case xdr.AssetTypeAssetTypeCreditAlphanum4:
+		assetCode := result.Asset.MustAssetCode4()
+		code = strings.TrimRight(string(assetCode[:]), "\x00")
+	case xdr.AssetTypeAssetTypeCreditAlphanum12:
+		assetCode := result.Asset.MustAssetCode12()
+		code = strings.TrimRight(string(assetCode[:]), "\x00")
+	default:
+		return errors.New("Error parsing asset in allow_trust operation")
+	}
+	at.Type = &Asset{Code: code}
+
+	return nil
+}
+
+// Validate for AllowTrust validates the required struct fields. It returns an error if any of the fields are
+// invalid. Otherwise, it returns nil.
+func (at *AllowTrust) Validate() error {
+	err := validateStellarPublicKey("Trustor", at.Trustor)
+	if err != nil {
+		return err
+	}
+
+	err = validateIssuedAsset("Type", at.Type)
+	if err != nil {
+		return err
+	}
 
-	return xdr.Operation{Body: body}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	return validateSourceAccount("SourceAccount", at.SourceAccount)
 }
@@ -57,3 +57,14 @@ func (a *Asset) ToXDR() (xdr.Asset, error) {
 
 	return xdrAsset, nil
 }
+
+// assetFromXDR returns the Asset corresponding to the supplied XDR asset.
+func assetFromXDR(xAsset xdr.Asset) (Asset, error) {
+	var a Asset
+	err := xAsset.Extract(new(xdr.AssetType), &a.Code, &a.Issuer)
+	if err != nil {
+		return Asset{}, errors.Wrap(err, "Failed to extract asset from XDR")
+	}
+
+	return a, nil
+}
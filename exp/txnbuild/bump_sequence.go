@@ -8,7 +8,8 @@ import (
 // BumpSequence represents the Stellar bump sequence operation. See
 // https://www.stellar.org/developers/guides/concepts/list-of-operations.html
 type BumpSequence struct {
-	BumpTo int64
+	BumpTo        int64
+	SourceAccount Account
 }
 
 // BuildXDR for BumpSequence returns a fully configured XDR Operation.
@@ -16,6 +17,36 @@ func (bs *BumpSequence) BuildXDR() (xdr.Operation, error) {
 	opType := xdr.OperationTypeBumpSequence
 	xdrOp := xdr.BumpSequenceOp{BumpTo: xdr.SequenceNumber(bs.BumpTo)}
 	body, err := xdr.NewOperationBody(opType, xdrOp)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	}
 
-	return xdr.Operation{Body: body}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	op := xdr.Operation{Body: body}
+	err = setOpSourceAccount(&op, bs.SourceAccount)
+
+	return op, errors.Wrap(err, "Failed to set operation source account")
+}
+
+// FromXDR for BumpSequence initialises the txnbuild struct from the corresponding xdr Operation.
+func (bs *BumpSequence) FromXDR(xdrOp xdr.Operation) error {
+	result, ok := xdrOp.Body.GetBumpSequenceOp()
+	if !ok {
+		return errors.New("Error parsing bump_sequence operation from xdr")
+	}
+
+	bs.SourceAccount = accountFromXDR(xdrOp.SourceAccount)
+
+	bs.BumpTo = int64(result.BumpTo)
+
+	return nil
+}
+
+// Validate for BumpSequence validates the required struct fields. It returns an error if any of the fields are
+// invalid. Otherwise, it returns nil.
+func (bs *BumpSequence) Validate() error {
+	if bs.BumpTo < 0 {
+		return NewValidationError("BumpTo", "value should be greater than or equal to 0")
+	}
+
+	return validateSourceAccount("SourceAccount", bs.SourceAccount)
 }
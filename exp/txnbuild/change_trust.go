@@ -9,8 +9,9 @@ import (
 // ChangeTrust represents the Stellar change trust operation. See
 // https://www.stellar.org/developers/guides/concepts/list-of-operations.html
 type ChangeTrust struct {
-	Line  *Asset
-	Limit string
+	Line          *Asset
+	Limit         string
+	SourceAccount Account
 }
 
 // RemoveTrustlineOp returns a ChangeTrust operation to remove the trustline of the described asset,
@@ -43,6 +44,48 @@ func (ct *ChangeTrust) BuildXDR() (xdr.Operation, error) {
 		Limit: xdrLimit,
 	}
 	body, err := xdr.NewOperationBody(opType, xdrOp)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	}
+
+	op := xdr.Operation{Body: body}
+	err = setOpSourceAccount(&op, ct.SourceAccount)
+
+	return op, errors.Wrap(err, "Failed to set operation source account")
+}
+
+// FromXDR for ChangeTrust initialises the txnbuild struct from the corresponding xdr Operation.
+func (ct *ChangeTrust) FromXDR(xdrOp xdr.Operation) error {
+	result, ok := xdrOp.Body.GetChangeTrustOp()
+	if !ok {
+		return errors.New("Error parsing change_trust operation from xdr")
+	}
+
+	ct.SourceAccount = accountFromXDR(xdrOp.SourceAccount)
+
+	ct.Limit = amount.String(result.Limit)
+
+	line, err := assetFromXDR(result.Line)
+	if err != nil {
+		return errors.Wrap(err, "Error parsing asset in change_trust operation")
+	}
+	ct.Line = &line
+
+	return nil
+}
+
+// Validate for ChangeTrust validates the required struct fields. It returns an error if any of the fields are
+// invalid. Otherwise, it returns nil. A Limit of zero is accepted, as it removes the trustline.
+func (ct *ChangeTrust) Validate() error {
+	err := validateIssuedAsset("Line", ct.Line)
+	if err != nil {
+		return err
+	}
+
+	err = validateAmount("Limit", ct.Limit, true)
+	if err != nil {
+		return err
+	}
 
-	return xdr.Operation{Body: body}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	return validateSourceAccount("SourceAccount", ct.SourceAccount)
 }
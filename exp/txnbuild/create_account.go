@@ -9,9 +9,10 @@ import (
 // CreateAccount represents the Stellar create account operation. See
 // https://www.stellar.org/developers/guides/concepts/list-of-operations.html
 type CreateAccount struct {
-	Destination string
-	Amount      string
-	Asset       string // TODO: Not used yet
+	Destination   string
+	Amount        string
+	Asset         string // TODO: Not used yet
+	SourceAccount Account
 }
 
 // BuildXDR for CreateAccount returns a fully configured XDR Operation.
@@ -30,6 +31,43 @@ func (ca *CreateAccount) BuildXDR() (xdr.Operation, error) {
 
 	opType := xdr.OperationTypeCreateAccount
 	body, err := xdr.NewOperationBody(opType, xdrOp)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	}
+
+	op := xdr.Operation{Body: body}
+	err = setOpSourceAccount(&op, ca.SourceAccount)
+
+	return op, errors.Wrap(err, "Failed to set operation source account")
+}
+
+// FromXDR for CreateAccount initialises the txnbuild struct from the corresponding xdr Operation.
+func (ca *CreateAccount) FromXDR(xdrOp xdr.Operation) error {
+	result, ok := xdrOp.Body.GetCreateAccountOp()
+	if !ok {
+		return errors.New("Error parsing create_account operation from xdr")
+	}
+
+	ca.SourceAccount = accountFromXDR(xdrOp.SourceAccount)
+
+	ca.Destination = result.Destination.Address()
+	ca.Amount = amount.String(result.StartingBalance)
+
+	return nil
+}
+
+// Validate for CreateAccount validates the required struct fields. It returns an error if any of the fields are
+// invalid. Otherwise, it returns nil.
+func (ca *CreateAccount) Validate() error {
+	err := validateStellarPublicKey("Destination", ca.Destination)
+	if err != nil {
+		return err
+	}
+
+	err = validateAmount("Amount", ca.Amount, false)
+	if err != nil {
+		return err
+	}
 
-	return xdr.Operation{Body: body}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	return validateSourceAccount("SourceAccount", ca.SourceAccount)
 }
@@ -10,10 +10,11 @@ import (
 // CreatePassiveOffer represents the Stellar create passive offer operation. See
 // https://www.stellar.org/developers/guides/concepts/list-of-operations.html
 type CreatePassiveOffer struct {
-	Selling *Asset
-	Buying  *Asset
-	Amount  string
-	Price   string // TODO: Extend to include number, and n/d fraction. See package 'amount'
+	Selling       *Asset
+	Buying        *Asset
+	Amount        string
+	Price         string // TODO: Extend to include number, and n/d fraction. See package 'amount'
+	SourceAccount Account
 }
 
 // BuildXDR for CreatePassiveOffer returns a fully configured XDR Operation.
@@ -47,6 +48,50 @@ func (cpo *CreatePassiveOffer) BuildXDR() (xdr.Operation, error) {
 
 	opType := xdr.OperationTypeCreatePassiveOffer
 	body, err := xdr.NewOperationBody(opType, xdrOp)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	}
+
+	op := xdr.Operation{Body: body}
+	err = setOpSourceAccount(&op, cpo.SourceAccount)
+
+	return op, errors.Wrap(err, "Failed to set operation source account")
+}
+
+// FromXDR for CreatePassiveOffer initialises the txnbuild struct from the corresponding xdr Operation.
+func (cpo *CreatePassiveOffer) FromXDR(xdrOp xdr.Operation) error {
+	result, ok := xdrOp.Body.GetCreatePassiveOfferOp()
+	if !ok {
+		return errors.New("Error parsing create_passive_offer operation from xdr")
+	}
+
+	cpo.SourceAccount = accountFromXDR(xdrOp.SourceAccount)
+
+	cpo.Amount = amount.String(result.Amount)
+	cpo.Price = result.Price.String()
+
+	buyingAsset, err := assetFromXDR(result.Buying)
+	if err != nil {
+		return errors.Wrap(err, "Error parsing buying_asset in create_passive_offer operation")
+	}
+	cpo.Buying = &buyingAsset
+
+	sellingAsset, err := assetFromXDR(result.Selling)
+	if err != nil {
+		return errors.Wrap(err, "Error parsing selling_asset in create_passive_offer operation")
+	}
+	cpo.Selling = &sellingAsset
+
+	return nil
+}
+
+// Validate for CreatePassiveOffer validates the required struct fields. It returns an error if any of the fields are
+// invalid. Otherwise, it returns nil.
+func (cpo *CreatePassiveOffer) Validate() error {
+	err := validateOffer(cpo.Selling, cpo.Buying, cpo.Amount, cpo.Price, false)
+	if err != nil {
+		return err
+	}
 
-	return xdr.Operation{Body: body}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	return validateSourceAccount("SourceAccount", cpo.SourceAccount)
 }
@@ -0,0 +1,128 @@
+package txnbuild
+
+import (
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/errors"
+)
+
+// DefaultBaseFee is the minimum fee per operation, in stroops, accepted by the network when it
+// isn't congested.
+const DefaultBaseFee uint64 = 100
+
+// FeeStatsProvider is implemented by clients that report the fee statistics of recent ledgers,
+// such as horizonclient.Client.
+type FeeStatsProvider interface {
+	FeeStats() (hProtocol.FeeStats, error)
+}
+
+// FeePercentile selects which of the accepted fees reported by Horizon's /fee_stats endpoint
+// a FeeStrategy bids.
+type FeePercentile int
+
+const (
+	// FeeMin bids the minimum fee accepted in the last ledger.
+	FeeMin FeePercentile = iota
+	// FeeMode bids the most common fee accepted in the last ledger.
+	FeeMode
+	// FeeP10 bids the 10th percentile of the fees accepted in the last ledger.
+	FeeP10
+	// FeeP20 bids the 20th percentile of the fees accepted in the last ledger.
+	FeeP20
+	// FeeP30 bids the 30th percentile of the fees accepted in the last ledger.
+	FeeP30
+	// FeeP40 bids the 40th percentile of the fees accepted in the last ledger.
+	FeeP40
+	// FeeP50 bids the 50th percentile of the fees accepted in the last ledger.
+	FeeP50
+	// FeeP60 bids the 60th percentile of the fees accepted in the last ledger.
+	FeeP60
+	// FeeP70 bids the 70th percentile of the fees accepted in the last ledger.
+	FeeP70
+	// FeeP80 bids the 80th percentile of the fees accepted in the last ledger.
+	FeeP80
+	// FeeP90 bids the 90th percentile of the fees accepted in the last ledger.
+	FeeP90
+	// FeeP95 bids the 95th percentile of the fees accepted in the last ledger.
+	FeeP95
+	// FeeP99 bids the 99th percentile of the fees accepted in the last ledger.
+	FeeP99
+)
+
+// FeeStrategy computes a per-operation base fee from the fees recently accepted by the network,
+// so that transactions keep being accepted during surge pricing.
+//
+// The fee bid is the configured Percentile of the fees accepted in the last ledger. It is never
+// lower than the last ledger's base fee, which is also used when Horizon reports no accepted fee
+// for the percentile. If MaxFee is set, the bid is capped at MaxFee stroops per operation.
+type FeeStrategy struct {
+	Client     FeeStatsProvider
+	Percentile FeePercentile
+	MaxFee     uint64
+}
+
+// BaseFee for FeeStrategy queries the fee statistics and returns the fee to bid per operation,
+// in stroops.
+func (fs FeeStrategy) BaseFee() (uint64, error) {
+	if fs.Client == nil {
+		return 0, errors.New("FeeStrategy has no client to query fee stats from")
+	}
+
+	feeStats, err := fs.Client.FeeStats()
+	if err != nil {
+		return 0, errors.Wrap(err, "Failed to query fee stats")
+	}
+
+	fee, err := percentileFee(feeStats, fs.Percentile)
+	if err != nil {
+		return 0, err
+	}
+
+	// Fall back to the last ledger's base fee, which is the least the network accepts
+	if fee < feeStats.LastLedgerBaseFee {
+		fee = feeStats.LastLedgerBaseFee
+	}
+	if fee <= 0 {
+		fee = int(DefaultBaseFee)
+	}
+
+	baseFee := uint64(fee)
+	if fs.MaxFee > 0 && baseFee > fs.MaxFee {
+		baseFee = fs.MaxFee
+	}
+
+	return baseFee, nil
+}
+
+// percentileFee returns the accepted fee reported for the given percentile.
+func percentileFee(feeStats hProtocol.FeeStats, percentile FeePercentile) (int, error) {
+	switch percentile {
+	case FeeMin:
+		return feeStats.MinAcceptedFee, nil
+	case FeeMode:
+		return feeStats.ModeAcceptedFee, nil
+	case FeeP10:
+		return feeStats.P10AcceptedFee, nil
+	case FeeP20:
+		return feeStats.P20AcceptedFee, nil
+	case FeeP30:
+		return feeStats.P30AcceptedFee, nil
+	case FeeP40:
+		return feeStats.P40AcceptedFee, nil
+	case FeeP50:
+		return feeStats.P50AcceptedFee, nil
+	case FeeP60:
+		return feeStats.P60AcceptedFee, nil
+	case FeeP70:
+		return feeStats.P70AcceptedFee, nil
+	case FeeP80:
+		return feeStats.P80AcceptedFee, nil
+	case FeeP90:
+		return feeStats.P90AcceptedFee, nil
+	case FeeP95:
+		return feeStats.P95AcceptedFee, nil
+	case FeeP99:
+		return feeStats.P99AcceptedFee, nil
+	}
+
+	return 0, errors.Errorf("Unknown fee percentile: %d", percentile)
+}
@@ -0,0 +1,94 @@
+package txnbuild
+
+import (
+	"testing"
+
+	"github.com/stellar/go/network"
+	hProtocol "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/support/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeFeeStats struct {
+	feeStats hProtocol.FeeStats
+	err      error
+}
+
+func (f fakeFeeStats) FeeStats() (hProtocol.FeeStats, error) {
+	return f.feeStats, f.err
+}
+
+var surgeFeeStats = hProtocol.FeeStats{
+	LastLedgerBaseFee: 100,
+	MinAcceptedFee:    100,
+	ModeAcceptedFee:   250,
+	P50AcceptedFee:    300,
+	P70AcceptedFee:    1000,
+	P95AcceptedFee:    5000,
+}
+
+func TestFeeStrategyPercentiles(t *testing.T) {
+	client := fakeFeeStats{feeStats: surgeFeeStats}
+
+	fee, err := FeeStrategy{Client: client, Percentile: FeeP70}.BaseFee()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1000), fee)
+
+	fee, err = FeeStrategy{Client: client, Percentile: FeeMode}.BaseFee()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(250), fee)
+}
+
+func TestFeeStrategyMaxFee(t *testing.T) {
+	client := fakeFeeStats{feeStats: surgeFeeStats}
+
+	fee, err := FeeStrategy{Client: client, Percentile: FeeP95, MaxFee: 2000}.BaseFee()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2000), fee, "fee should be capped")
+}
+
+func TestFeeStrategyFallsBackToLastBaseFee(t *testing.T) {
+	client := fakeFeeStats{feeStats: hProtocol.FeeStats{LastLedgerBaseFee: 200}}
+
+	fee, err := FeeStrategy{Client: client, Percentile: FeeP99}.BaseFee()
+	require.NoError(t, err)
+	assert.Equal(t, uint64(200), fee, "an empty percentile should use the last base fee")
+
+	client = fakeFeeStats{feeStats: hProtocol.FeeStats{}}
+	fee, err = FeeStrategy{Client: client, Percentile: FeeP99}.BaseFee()
+	require.NoError(t, err)
+	assert.Equal(t, DefaultBaseFee, fee, "empty fee stats should use the default base fee")
+}
+
+func TestFeeStrategyErrors(t *testing.T) {
+	_, err := FeeStrategy{Percentile: FeeP70}.BaseFee()
+	assert.EqualError(t, err, "FeeStrategy has no client to query fee stats from")
+
+	client := fakeFeeStats{err: errors.New("horizon is down")}
+	_, err = FeeStrategy{Client: client, Percentile: FeeP70}.BaseFee()
+	assert.EqualError(t, err, "Failed to query fee stats: horizon is down")
+
+	client = fakeFeeStats{feeStats: surgeFeeStats}
+	_, err = FeeStrategy{Client: client, Percentile: FeePercentile(42)}.BaseFee()
+	assert.EqualError(t, err, "Unknown fee percentile: 42")
+}
+
+func TestTransactionFeeFromStrategy(t *testing.T) {
+	kp0 := newKeypair0()
+	sourceAccount := makeTestAccount(kp0, "9605939170639897")
+
+	strategy := FeeStrategy{Client: fakeFeeStats{feeStats: surgeFeeStats}, Percentile: FeeP70}
+	baseFee, err := strategy.BaseFee()
+	require.NoError(t, err)
+
+	tx := Transaction{
+		SourceAccount: &sourceAccount,
+		Operations:    []Operation{&Inflation{}, &BumpSequence{BumpTo: 9605939170639999}},
+		BaseFee:       baseFee,
+		Network:       network.TestNetworkPassphrase,
+	}
+	err = tx.Build()
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2000), uint32(tx.xdrTransaction.Fee), "fee should be the base fee times the number of operations")
+}
@@ -7,12 +7,37 @@ import (
 
 // Inflation represents the Stellar inflation operation. See
 // https://www.stellar.org/developers/guides/concepts/list-of-operations.html
-type Inflation struct{}
+type Inflation struct {
+	SourceAccount Account
+}
 
 // BuildXDR for Inflation returns a fully configured XDR Operation.
 func (inf *Inflation) BuildXDR() (xdr.Operation, error) {
 	opType := xdr.OperationTypeInflation
 	body, err := xdr.NewOperationBody(opType, nil)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	}
+
+	op := xdr.Operation{Body: body}
+	err = setOpSourceAccount(&op, inf.SourceAccount)
+
+	return op, errors.Wrap(err, "Failed to set operation source account")
+}
+
+// FromXDR for Inflation initialises the txnbuild struct from the corresponding xdr Operation.
+func (inf *Inflation) FromXDR(xdrOp xdr.Operation) error {
+	if xdrOp.Body.Type != xdr.OperationTypeInflation {
+		return errors.New("Error parsing inflation operation from xdr")
+	}
+
+	inf.SourceAccount = accountFromXDR(xdrOp.SourceAccount)
+
+	return nil
+}
 
-	return xdr.Operation{Body: body}, errors.Wrap(err, "Failed to build XDR OperationBody")
+// Validate for Inflation is just a method that implements the Operation interface. No logic is needed for
+// Inflation beyond checking its optional source account.
+func (inf *Inflation) Validate() error {
+	return validateSourceAccount("SourceAccount", inf.SourceAccount)
 }
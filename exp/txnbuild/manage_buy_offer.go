@@ -0,0 +1,100 @@
+package txnbuild
+
+import (
+	"github.com/stellar/go/amount"
+	"github.com/stellar/go/price"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// ManageBuyOffer represents the Stellar manage buy offer operation, added in protocol 11. Amount is
+// the amount of the Buying asset to buy, and Price is the price of 1 unit of Buying in terms of
+// Selling. See https://www.stellar.org/developers/guides/concepts/list-of-operations.html
+type ManageBuyOffer struct {
+	Selling       *Asset
+	Buying        *Asset
+	Amount        string
+	Price         string
+	OfferID       uint64
+	SourceAccount Account
+}
+
+// BuildXDR for ManageBuyOffer returns a fully configured XDR Operation.
+func (mo *ManageBuyOffer) BuildXDR() (xdr.Operation, error) {
+	xdrSelling, err := mo.Selling.ToXDR()
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "Failed to set XDR 'Selling' field")
+	}
+
+	xdrBuying, err := mo.Buying.ToXDR()
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "Failed to set XDR 'Buying' field")
+	}
+
+	xdrAmount, err := amount.Parse(mo.Amount)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "Failed to parse 'Amount'")
+	}
+
+	xdrPrice, err := price.Parse(mo.Price)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "Failed to parse 'Price'")
+	}
+
+	opType := xdr.OperationTypeManageBuyOffer
+	xdrOp := xdr.ManageBuyOfferOp{
+		Selling:   xdrSelling,
+		Buying:    xdrBuying,
+		BuyAmount: xdrAmount,
+		Price:     xdrPrice,
+		OfferId:   xdr.Uint64(mo.OfferID),
+	}
+	body, err := xdr.NewOperationBody(opType, xdrOp)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	}
+
+	op := xdr.Operation{Body: body}
+	err = setOpSourceAccount(&op, mo.SourceAccount)
+
+	return op, errors.Wrap(err, "Failed to set operation source account")
+}
+
+// FromXDR for ManageBuyOffer initialises the txnbuild struct from the corresponding xdr Operation.
+func (mo *ManageBuyOffer) FromXDR(xdrOp xdr.Operation) error {
+	result, ok := xdrOp.Body.GetManageBuyOfferOp()
+	if !ok {
+		return errors.New("Error parsing manage_buy_offer operation from xdr")
+	}
+
+	mo.SourceAccount = accountFromXDR(xdrOp.SourceAccount)
+
+	mo.OfferID = uint64(result.OfferId)
+	mo.Amount = amount.String(result.BuyAmount)
+	mo.Price = result.Price.String()
+
+	buyingAsset, err := assetFromXDR(result.Buying)
+	if err != nil {
+		return errors.Wrap(err, "Error parsing buying_asset in manage_buy_offer operation")
+	}
+	mo.Buying = &buyingAsset
+
+	sellingAsset, err := assetFromXDR(result.Selling)
+	if err != nil {
+		return errors.Wrap(err, "Error parsing selling_asset in manage_buy_offer operation")
+	}
+	mo.Selling = &sellingAsset
+
+	return nil
+}
+
+// Validate for ManageBuyOffer validates the required struct fields. It returns an error if any of the fields are
+// invalid. Otherwise, it returns nil. An Amount of zero is accepted, as it deletes the offer.
+func (mo *ManageBuyOffer) Validate() error {
+	err := validateOffer(mo.Selling, mo.Buying, mo.Amount, mo.Price, true)
+	if err != nil {
+		return err
+	}
+
+	return validateSourceAccount("SourceAccount", mo.SourceAccount)
+}
@@ -1,6 +1,8 @@
 package txnbuild
 
 import (
+	"fmt"
+
 	"github.com/stellar/go/support/errors"
 	"github.com/stellar/go/xdr"
 )
@@ -8,8 +10,9 @@ import (
 // ManageData represents the Stellar manage data operation. See
 // https://www.stellar.org/developers/guides/concepts/list-of-operations.html
 type ManageData struct {
-	Name  string
-	Value []byte
+	Name          string
+	Value         []byte
+	SourceAccount Account
 }
 
 // BuildXDR for ManageData returns a fully configured XDR Operation.
@@ -26,6 +29,45 @@ func (md *ManageData) BuildXDR() (xdr.Operation, error) {
 
 	opType := xdr.OperationTypeManageData
 	body, err := xdr.NewOperationBody(opType, xdrOp)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	}
+
+	op := xdr.Operation{Body: body}
+	err = setOpSourceAccount(&op, md.SourceAccount)
+
+	return op, errors.Wrap(err, "Failed to set operation source account")
+}
+
+// FromXDR for ManageData initialises the txnbuild struct from the corresponding xdr Operation.
+func (md *ManageData) FromXDR(xdrOp xdr.Operation) error {
+	result, ok := xdrOp.Body.GetManageDataOp()
+	if !ok {
+		return errors.New("Error parsing manage_data operation from xdr")
+	}
+
+	md.SourceAccount = accountFromXDR(xdrOp.SourceAccount)
+
+	md.Name = string(result.DataName)
+	if result.DataValue != nil {
+		md.Value = *result.DataValue
+	} else {
+		md.Value = nil
+	}
+
+	return nil
+}
+
+// Validate for ManageData validates the required struct fields. It returns an error if any of the fields are
+// invalid. Otherwise, it returns nil.
+func (md *ManageData) Validate() error {
+	if len(md.Name) < 1 || len(md.Name) > maxDataNameLength {
+		return NewValidationError("Name", fmt.Sprintf("maximum length is %d characters", maxDataNameLength))
+	}
+
+	if len(md.Value) > maxDataValueLength {
+		return NewValidationError("Value", fmt.Sprintf("maximum length is %d bytes", maxDataValueLength))
+	}
 
-	return xdr.Operation{Body: body}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	return validateSourceAccount("SourceAccount", md.SourceAccount)
 }
@@ -49,11 +49,12 @@ func DeleteOfferOp(offerID uint64) ManageOffer {
 // ManageOffer represents the Stellar manage offer operation. See
 // https://www.stellar.org/developers/guides/concepts/list-of-operations.html
 type ManageOffer struct {
-	Selling *Asset
-	Buying  *Asset
-	Amount  string
-	Price   string // TODO: Extend to include number, and n/d fraction. See package 'amount'
-	OfferID uint64
+	Selling       *Asset
+	Buying        *Asset
+	Amount        string
+	Price         string // TODO: Extend to include number, and n/d fraction. See package 'amount'
+	OfferID       uint64
+	SourceAccount Account
 }
 
 // BuildXDR for ManageOffer returns a fully configured XDR Operation.
@@ -87,6 +88,51 @@ func (mo *ManageOffer) BuildXDR() (xdr.Operation, error) {
 		OfferId: xdr.Uint64(mo.OfferID),
 	}
 	body, err := xdr.NewOperationBody(opType, xdrOp)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	}
+
+	op := xdr.Operation{Body: body}
+	err = setOpSourceAccount(&op, mo.SourceAccount)
+
+	return op, errors.Wrap(err, "Failed to set operation source account")
+}
+
+// FromXDR for ManageOffer initialises the txnbuild struct from the corresponding xdr Operation.
+func (mo *ManageOffer) FromXDR(xdrOp xdr.Operation) error {
+	result, ok := xdrOp.Body.GetManageOfferOp()
+	if !ok {
+		return errors.New("Error parsing manage_offer operation from xdr")
+	}
+
+	mo.SourceAccount = accountFromXDR(xdrOp.SourceAccount)
+
+	mo.OfferID = uint64(result.OfferId)
+	mo.Amount = amount.String(result.Amount)
+	mo.Price = result.Price.String()
+
+	buyingAsset, err := assetFromXDR(result.Buying)
+	if err != nil {
+		return errors.Wrap(err, "Error parsing buying_asset in manage_offer operation")
+	}
+	mo.Buying = &buyingAsset
+
+	sellingAsset, err := assetFromXDR(result.Selling)
+	if err != nil {
+		return errors.Wrap(err, "Error parsing selling_asset in manage_offer operation")
+	}
+	mo.Selling = &sellingAsset
+
+	return nil
+}
+
+// Validate for ManageOffer validates the required struct fields. It returns an error if any of the fields are
+// invalid. Otherwise, it returns nil. An Amount of zero is accepted, as it deletes the offer.
+func (mo *ManageOffer) Validate() error {
+	err := validateOffer(mo.Selling, mo.Buying, mo.Amount, mo.Price, true)
+	if err != nil {
+		return err
+	}
 
-	return xdr.Operation{Body: body}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	return validateSourceAccount("SourceAccount", mo.SourceAccount)
 }
@@ -0,0 +1,68 @@
+package txnbuild
+
+import (
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// MemoText is used to send human messages of up to 28 bytes of ASCII/UTF-8.
+type MemoText string
+
+// MemoID is an identifier representing the transaction originator.
+type MemoID uint64
+
+// MemoHash is a hash representing a reference to another transaction.
+type MemoHash [32]byte
+
+// MemoReturn is a hash representing the hash of the transaction the sender is refunding.
+type MemoReturn [32]byte
+
+// MemoTextMaxLength is the maximum number of bytes allowed for a text memo.
+const MemoTextMaxLength = 28
+
+// Memo represents the superset of all memo types.
+type Memo interface {
+	ToXDR() (xdr.Memo, error)
+}
+
+// ToXDR for MemoText returns an XDR object representation of a Memo of the same type.
+func (mt MemoText) ToXDR() (xdr.Memo, error) {
+	if len(mt) > MemoTextMaxLength {
+		return xdr.Memo{}, errors.New("Memo text can't be longer than 28 bytes")
+	}
+
+	return xdr.NewMemo(xdr.MemoTypeMemoText, string(mt))
+}
+
+// ToXDR for MemoID returns an XDR object representation of a Memo of the same type.
+func (mid MemoID) ToXDR() (xdr.Memo, error) {
+	return xdr.NewMemo(xdr.MemoTypeMemoId, xdr.Uint64(mid))
+}
+
+// ToXDR for MemoHash returns an XDR object representation of a Memo of the same type.
+func (mh MemoHash) ToXDR() (xdr.Memo, error) {
+	return xdr.NewMemo(xdr.MemoTypeMemoHash, xdr.Hash(mh))
+}
+
+// ToXDR for MemoReturn returns an XDR object representation of a Memo of the same type.
+func (mr MemoReturn) ToXDR() (xdr.Memo, error) {
+	return xdr.NewMemo(xdr.MemoTypeMemoReturn, xdr.Hash(mr))
+}
+
+// memoFromXDR returns a Memo from XDR, or nil if the XDR memo is of type MemoNone.
+func memoFromXDR(memo xdr.Memo) (Memo, error) {
+	switch memo.Type {
+	case xdr.MemoTypeMemoNone:
+		return nil, nil
+	case xdr.MemoTypeMemoText:
+		return MemoText(memo.MustText()), nil
+	case xdr.MemoTypeMemoId:
+		return MemoID(memo.MustId()), nil
+	case xdr.MemoTypeMemoHash:
+		return MemoHash(memo.MustHash()), nil
+	case xdr.MemoTypeMemoReturn:
+		return MemoReturn(memo.MustRetHash()), nil
+	}
+
+	return nil, errors.New("Unknown memo type")
+}
@@ -1,10 +1,79 @@
 package txnbuild
 
 import (
+	"fmt"
+
 	"github.com/stellar/go/xdr"
 )
 
 // Operation represents the operation types of the Stellar network.
 type Operation interface {
 	BuildXDR() (xdr.Operation, error)
+	FromXDR(xdrOp xdr.Operation) error
+	Validate() error
+}
+
+// operationFromXDR returns an Operation of the type matching the supplied XDR operation,
+// populated from its contents.
+func operationFromXDR(xdrOp xdr.Operation) (Operation, error) {
+	var newOp Operation
+	switch xdrOp.Body.Type {
+	case xdr.OperationTypeCreateAccount:
+		newOp = &CreateAccount{}
+	case xdr.OperationTypePayment:
+		newOp = &Payment{}
+	case xdr.OperationTypePathPayment:
+		newOp = &PathPayment{}
+	case xdr.OperationTypeManageOffer:
+		newOp = &ManageOffer{}
+	case xdr.OperationTypeCreatePassiveOffer:
+		newOp = &CreatePassiveOffer{}
+	case xdr.OperationTypeSetOptions:
+		newOp = &SetOptions{}
+	case xdr.OperationTypeChangeTrust:
+		newOp = &ChangeTrust{}
+	case xdr.OperationTypeAllowTrust:
+		newOp = &AllowTrust{}
+	case xdr.OperationTypeAccountMerge:
+		newOp = &AccountMerge{}
+	case xdr.OperationTypeInflation:
+		newOp = &Inflation{}
+	case xdr.OperationTypeManageData:
+		newOp = &ManageData{}
+	case xdr.OperationTypeBumpSequence:
+		newOp = &BumpSequence{}
+	case xdr.OperationTypeManageBuyOffer:
+		newOp = &ManageBuyOffer{}
+	default:
+		return nil, fmt.Errorf("Unknown operation type: %d", xdrOp.Body.Type)
+	}
+
+	err := newOp.FromXDR(xdrOp)
+	return newOp, err
+}
+
+// setOpSourceAccount sets the source account ID on an XDR Operation, if one has been provided.
+func setOpSourceAccount(xdrOp *xdr.Operation, sourceAccount Account) error {
+	if sourceAccount == nil {
+		return nil
+	}
+
+	var opSourceAccountID xdr.AccountId
+	err := opSourceAccountID.SetAddress(sourceAccount.GetAccountID())
+	if err != nil {
+		return err
+	}
+	xdrOp.SourceAccount = &opSourceAccountID
+
+	return nil
+}
+
+// accountFromXDR returns an Account representing the supplied XDR operation source account,
+// or nil if the operation has no source account of its own.
+func accountFromXDR(accountID *xdr.AccountId) Account {
+	if accountID == nil {
+		return nil
+	}
+
+	return &SimpleAccount{AccountID: accountID.Address()}
 }
@@ -0,0 +1,112 @@
+package txnbuild
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationsRoundTripXDR(t *testing.T) {
+	kp0 := newKeypair0()
+	kp1 := newKeypair1()
+	abcd := NewAsset("ABCD", kp0.Address())
+
+	testCases := []struct {
+		name string
+		op   Operation
+		dest Operation
+	}{
+		{"CreateAccount", &CreateAccount{Destination: kp1.Address(), Amount: "10.0000000"}, &CreateAccount{}},
+		{"Payment", &Payment{Destination: kp1.Address(), Amount: "10.0000000", Asset: abcd}, &Payment{}},
+		{"PathPayment", &PathPayment{
+			SendAsset:   NewNativeAsset(),
+			SendMax:     "10.0000000",
+			Destination: kp1.Address(),
+			DestAsset:   NewNativeAsset(),
+			DestAmount:  "1.0000000",
+			Path:        []Asset{*abcd},
+		}, &PathPayment{}},
+		{"ManageOffer", &ManageOffer{
+			Selling: NewNativeAsset(),
+			Buying:  abcd,
+			Amount:  "100.0000000",
+			Price:   "0.0100000",
+			OfferID: 2497628,
+		}, &ManageOffer{}},
+		{"ManageBuyOffer", &ManageBuyOffer{
+			Selling:       NewNativeAsset(),
+			Buying:        abcd,
+			Amount:        "100.0000000",
+			Price:         "0.0100000",
+			OfferID:       2497628,
+			SourceAccount: &SimpleAccount{AccountID: kp1.Address()},
+		}, &ManageBuyOffer{}},
+		{"CreatePassiveOffer", &CreatePassiveOffer{
+			Selling: NewNativeAsset(),
+			Buying:  abcd,
+			Amount:  "10.0000000",
+			Price:   "1.0000000",
+		}, &CreatePassiveOffer{}},
+		{"SetOptions", &SetOptions{
+			InflationDestination: NewInflationDestination(kp1.Address()),
+			SetFlags:             []AccountFlag{AuthRequired, AuthRevocable},
+			ClearFlags:           []AccountFlag{AuthImmutable},
+			MasterWeight:         NewThreshold(10),
+			LowThreshold:         NewThreshold(1),
+			MediumThreshold:      NewThreshold(2),
+			HighThreshold:        NewThreshold(3),
+			HomeDomain:           NewHomeDomain("stellar.org"),
+			Signer:               &Signer{Address: kp1.Address(), Weight: 4},
+		}, &SetOptions{}},
+		{"ChangeTrust", &ChangeTrust{Line: abcd, Limit: "922337203685.4775807"}, &ChangeTrust{}},
+		{"AccountMerge", &AccountMerge{Destination: kp1.Address()}, &AccountMerge{}},
+		{"Inflation", &Inflation{}, &Inflation{}},
+		{"ManageData", &ManageData{Name: "Fruit preference", Value: []byte("Apple")}, &ManageData{}},
+		{"BumpSequence", &BumpSequence{BumpTo: 9606132444168300}, &BumpSequence{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			xdrOp, err := tc.op.BuildXDR()
+			require.NoError(t, err)
+
+			err = tc.dest.FromXDR(xdrOp)
+			require.NoError(t, err)
+
+			// SetOptions keeps its built XDR around, so compare the re-built operations instead
+			roundTripped, err := tc.dest.BuildXDR()
+			require.NoError(t, err)
+			assert.Equal(t, xdrOp, roundTripped, "operation should round-trip through XDR")
+		})
+	}
+}
+
+func TestOperationFromXDRWrongType(t *testing.T) {
+	payment := Payment{Destination: newKeypair1().Address(), Amount: "10", Asset: NewNativeAsset()}
+	xdrOp, err := payment.BuildXDR()
+	require.NoError(t, err)
+
+	var createAccount CreateAccount
+	err = createAccount.FromXDR(xdrOp)
+	assert.EqualError(t, err, "Error parsing create_account operation from xdr")
+}
+
+func TestAllowTrustFromXDR(t *testing.T) {
+	kp0 := newKeypair0()
+	allowTrust := AllowTrust{
+		Trustor:   newKeypair1().Address(),
+		Type:      NewAsset("ABCD", kp0.Address()),
+		Authorize: true,
+	}
+	xdrOp, err := allowTrust.BuildXDR()
+	require.NoError(t, err)
+
+	var parsed AllowTrust
+	err = parsed.FromXDR(xdrOp)
+	require.NoError(t, err)
+	assert.Equal(t, allowTrust.Trustor, parsed.Trustor)
+	assert.Equal(t, "ABCD", parsed.Type.Code)
+	assert.Equal(t, "", parsed.Type.Issuer, "the issuer isn't carried by the operation")
+	assert.True(t, parsed.Authorize)
+}
@@ -1,6 +1,8 @@
 package txnbuild
 
 import (
+	"fmt"
+
 	"github.com/stellar/go/amount"
 	"github.com/stellar/go/support/errors"
 	"github.com/stellar/go/xdr"
@@ -9,12 +11,13 @@ import (
 // PathPayment represents the Stellar path payment operation. See
 // https://www.stellar.org/developers/guides/concepts/list-of-operations.html
 type PathPayment struct {
-	SendAsset   *Asset
-	SendMax     string
-	Destination string
-	DestAsset   *Asset
-	DestAmount  string
-	Path        []Asset
+	SendAsset     *Asset
+	SendMax       string
+	Destination   string
+	DestAsset     *Asset
+	DestAmount    string
+	Path          []Asset
+	SourceAccount Account
 }
 
 // BuildXDR for Payment returns a fully configured XDR Operation.
@@ -77,6 +80,91 @@ func (pp *PathPayment) BuildXDR() (xdr.Operation, error) {
 		Path:        xdrPath,
 	}
 	body, err := xdr.NewOperationBody(opType, xdrOp)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	}
+
+	op := xdr.Operation{Body: body}
+	err = setOpSourceAccount(&op, pp.SourceAccount)
+
+	return op, errors.Wrap(err, "Failed to set operation source account")
+}
+
+// FromXDR for PathPayment initialises the txnbuild struct from the corresponding xdr Operation.
+func (pp *PathPayment) FromXDR(xdrOp xdr.Operation) error {
+	result, ok := xdrOp.Body.GetPathPaymentOp()
+	if !ok {
+		return errors.New("Error parsing path_payment operation from xdr")
+	}
+
+	pp.SourceAccount = accountFromXDR(xdrOp.SourceAccount)
+
+	sendAsset, err := assetFromXDR(result.SendAsset)
+	if err != nil {
+		return errors.Wrap(err, "Error parsing sendAsset in path_payment operation")
+	}
+	pp.SendAsset = &sendAsset
+	pp.SendMax = amount.String(result.SendMax)
+
+	pp.Destination = result.Destination.Address()
+
+	destAsset, err := assetFromXDR(result.DestAsset)
+	if err != nil {
+		return errors.Wrap(err, "Error parsing destAsset in path_payment operation")
+	}
+	pp.DestAsset = &destAsset
+	pp.DestAmount = amount.String(result.DestAmount)
+
+	pp.Path = []Asset{}
+	for _, p := range result.Path {
+		pathAsset, err := assetFromXDR(p)
+		if err != nil {
+			return errors.Wrap(err, "Error parsing paths in path_payment operation")
+		}
+		pp.Path = append(pp.Path, pathAsset)
+	}
+
+	return nil
+}
+
+// Validate for PathPayment validates the required struct fields. It returns an error if any of the fields are
+// invalid. Otherwise, it returns nil.
+func (pp *PathPayment) Validate() error {
+	err := validateStellarPublicKey("Destination", pp.Destination)
+	if err != nil {
+		return err
+	}
+
+	err = validateStellarAsset("SendAsset", pp.SendAsset)
+	if err != nil {
+		return err
+	}
+
+	err = validateAmount("SendMax", pp.SendMax, false)
+	if err != nil {
+		return err
+	}
+
+	err = validateStellarAsset("DestAsset", pp.DestAsset)
+	if err != nil {
+		return err
+	}
+
+	err = validateAmount("DestAmount", pp.DestAmount, false)
+	if err != nil {
+		return err
+	}
+
+	if len(pp.Path) > maxPathLength {
+		return NewValidationError("Path", fmt.Sprintf("path can not contain more than %d assets", maxPathLength))
+	}
+
+	for i := range pp.Path {
+		err = validateStellarAsset(fmt.Sprintf("Path[%d]", i), &pp.Path[i])
+		if err != nil {
+			return err
+		}
+	}
 
-	return xdr.Operation{Body: body}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	return validateSourceAccount("SourceAccount", pp.SourceAccount)
 }
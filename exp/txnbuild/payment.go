@@ -9,9 +9,10 @@ import (
 // Payment represents the Stellar payment operation. See
 // https://www.stellar.org/developers/guides/concepts/list-of-operations.html
 type Payment struct {
-	Destination string
-	Amount      string
-	Asset       *Asset
+	Destination   string
+	Amount        string
+	Asset         *Asset
+	SourceAccount Account
 }
 
 // BuildXDR for Payment returns a fully configured XDR Operation.
@@ -43,6 +44,54 @@ func (p *Payment) BuildXDR() (xdr.Operation, error) {
 		Asset:       xdrAsset,
 	}
 	body, err := xdr.NewOperationBody(opType, xdrOp)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	}
+
+	op := xdr.Operation{Body: body}
+	err = setOpSourceAccount(&op, p.SourceAccount)
+
+	return op, errors.Wrap(err, "Failed to set operation source account")
+}
+
+// FromXDR for Payment initialises the txnbuild struct from the corresponding xdr Operation.
+func (p *Payment) FromXDR(xdrOp xdr.Operation) error {
+	result, ok := xdrOp.Body.GetPaymentOp()
+	if !ok {
+		return errors.New("Error parsing payment operation from xdr")
+	}
+
+	p.SourceAccount = accountFromXDR(xdrOp.SourceAccount)
+
+	p.Destination = result.Destination.Address()
+	p.Amount = amount.String(result.Amount)
+
+	asset, err := assetFromXDR(result.Asset)
+	if err != nil {
+		return errors.Wrap(err, "Error parsing asset in payment operation")
+	}
+	p.Asset = &asset
+
+	return nil
+}
+
+// Validate for Payment validates the required struct fields. It returns an error if any of the fields are
+// invalid. Otherwise, it returns nil.
+func (p *Payment) Validate() error {
+	err := validateStellarPublicKey("Destination", p.Destination)
+	if err != nil {
+		return err
+	}
+
+	err = validateAmount("Amount", p.Amount, false)
+	if err != nil {
+		return err
+	}
+
+	err = validateStellarAsset("Asset", p.Asset)
+	if err != nil {
+		return err
+	}
 
-	return xdr.Operation{Body: body}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	return validateSourceAccount("SourceAccount", p.SourceAccount)
 }
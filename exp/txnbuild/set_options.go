@@ -1,6 +1,8 @@
 package txnbuild
 
 import (
+	"fmt"
+
 	"github.com/stellar/go/support/errors"
 	"github.com/stellar/go/xdr"
 )
@@ -59,6 +61,7 @@ type SetOptions struct {
 	HighThreshold        *Threshold
 	HomeDomain           *string
 	Signer               *Signer
+	SourceAccount        Account
 	xdrOp                xdr.SetOptionsOp
 }
 
@@ -86,8 +89,14 @@ func (so *SetOptions) BuildXDR() (xdr.Operation, error) {
 
 	opType := xdr.OperationTypeSetOptions
 	body, err := xdr.NewOperationBody(opType, so.xdrOp)
+	if err != nil {
+		return xdr.Operation{}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	}
 
-	return xdr.Operation{Body: body}, errors.Wrap(err, "Failed to build XDR OperationBody")
+	op := xdr.Operation{Body: body}
+	err = setOpSourceAccount(&op, so.SourceAccount)
+
+	return op, errors.Wrap(err, "Failed to set operation source account")
 }
 
 // handleInflation for SetOptions sets the XDR inflation destination.
@@ -194,3 +203,90 @@ func (so *SetOptions) handleSigner() (err error) {
 	}
 	return nil
 }
+
+// FromXDR for SetOptions initialises the txnbuild struct from the corresponding xdr Operation.
+func (so *SetOptions) FromXDR(xdrOp xdr.Operation) error {
+	result, ok := xdrOp.Body.GetSetOptionsOp()
+	if !ok {
+		return errors.New("Error parsing set_options operation from xdr")
+	}
+
+	so.SourceAccount = accountFromXDR(xdrOp.SourceAccount)
+
+	if result.InflationDest != nil {
+		so.InflationDestination = NewInflationDestination(result.InflationDest.Address())
+	}
+
+	if result.SetFlags != nil {
+		so.SetFlags = flagsFromXDR(*result.SetFlags)
+	}
+
+	if result.ClearFlags != nil {
+		so.ClearFlags = flagsFromXDR(*result.ClearFlags)
+	}
+
+	if result.MasterWeight != nil {
+		so.MasterWeight = NewThreshold(Threshold(*result.MasterWeight))
+	}
+
+	if result.LowThreshold != nil {
+		so.LowThreshold = NewThreshold(Threshold(*result.LowThreshold))
+	}
+
+	if result.MedThreshold != nil {
+		so.MediumThreshold = NewThreshold(Threshold(*result.MedThreshold))
+	}
+
+	if result.HighThreshold != nil {
+		so.HighThreshold = NewThreshold(Threshold(*result.HighThreshold))
+	}
+
+	if result.HomeDomain != nil {
+		so.HomeDomain = NewHomeDomain(string(*result.HomeDomain))
+	}
+
+	if result.Signer != nil {
+		so.Signer = &Signer{
+			Address: result.Signer.Key.Address(),
+			Weight:  Threshold(result.Signer.Weight),
+		}
+	}
+
+	return nil
+}
+
+// flagsFromXDR splits an XDR account flags bitmask into its individual AccountFlags.
+func flagsFromXDR(flags xdr.Uint32) []AccountFlag {
+	var accountFlags []AccountFlag
+	for _, flag := range []AccountFlag{AuthRequired, AuthRevocable, AuthImmutable} {
+		if flags&xdr.Uint32(flag) != 0 {
+			accountFlags = append(accountFlags, flag)
+		}
+	}
+
+	return accountFlags
+}
+
+// Validate for SetOptions validates the required struct fields. It returns an error if any of the fields are
+// invalid. Otherwise, it returns nil.
+func (so *SetOptions) Validate() error {
+	if so.InflationDestination != nil {
+		err := validateStellarPublicKey("InflationDestination", *so.InflationDestination)
+		if err != nil {
+			return err
+		}
+	}
+
+	if so.HomeDomain != nil && len(*so.HomeDomain) > maxHomeDomainLength {
+		return NewValidationError("HomeDomain", fmt.Sprintf("maximum length is %d characters", maxHomeDomainLength))
+	}
+
+	if so.Signer != nil {
+		err := validateStellarSignerKey("Signer.Address", so.Signer.Address)
+		if err != nil {
+			return err
+		}
+	}
+
+	return validateSourceAccount("SourceAccount", so.SourceAccount)
+}
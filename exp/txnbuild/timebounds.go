@@ -0,0 +1,72 @@
+package txnbuild
+
+import (
+	"time"
+
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// TimeoutInfinite allows an indefinite upper bound to be set for Timebounds.MaxTime. This is usually not
+// what you want.
+const TimeoutInfinite = int64(0)
+
+// Timebounds represents the time window during which a Stellar transaction is considered valid.
+//
+// MinTime and MaxTime are UNIX timestamps. In general, almost all Transactions benefit from setting an
+// upper timebound, because once submitted, the status of a pending Transaction may remain unresolved for
+// a long time if the network is congested. With an upper timebound, the submitter has a guaranteed time at
+// which the Transaction is known to have either succeeded or failed, and can then take appropriate action
+// (e.g. to resubmit or mark as resolved).
+//
+// A zero value Timebounds leaves the Transaction without time bounds.
+type Timebounds struct {
+	MinTime int64
+	MaxTime int64
+}
+
+// NewTimebounds is syntactic sugar that sets the MinTime and MaxTime to specified UNIX timestamps.
+func NewTimebounds(minTime, maxTime int64) Timebounds {
+	return Timebounds{MinTime: minTime, MaxTime: maxTime}
+}
+
+// NewTimeout is syntactic sugar that sets the MaxTime to be the duration in seconds in the
+// future specified by 'timeout'.
+func NewTimeout(timeout int64) Timebounds {
+	return Timebounds{MinTime: 0, MaxTime: time.Now().UTC().Unix() + timeout}
+}
+
+// IsSet for Timebounds returns true if either of the time limits has been configured.
+func (tb *Timebounds) IsSet() bool {
+	return tb.MinTime != 0 || tb.MaxTime != 0
+}
+
+// Validate for Timebounds sanity-checks the configured time limits.
+func (tb *Timebounds) Validate() error {
+	if tb.MinTime < 0 {
+		return errors.New("invalid timebound: minTime cannot be negative")
+	}
+
+	if tb.MaxTime < 0 {
+		return errors.New("invalid timebound: maxTime cannot be negative")
+	}
+
+	if tb.MaxTime != TimeoutInfinite && tb.MaxTime < tb.MinTime {
+		return errors.New("invalid timebound: maxTime < minTime")
+	}
+
+	return nil
+}
+
+// ToXDR for Timebounds returns an XDR object representation of the time window.
+func (tb *Timebounds) ToXDR() (xdr.TimeBounds, error) {
+	err := tb.Validate()
+	if err != nil {
+		return xdr.TimeBounds{}, err
+	}
+
+	return xdr.TimeBounds{
+		MinTime: xdr.Uint64(tb.MinTime),
+		MaxTime: xdr.Uint64(tb.MaxTime),
+	}, nil
+}
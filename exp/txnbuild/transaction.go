@@ -6,6 +6,7 @@ package txnbuild
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"encoding/base64"
 	"fmt"
 
@@ -28,6 +29,8 @@ type Transaction struct {
 	xdrTransaction xdr.Transaction
 	BaseFee        uint64 // TODO: Why is this a uint 64? Can it be a plain int?
 	xdrEnvelope    *xdr.TransactionEnvelope
+	Memo           Memo
+	Timebounds     Timebounds
 	Network        string
 }
 
@@ -59,10 +62,9 @@ func (tx *Transaction) Base64() (string, error) {
 
 // SetDefaultFee sets a sensible minimum default for the Transaction fee, if one has not
 // already been set. It is a linear function of the number of Operations in the Transaction.
+// To bid a fee based on the fees currently accepted by the network, set BaseFee using a
+// FeeStrategy before calling Build.
 func (tx *Transaction) SetDefaultFee() {
-	// TODO: Check if default base fee used elsewhere - otherwise just use int
-	// TODO: Generalise to pull this from a client call
-	var DefaultBaseFee uint64 = 100
 	if tx.BaseFee == 0 {
 		tx.BaseFee = DefaultBaseFee
 	}
@@ -71,18 +73,65 @@ func (tx *Transaction) SetDefaultFee() {
 	}
 }
 
+// Validate for Transaction checks the Transaction and each of its Operations before they are
+// built, so that malformed transactions are rejected before they are signed and submitted.
+// The cause of a returned error is a *ValidationError naming the invalid field.
+func (tx *Transaction) Validate() error {
+	if tx.SourceAccount == nil {
+		return NewValidationError("SourceAccount", "transaction has no source account")
+	}
+
+	err := validateStellarPublicKey("SourceAccount", tx.SourceAccount.GetAccountID())
+	if err != nil {
+		return err
+	}
+
+	if len(tx.Operations) == 0 {
+		return NewValidationError("Operations", "transaction has no operations")
+	}
+
+	for _, op := range tx.Operations {
+		err = op.Validate()
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("Validation failed for operation %T", op))
+		}
+	}
+
+	if memoText, ok := tx.Memo.(MemoText); ok && len(memoText) > MemoTextMaxLength {
+		return NewValidationError("Memo", fmt.Sprintf("memo text can't be longer than %d bytes", MemoTextMaxLength))
+	}
+
+	err = tx.Timebounds.Validate()
+	if err != nil {
+		return NewValidationError("Timebounds", err.Error())
+	}
+
+	return nil
+}
+
 // Build for Transaction completely configures the Transaction. After calling Build,
 // the Transaction is ready to be serialised or signed.
 func (tx *Transaction) Build() error {
+	err := tx.Validate()
+	if err != nil {
+		return err
+	}
+
 	// Set account ID in XDR
-	// TODO: Validate provided key before going further
-	tx.xdrTransaction.SourceAccount.SetAddress(tx.SourceAccount.GetAccountID())
+	err = tx.xdrTransaction.SourceAccount.SetAddress(tx.SourceAccount.GetAccountID())
+	if err != nil {
+		return errors.Wrap(err, "Failed to set source account address")
+	}
 
-	// TODO: Validate Seq Num is present in struct
 	seqnum, err := tx.SourceAccount.IncrementSequenceNumber()
 	if err != nil {
 		return errors.Wrap(err, "Failed to parse sequence number")
 	}
+	// A sequence number of zero is only valid for transactions that are never submitted, such
+	// as SEP-10 challenges.
+	if seqnum < 0 {
+		return NewValidationError("SourceAccount", "sequence number can not be negative")
+	}
 	tx.xdrTransaction.SeqNum = seqnum
 
 	for _, op := range tx.Operations {
@@ -93,6 +142,24 @@ func (tx *Transaction) Build() error {
 		tx.xdrTransaction.Operations = append(tx.xdrTransaction.Operations, xdrOperation)
 	}
 
+	// Set a memo, if one has been provided
+	if tx.Memo != nil {
+		xdrMemo, err := tx.Memo.ToXDR()
+		if err != nil {
+			return errors.Wrap(err, "Couldn't build memo XDR")
+		}
+		tx.xdrTransaction.Memo = xdrMemo
+	}
+
+	// Set the time bounds, if they have been provided
+	if tx.Timebounds.IsSet() {
+		xdrTimebounds, err := tx.Timebounds.ToXDR()
+		if err != nil {
+			return errors.Wrap(err, "Couldn't build timebounds XDR")
+		}
+		tx.xdrTransaction.TimeBounds = &xdrTimebounds
+	}
+
 	// Set a default fee, if it hasn't been set yet
 	tx.SetDefaultFee()
 
@@ -100,15 +167,12 @@ func (tx *Transaction) Build() error {
 }
 
 // Sign for Transaction signs a previously built transaction. A signed transaction may be
-// submitted to the network.
-func (tx *Transaction) Sign(kp *keypair.Full) error {
+// submitted to the network. Sign may be called with several keypairs at once, and may be
+// called repeatedly to add further signatures to the same envelope.
+func (tx *Transaction) Sign(kps ...*keypair.Full) error {
 	// TODO: Only sign if Transaction has been previously built
 	// TODO: Validate network set before sign
-	// Initialise transaction envelope
-	if tx.xdrEnvelope == nil {
-		tx.xdrEnvelope = &xdr.TransactionEnvelope{}
-		tx.xdrEnvelope.Tx = tx.xdrTransaction
-	}
+	tx.initEnvelope()
 
 	// Hash the transaction
 	hash, err := tx.Hash()
@@ -117,14 +181,133 @@ func (tx *Transaction) Sign(kp *keypair.Full) error {
 	}
 
 	// Sign the hash
-	// TODO: Allow multiple signers
-	sig, err := kp.SignDecorated(hash[:])
+	for _, kp := range kps {
+		sig, err := kp.SignDecorated(hash[:])
+		if err != nil {
+			return errors.Wrap(err, "Failed to sign transaction")
+		}
+
+		// Append the signature to the envelope
+		tx.xdrEnvelope.Signatures = append(tx.xdrEnvelope.Signatures, sig)
+	}
+
+	return nil
+}
+
+// AddSignature for Transaction appends a raw ed25519 signature, produced elsewhere (e.g. by a
+// hardware wallet or another service) by the signer with the given address, to the envelope.
+// The signature is verified against the transaction hash before it is added.
+func (tx *Transaction) AddSignature(address string, signature []byte) error {
+	tx.initEnvelope()
+
+	kp, err := keypair.Parse(address)
+	if err != nil {
+		return errors.Wrap(err, "Failed to parse signer address")
+	}
+
+	hash, err := tx.Hash()
+	if err != nil {
+		return errors.Wrap(err, "Failed to hash transaction")
+	}
+
+	err = kp.Verify(hash[:], signature)
 	if err != nil {
-		return errors.Wrap(err, "Failed to sign transaction")
+		return errors.Wrap(err, "Signature is not valid for this transaction")
+	}
+
+	tx.xdrEnvelope.Signatures = append(tx.xdrEnvelope.Signatures, xdr.DecoratedSignature{
+		Hint:      xdr.SignatureHint(kp.Hint()),
+		Signature: xdr.Signature(signature),
+	})
+
+	return nil
+}
+
+// SignHashX for Transaction signs a previously built transaction by revealing the preimage
+// of a hash(x) signer. See
+// https://www.stellar.org/developers/guides/concepts/multi-sig.html#hashx
+func (tx *Transaction) SignHashX(preimage []byte) error {
+	if len(preimage) > 64 {
+		return errors.New("Preimage cannot be more than 64 bytes")
+	}
+
+	tx.initEnvelope()
+
+	preimageHash := sha256.Sum256(preimage)
+	var hint [4]byte
+	// The hash(x) signer key is the hash of the preimage, so its last 4 bytes are the hint
+	copy(hint[:], preimageHash[28:])
+
+	sig := xdr.DecoratedSignature{
+		Hint:      xdr.SignatureHint(hint),
+		Signature: xdr.Signature(preimage),
 	}
 
-	// Append the signature to the envelope
 	tx.xdrEnvelope.Signatures = append(tx.xdrEnvelope.Signatures, sig)
 
 	return nil
 }
+
+// initEnvelope for Transaction creates the transaction envelope that signatures are added to,
+// if it doesn't exist yet.
+func (tx *Transaction) initEnvelope() {
+	if tx.xdrEnvelope == nil {
+		tx.xdrEnvelope = &xdr.TransactionEnvelope{}
+		tx.xdrEnvelope.Tx = tx.xdrTransaction
+	}
+}
+
+// TransactionFromXDR parses the supplied transaction envelope in base64 XDR and returns a
+// Transaction populated with its source account, fee, operations (including their own source
+// accounts), memo and time bounds, preserving any signatures the envelope already carries.
+// The source account's sequence number is set one below the transaction's, as it would have
+// been before the transaction was built. The Network field must be set on the result before
+// further signatures can be added. The returned Transaction is already built: calling Build on
+// it again is not supported.
+func TransactionFromXDR(txeB64 string) (Transaction, error) {
+	var xdrEnv xdr.TransactionEnvelope
+	err := xdr.SafeUnmarshalBase64(txeB64, &xdrEnv)
+	if err != nil {
+		return Transaction{}, errors.Wrap(err, "Unable to unmarshal transaction envelope")
+	}
+
+	xdrTx := xdrEnv.Tx
+	sourceAccount := NewSimpleAccount(xdrTx.SourceAccount.Address(), int64(xdrTx.SeqNum)-1)
+	tx := Transaction{
+		SourceAccount:  &sourceAccount,
+		xdrTransaction: xdrTx,
+		xdrEnvelope:    &xdrEnv,
+	}
+
+	if len(xdrTx.Operations) > 0 {
+		tx.BaseFee = uint64(xdrTx.Fee) / uint64(len(xdrTx.Operations))
+	}
+
+	tx.Memo, err = memoFromXDR(xdrTx.Memo)
+	if err != nil {
+		return Transaction{}, errors.Wrap(err, "Unable to parse memo")
+	}
+
+	if xdrTx.TimeBounds != nil {
+		tx.Timebounds = NewTimebounds(int64(xdrTx.TimeBounds.MinTime), int64(xdrTx.TimeBounds.MaxTime))
+	}
+
+	for i, xdrOp := range xdrTx.Operations {
+		op, err := operationFromXDR(xdrOp)
+		if err != nil {
+			return Transaction{}, errors.Wrap(err, fmt.Sprintf("Unable to parse operation %d", i))
+		}
+
+		// The asset being allowed is issued by the account the operation runs on behalf of
+		if at, ok := op.(*AllowTrust); ok {
+			at.Type.Issuer = sourceAccount.AccountID
+			if at.SourceAccount != nil {
+				at.Type.Issuer = at.SourceAccount.GetAccountID()
+			}
+		}
+
+		tx.Operations = append(tx.Operations, op)
+	}
+
+	return tx, nil
+}
@@ -1,11 +1,14 @@
 package txnbuild
 
 import (
+	"crypto/sha256"
 	"testing"
+	"time"
 
 	"github.com/stellar/go/clients/horizon"
 	"github.com/stellar/go/keypair"
 	"github.com/stellar/go/network"
+	"github.com/stellar/go/xdr"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -94,7 +97,7 @@ func TestPaymentFailsIfNoAssetSpecified(t *testing.T) {
 	}
 
 	err := tx.Build()
-	expectedErrMsg := "Failed to build operation *txnbuild.Payment: You must specify an asset for payment"
+	expectedErrMsg := "Validation failed for operation *txnbuild.Payment: Field: Asset, Error: asset is undefined"
 	require.EqualError(t, err, expectedErrMsg, "An asset is required")
 }
 
@@ -386,7 +389,7 @@ func TestChangeTrustNativeAssetNotAllowed(t *testing.T) {
 	}
 
 	err := tx.Build()
-	expectedErrMsg := "Failed to build operation *txnbuild.ChangeTrust: Trustline cannot be extended to a native (XLM) asset"
+	expectedErrMsg := "Validation failed for operation *txnbuild.ChangeTrust: Field: Line, Error: asset can not be native"
 	require.EqualError(t, err, expectedErrMsg, "No trustlines for native assets")
 }
 
@@ -495,6 +498,29 @@ func TestManageOfferUpdateOffer(t *testing.T) {
 	assert.Equal(t, expected, received, "Base 64 XDR should match")
 }
 
+func TestManageBuyOfferNewOffer(t *testing.T) {
+	kp0 := newKeypair0()
+	kp1 := newKeypair1()
+	sourceAccount := makeTestAccount(kp1, "41137196761092")
+
+	buyOffer := ManageBuyOffer{
+		Selling: NewNativeAsset(),
+		Buying:  NewAsset("ABCD", kp0.Address()),
+		Amount:  "100",
+		Price:   "0.01",
+	}
+
+	tx := Transaction{
+		SourceAccount: &sourceAccount,
+		Operations:    []Operation{&buyOffer},
+		Network:       network.TestNetworkPassphrase,
+	}
+
+	received := buildSignEncode(tx, kp1, t)
+	expected := "AAAAACXK8doPx27P6IReQlRRuweSSUiUfjqgyswxiu3Sh2R+AAAAZAAAJWoAAAAFAAAAAAAAAAAAAAABAAAAAAAAAAwAAAAAAAAAAUFCQ0QAAAAA4Nxt4XJcrGZRYrUvrOc1sooiQ+QdEk1suS1wo+oucsUAAAAAO5rKAAAAAAEAAABkAAAAAAAAAAAAAAAAAAAAAdKHZH4AAABAMD64RdlAEJKQOXdtMJuid0o45YRkm+2ZsKsuDuzOLvw4rWb7CuE6PcXKzYapMQ4WdQB7fM4WFbM7wHQJsCmsBQ=="
+	assert.Equal(t, expected, received, "Base 64 XDR should match")
+}
+
 func TestCreatePassiveOffer(t *testing.T) {
 	kp0 := newKeypair0()
 	kp1 := newKeypair1()
@@ -543,3 +569,345 @@ func TestPathPayment(t *testing.T) {
 	expected := "AAAAAH4RyzTWNfXhqwLUoCw91aWkZtgIzY8SAVkIPc0uFVmYAAAAZAAAql0AAAADAAAAAAAAAAAAAAABAAAAAAAAAAIAAAAAAAAAAAX14QAAAAAAfhHLNNY19eGrAtSgLD3VpaRm2AjNjxIBWQg9zS4VWZgAAAAAAAAAAACYloAAAAABAAAAAUFCQ0QAAAAA4Nxt4XJcrGZRYrUvrOc1sooiQ+QdEk1suS1wo+oucsUAAAAAAAAAAS4VWZgAAABAZBS66leC0Y7UMg6jPYWh04lLWW9cLOdjWKKIWCjBTwRPmRhb5KyVsRepZdAvl8jmaLnbTk20uJ1yWbenbbbqCw=="
 	assert.Equal(t, expected, received, "Base 64 XDR should match")
 }
+
+func TestMemoText(t *testing.T) {
+	kp0 := newKeypair0()
+	sourceAccount := makeTestAccount(kp0, "9605939170639897")
+
+	tx := Transaction{
+		SourceAccount: &sourceAccount,
+		Operations:    []Operation{&Inflation{}},
+		Memo:          MemoText("deposit 12345"),
+		Network:       network.TestNetworkPassphrase,
+	}
+
+	received := buildSignEncode(tx, kp0, t)
+
+	var txe xdr.TransactionEnvelope
+	require.NoError(t, xdr.SafeUnmarshalBase64(received, &txe))
+	assert.Equal(t, xdr.MemoTypeMemoText, txe.Tx.Memo.Type, "memo type should match")
+	assert.Equal(t, "deposit 12345", txe.Tx.Memo.MustText(), "memo text should round-trip")
+}
+
+func TestMemoTextTooLong(t *testing.T) {
+	kp0 := newKeypair0()
+	sourceAccount := makeTestAccount(kp0, "9605939170639897")
+
+	tx := Transaction{
+		SourceAccount: &sourceAccount,
+		Operations:    []Operation{&Inflation{}},
+		Memo:          MemoText("this memo text is more than 28 bytes long"),
+		Network:       network.TestNetworkPassphrase,
+	}
+
+	err := tx.Build()
+	expectedErrMsg := "Field: Memo, Error: memo text can't be longer than 28 bytes"
+	require.EqualError(t, err, expectedErrMsg, "Memo text length should be enforced")
+}
+
+func TestMemoIDAndHash(t *testing.T) {
+	kp0 := newKeypair0()
+	sourceAccount := makeTestAccount(kp0, "9605939170639897")
+
+	tx := Transaction{
+		SourceAccount: &sourceAccount,
+		Operations:    []Operation{&Inflation{}},
+		Memo:          MemoID(918273645),
+		Network:       network.TestNetworkPassphrase,
+	}
+
+	received := buildSignEncode(tx, kp0, t)
+
+	var txe xdr.TransactionEnvelope
+	require.NoError(t, xdr.SafeUnmarshalBase64(received, &txe))
+	assert.Equal(t, xdr.Uint64(918273645), txe.Tx.Memo.MustId(), "memo id should round-trip")
+
+	hash := MemoHash{0x01, 0x02, 0x03}
+	tx = Transaction{
+		SourceAccount: &sourceAccount,
+		Operations:    []Operation{&Inflation{}},
+		Memo:          hash,
+		Network:       network.TestNetworkPassphrase,
+	}
+
+	received = buildSignEncode(tx, kp0, t)
+
+	require.NoError(t, xdr.SafeUnmarshalBase64(received, &txe))
+	assert.Equal(t, xdr.Hash(hash), txe.Tx.Memo.MustHash(), "memo hash should round-trip")
+}
+
+func TestTimebounds(t *testing.T) {
+	kp0 := newKeypair0()
+	sourceAccount := makeTestAccount(kp0, "9605939170639897")
+
+	tx := Transaction{
+		SourceAccount: &sourceAccount,
+		Operations:    []Operation{&Inflation{}},
+		Timebounds:    NewTimebounds(1546300800, 1546387200),
+		Network:       network.TestNetworkPassphrase,
+	}
+
+	received := buildSignEncode(tx, kp0, t)
+
+	var txe xdr.TransactionEnvelope
+	require.NoError(t, xdr.SafeUnmarshalBase64(received, &txe))
+	require.NotNil(t, txe.Tx.TimeBounds, "time bounds should be set")
+	assert.Equal(t, xdr.Uint64(1546300800), txe.Tx.TimeBounds.MinTime, "min time should round-trip")
+	assert.Equal(t, xdr.Uint64(1546387200), txe.Tx.TimeBounds.MaxTime, "max time should round-trip")
+}
+
+func TestTimeout(t *testing.T) {
+	kp0 := newKeypair0()
+	sourceAccount := makeTestAccount(kp0, "9605939170639897")
+
+	before := time.Now().UTC().Unix()
+	tx := Transaction{
+		SourceAccount: &sourceAccount,
+		Operations:    []Operation{&Inflation{}},
+		Timebounds:    NewTimeout(300),
+		Network:       network.TestNetworkPassphrase,
+	}
+
+	received := buildSignEncode(tx, kp0, t)
+
+	var txe xdr.TransactionEnvelope
+	require.NoError(t, xdr.SafeUnmarshalBase64(received, &txe))
+	require.NotNil(t, txe.Tx.TimeBounds, "time bounds should be set")
+	assert.Equal(t, xdr.Uint64(0), txe.Tx.TimeBounds.MinTime, "min time should be unbounded")
+	assert.True(t, int64(txe.Tx.TimeBounds.MaxTime) >= before+300, "max time should be in the future")
+}
+
+func TestInvalidTimebounds(t *testing.T) {
+	kp0 := newKeypair0()
+	sourceAccount := makeTestAccount(kp0, "9605939170639897")
+
+	tx := Transaction{
+		SourceAccount: &sourceAccount,
+		Operations:    []Operation{&Inflation{}},
+		Timebounds:    NewTimebounds(1546387200, 1546300800),
+		Network:       network.TestNetworkPassphrase,
+	}
+
+	err := tx.Build()
+	expectedErrMsg := "Field: Timebounds, Error: invalid timebound: maxTime < minTime"
+	require.EqualError(t, err, expectedErrMsg, "maxTime must not precede minTime")
+}
+
+func TestSignWithMultipleKeys(t *testing.T) {
+	kp0 := newKeypair0()
+	kp1 := newKeypair1()
+	sourceAccount := makeTestAccount(kp0, "9605939170639897")
+
+	tx := Transaction{
+		SourceAccount: &sourceAccount,
+		Operations:    []Operation{&Inflation{}},
+		Network:       network.TestNetworkPassphrase,
+	}
+
+	err := tx.Build()
+	require.NoError(t, err)
+	err = tx.Sign(kp0, kp1)
+	require.NoError(t, err)
+
+	received, err := tx.Base64()
+	require.NoError(t, err)
+
+	var txe xdr.TransactionEnvelope
+	require.NoError(t, xdr.SafeUnmarshalBase64(received, &txe))
+	require.Len(t, txe.Signatures, 2, "both signatures should be present")
+	assert.Equal(t, xdr.SignatureHint(kp0.Hint()), txe.Signatures[0].Hint)
+	assert.Equal(t, xdr.SignatureHint(kp1.Hint()), txe.Signatures[1].Hint)
+}
+
+func TestAddSignatureFromPartiallySignedEnvelope(t *testing.T) {
+	kp0 := newKeypair0()
+	kp1 := newKeypair1()
+	kp2 := newKeypair2()
+	sourceAccount := makeTestAccount(kp0, "9605939170639897")
+
+	tx := Transaction{
+		SourceAccount: &sourceAccount,
+		Operations:    []Operation{&Inflation{}},
+		Network:       network.TestNetworkPassphrase,
+	}
+	partial := buildSignEncode(tx, kp0, t)
+
+	// A second service picks up the envelope and adds its own signatures
+	tx2, err := TransactionFromXDR(partial)
+	require.NoError(t, err)
+	tx2.Network = network.TestNetworkPassphrase
+
+	err = tx2.Sign(kp1)
+	require.NoError(t, err)
+
+	hash, err := tx2.Hash()
+	require.NoError(t, err)
+	rawSig, err := kp2.Sign(hash[:])
+	require.NoError(t, err)
+	err = tx2.AddSignature(kp2.Address(), rawSig)
+	require.NoError(t, err)
+
+	received, err := tx2.Base64()
+	require.NoError(t, err)
+
+	var txe xdr.TransactionEnvelope
+	require.NoError(t, xdr.SafeUnmarshalBase64(received, &txe))
+	require.Len(t, txe.Signatures, 3, "all signatures should be present")
+	for i, kp := range []*keypair.Full{kp0, kp1, kp2} {
+		assert.Equal(t, xdr.SignatureHint(kp.Hint()), txe.Signatures[i].Hint)
+		assert.NoError(t, kp.Verify(hash[:], txe.Signatures[i].Signature))
+	}
+}
+
+func TestAddSignatureRejectsInvalidSignature(t *testing.T) {
+	kp0 := newKeypair0()
+	kp1 := newKeypair1()
+	sourceAccount := makeTestAccount(kp0, "9605939170639897")
+
+	tx := Transaction{
+		SourceAccount: &sourceAccount,
+		Operations:    []Operation{&Inflation{}},
+		Network:       network.TestNetworkPassphrase,
+	}
+	err := tx.Build()
+	require.NoError(t, err)
+
+	sig, err := kp1.Sign([]byte("not the transaction hash"))
+	require.NoError(t, err)
+
+	err = tx.AddSignature(kp1.Address(), sig)
+	expectedErrMsg := "Signature is not valid for this transaction: signature verification failed"
+	require.EqualError(t, err, expectedErrMsg, "Signatures should be verified")
+}
+
+func TestSignHashX(t *testing.T) {
+	kp0 := newKeypair0()
+	sourceAccount := makeTestAccount(kp0, "9605939170639897")
+
+	tx := Transaction{
+		SourceAccount: &sourceAccount,
+		Operations:    []Operation{&Inflation{}},
+		Network:       network.TestNetworkPassphrase,
+	}
+	err := tx.Build()
+	require.NoError(t, err)
+
+	preimage := []byte("this is a preimage for hashx transactions")
+	err = tx.SignHashX(preimage)
+	require.NoError(t, err)
+
+	received, err := tx.Base64()
+	require.NoError(t, err)
+
+	var txe xdr.TransactionEnvelope
+	require.NoError(t, xdr.SafeUnmarshalBase64(received, &txe))
+	require.Len(t, txe.Signatures, 1)
+	preimageHash := sha256.Sum256(preimage)
+	assert.Equal(t, preimageHash[28:], txe.Signatures[0].Hint[:], "hint should be the end of the preimage hash")
+	assert.Equal(t, preimage, []byte(txe.Signatures[0].Signature), "signature should be the preimage")
+
+	err = tx.SignHashX(make([]byte, 65))
+	require.EqualError(t, err, "Preimage cannot be more than 64 bytes")
+}
+
+func TestTransactionFromXDR(t *testing.T) {
+	kp0 := newKeypair0()
+	kp1 := newKeypair1()
+	sourceAccount := makeTestAccount(kp0, "9605939170639897")
+
+	payment := Payment{
+		Destination: kp1.Address(),
+		Amount:      "10.0000000",
+		Asset:       NewNativeAsset(),
+	}
+	allowTrust := AllowTrust{
+		Trustor:   kp1.Address(),
+		Type:      NewAsset("ABCD", kp0.Address()),
+		Authorize: true,
+	}
+
+	tx := Transaction{
+		SourceAccount: &sourceAccount,
+		Operations:    []Operation{&payment, &allowTrust},
+		Memo:          MemoText("deposit 12345"),
+		Timebounds:    NewTimebounds(1546300800, 1546387200),
+		Network:       network.TestNetworkPassphrase,
+	}
+	txeB64 := buildSignEncode(tx, kp0, t)
+
+	parsed, err := TransactionFromXDR(txeB64)
+	require.NoError(t, err)
+
+	assert.Equal(t, kp0.Address(), parsed.SourceAccount.GetAccountID())
+	assert.Equal(t, &SimpleAccount{AccountID: kp0.Address(), Sequence: 9605939170639897}, parsed.SourceAccount)
+	assert.Equal(t, uint64(100), parsed.BaseFee)
+	assert.Equal(t, MemoText("deposit 12345"), parsed.Memo)
+	assert.Equal(t, NewTimebounds(1546300800, 1546387200), parsed.Timebounds)
+	require.Len(t, parsed.Operations, 2)
+	assert.Equal(t, &payment, parsed.Operations[0])
+	assert.Equal(t, &allowTrust, parsed.Operations[1], "allow trust issuer should be the source account")
+
+	// The parsed transaction still encodes to the same envelope
+	received, err := parsed.Base64()
+	require.NoError(t, err)
+	assert.Equal(t, txeB64, received)
+}
+
+func TestOperationSourceAccounts(t *testing.T) {
+	kp0 := newKeypair0()
+	kp1 := newKeypair1()
+	kp2 := newKeypair2()
+	sourceAccount := makeTestAccount(kp0, "9605939170639897")
+	channelAccount := NewSimpleAccount(kp1.Address(), 0)
+
+	payment := Payment{
+		Destination:   kp2.Address(),
+		Amount:        "10.0000000",
+		Asset:         NewNativeAsset(),
+		SourceAccount: &channelAccount,
+	}
+	allowTrust := AllowTrust{
+		Trustor:       kp2.Address(),
+		Type:          NewAsset("ABCD", kp1.Address()),
+		Authorize:     true,
+		SourceAccount: &channelAccount,
+	}
+
+	tx := Transaction{
+		SourceAccount: &sourceAccount,
+		Operations:    []Operation{&Inflation{}, &payment, &allowTrust},
+		Network:       network.TestNetworkPassphrase,
+	}
+	err := tx.Build()
+	require.NoError(t, err)
+	err = tx.Sign(kp0, kp1)
+	require.NoError(t, err)
+	txeB64, err := tx.Base64()
+	require.NoError(t, err)
+
+	var txe xdr.TransactionEnvelope
+	require.NoError(t, xdr.SafeUnmarshalBase64(txeB64, &txe))
+	require.Len(t, txe.Tx.Operations, 3)
+	assert.Nil(t, txe.Tx.Operations[0].SourceAccount, "operation without a source account uses the transaction's")
+	require.NotNil(t, txe.Tx.Operations[1].SourceAccount)
+	assert.Equal(t, kp1.Address(), txe.Tx.Operations[1].SourceAccount.Address())
+	require.NotNil(t, txe.Tx.Operations[2].SourceAccount)
+	assert.Equal(t, kp1.Address(), txe.Tx.Operations[2].SourceAccount.Address())
+
+	parsed, err := TransactionFromXDR(txeB64)
+	require.NoError(t, err)
+	require.Len(t, parsed.Operations, 3)
+	assert.Equal(t, &Inflation{}, parsed.Operations[0])
+	assert.Equal(t, &Payment{
+		Destination:   kp2.Address(),
+		Amount:        "10.0000000",
+		Asset:         NewNativeAsset(),
+		SourceAccount: &SimpleAccount{AccountID: kp1.Address()},
+	}, parsed.Operations[1])
+	assert.Equal(t, &AllowTrust{
+		Trustor:       kp2.Address(),
+		Type:          NewAsset("ABCD", kp1.Address()),
+		Authorize:     true,
+		SourceAccount: &SimpleAccount{AccountID: kp1.Address()},
+	}, parsed.Operations[2], "allow trust issuer should be the operation source account")
+}
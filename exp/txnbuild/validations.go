@@ -0,0 +1,187 @@
+package txnbuild
+
+import (
+	"fmt"
+	"unicode"
+
+	"github.com/stellar/go/amount"
+	"github.com/stellar/go/price"
+	"github.com/stellar/go/strkey"
+)
+
+// ValidationError is the error returned when a field of a txnbuild Operation or Transaction
+// fails client-side validation. Field names the offending struct field.
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+// Error for ValidationError returns a description of the failed validation.
+func (ve *ValidationError) Error() string {
+	return fmt.Sprintf("Field: %s, Error: %s", ve.Field, ve.Message)
+}
+
+// NewValidationError creates a ValidationError for the named field.
+func NewValidationError(field, message string) *ValidationError {
+	return &ValidationError{
+		Field:   field,
+		Message: message,
+	}
+}
+
+// maxPathLength is the maximum number of intermediate assets allowed in a path payment.
+const maxPathLength = 5
+
+// maxDataNameLength and maxDataValueLength are the limits on the size of a ManageData entry.
+const (
+	maxDataNameLength  = 64
+	maxDataValueLength = 64
+)
+
+// maxHomeDomainLength is the maximum number of characters allowed in an account's home domain.
+const maxHomeDomainLength = 32
+
+// validateStellarPublicKey checks that pk is a valid Stellar account ID.
+func validateStellarPublicKey(field, pk string) error {
+	if pk == "" {
+		return NewValidationError(field, "public key is undefined")
+	}
+
+	_, err := strkey.Decode(strkey.VersionByteAccountID, pk)
+	if err != nil {
+		return NewValidationError(field, fmt.Sprintf("%s is not a valid stellar public key", pk))
+	}
+
+	return nil
+}
+
+// validateStellarSignerKey checks that key is a valid Stellar signer: an account ID, a
+// pre-authorized transaction hash or a hash(x).
+func validateStellarSignerKey(field, key string) error {
+	version, err := strkey.Version(key)
+	if err != nil {
+		return NewValidationError(field, fmt.Sprintf("%s is not a valid stellar signer key", key))
+	}
+
+	switch version {
+	case strkey.VersionByteAccountID, strkey.VersionByteHashTx, strkey.VersionByteHashX:
+	default:
+		return NewValidationError(field, fmt.Sprintf("%s is not a valid stellar signer key", key))
+	}
+
+	_, err = strkey.Decode(version, key)
+	if err != nil {
+		return NewValidationError(field, fmt.Sprintf("%s is not a valid stellar signer key", key))
+	}
+
+	return nil
+}
+
+// validateAmount checks that value is a valid Stellar amount. Zero is only accepted when
+// allowZero is set, which is the case for amounts that are used to delete an entry.
+func validateAmount(field, value string, allowZero bool) error {
+	parsed, err := amount.ParseInt64(value)
+	if err != nil {
+		return NewValidationError(field, fmt.Sprintf("%s is not a valid amount", value))
+	}
+
+	if parsed < 0 {
+		return NewValidationError(field, "amount can not be negative")
+	}
+
+	if parsed == 0 && !allowZero {
+		return NewValidationError(field, "amount must be positive")
+	}
+
+	return nil
+}
+
+// validatePrice checks that value is a valid, positive Stellar price.
+func validatePrice(field, value string) error {
+	xdrPrice, err := price.Parse(value)
+	if err != nil {
+		return NewValidationError(field, fmt.Sprintf("%s is not a valid price", value))
+	}
+
+	if xdrPrice.N <= 0 || xdrPrice.D <= 0 {
+		return NewValidationError(field, "price must be positive")
+	}
+
+	return nil
+}
+
+// validateAssetCode checks that code is made of 1 to 12 alphanumeric characters.
+func validateAssetCode(field, code string) error {
+	if len(code) < 1 || len(code) > 12 {
+		return NewValidationError(field, "asset code length must be between 1 and 12 characters")
+	}
+
+	for _, c := range code {
+		if c > unicode.MaxASCII || !(unicode.IsLetter(c) || unicode.IsDigit(c)) {
+			return NewValidationError(field, "asset code must only contain alphanumeric characters")
+		}
+	}
+
+	return nil
+}
+
+// validateStellarAsset checks that asset is set, and, if it isn't native, that it has a
+// valid code and issuer.
+func validateStellarAsset(field string, asset *Asset) error {
+	if asset == nil {
+		return NewValidationError(field, "asset is undefined")
+	}
+
+	if asset.IsNative() {
+		return nil
+	}
+
+	err := validateAssetCode(field+".Code", asset.Code)
+	if err != nil {
+		return err
+	}
+
+	return validateStellarPublicKey(field+".Issuer", asset.Issuer)
+}
+
+// validateIssuedAsset checks that asset is a valid asset other than the native one.
+func validateIssuedAsset(field string, asset *Asset) error {
+	if asset != nil && asset.IsNative() {
+		return NewValidationError(field, "asset can not be native")
+	}
+
+	return validateStellarAsset(field, asset)
+}
+
+// validateOffer checks the fields shared by the offer operations.
+func validateOffer(selling, buying *Asset, offerAmount, offerPrice string, allowZeroAmount bool) error {
+	err := validateStellarAsset("Selling", selling)
+	if err != nil {
+		return err
+	}
+
+	err = validateStellarAsset("Buying", buying)
+	if err != nil {
+		return err
+	}
+
+	if *selling == *buying {
+		return NewValidationError("Buying", "selling and buying assets can not be the same")
+	}
+
+	err = validateAmount("Amount", offerAmount, allowZeroAmount)
+	if err != nil {
+		return err
+	}
+
+	return validatePrice("Price", offerPrice)
+}
+
+// validateSourceAccount checks that an optional source account has a valid account ID.
+func validateSourceAccount(field string, sourceAccount Account) error {
+	if sourceAccount == nil {
+		return nil
+	}
+
+	return validateStellarPublicKey(field, sourceAccount.GetAccountID())
+}
@@ -0,0 +1,110 @@
+package txnbuild
+
+import (
+	"testing"
+
+	"github.com/stellar/go/network"
+	"github.com/stellar/go/support/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperationValidation(t *testing.T) {
+	kp0 := newKeypair0()
+	kp1 := newKeypair1()
+	abcd := NewAsset("ABCD", kp0.Address())
+	badSource := NewSimpleAccount("GBADSOURCE", 0)
+
+	testCases := []struct {
+		name  string
+		op    Operation
+		field string
+	}{
+		{"bad destination", &CreateAccount{Destination: "GBADDESTINATION", Amount: "10"}, "Destination"},
+		{"zero starting balance", &CreateAccount{Destination: kp1.Address(), Amount: "0"}, "Amount"},
+		{"negative amount", &Payment{Destination: kp1.Address(), Amount: "-10", Asset: abcd}, "Amount"},
+		{"unparseable amount", &Payment{Destination: kp1.Address(), Amount: "ten", Asset: abcd}, "Amount"},
+		{"bad asset code", &Payment{Destination: kp1.Address(), Amount: "10", Asset: NewAsset("AB-CD", kp0.Address())}, "Asset.Code"},
+		{"bad asset issuer", &Payment{Destination: kp1.Address(), Amount: "10", Asset: NewAsset("ABCD", "GBADISSUER")}, "Asset.Issuer"},
+		{"bad operation source", &Payment{Destination: kp1.Address(), Amount: "10", Asset: abcd, SourceAccount: &badSource}, "SourceAccount"},
+		{"long path", &PathPayment{
+			SendAsset:   NewNativeAsset(),
+			SendMax:     "10",
+			Destination: kp1.Address(),
+			DestAsset:   NewNativeAsset(),
+			DestAmount:  "1",
+			Path:        []Asset{*abcd, *abcd, *abcd, *abcd, *abcd, *abcd},
+		}, "Path"},
+		{"zero price", &ManageOffer{Selling: NewNativeAsset(), Buying: abcd, Amount: "10", Price: "0"}, "Price"},
+		{"same assets", &CreatePassiveOffer{Selling: abcd, Buying: abcd, Amount: "10", Price: "1"}, "Buying"},
+		{"zero passive offer amount", &CreatePassiveOffer{Selling: NewNativeAsset(), Buying: abcd, Amount: "0", Price: "1"}, "Amount"},
+		{"native trustline", &ChangeTrust{Line: NewNativeAsset(), Limit: "10"}, "Line"},
+		{"native allow trust", &AllowTrust{Trustor: kp1.Address(), Type: NewNativeAsset(), Authorize: true}, "Type"},
+		{"bad merge destination", &AccountMerge{Destination: "GBADDESTINATION"}, "Destination"},
+		{"empty data name", &ManageData{Name: "", Value: []byte("value")}, "Name"},
+		{"long data value", &ManageData{Name: "key", Value: make([]byte, 65)}, "Value"},
+		{"negative bump", &BumpSequence{BumpTo: -1}, "BumpTo"},
+		{"long home domain", &SetOptions{HomeDomain: NewHomeDomain("this-home-domain-is-too-long.example.org")}, "HomeDomain"},
+		{"bad signer", &SetOptions{Signer: &Signer{Address: "GBADSIGNER", Weight: 1}}, "Signer.Address"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.op.Validate()
+			require.Error(t, err)
+			validationErr, ok := err.(*ValidationError)
+			require.True(t, ok, "error should be a *ValidationError")
+			assert.Equal(t, tc.field, validationErr.Field)
+		})
+	}
+}
+
+func TestValidOperationsPassValidation(t *testing.T) {
+	kp0 := newKeypair0()
+	kp1 := newKeypair1()
+
+	ops := []Operation{
+		&CreateAccount{Destination: kp1.Address(), Amount: "10"},
+		&Payment{Destination: kp1.Address(), Amount: "0.0000001", Asset: NewAsset("MEGAUSD", kp0.Address())},
+		&ChangeTrust{Line: NewAsset("ABCD", kp0.Address()), Limit: "0"},
+		func() Operation { op := DeleteOfferOp(2921622); return &op }(),
+		&SetOptions{Signer: &Signer{Address: "XBU2RRGLXH3E5CQHTD3ODLDF2BWDCYUSSBLLZ5GNW7JXHDIYKXZWGTOG", Weight: 1}},
+		&Inflation{},
+	}
+
+	for _, op := range ops {
+		assert.NoError(t, op.Validate(), "%T should be valid", op)
+	}
+}
+
+func TestTransactionValidation(t *testing.T) {
+	kp0 := newKeypair0()
+	sourceAccount := makeTestAccount(kp0, "9605939170639897")
+
+	tx := Transaction{
+		SourceAccount: &sourceAccount,
+		Network:       network.TestNetworkPassphrase,
+	}
+	err := tx.Build()
+	assert.EqualError(t, err, "Field: Operations, Error: transaction has no operations")
+
+	badSource := NewSimpleAccount("GBADSOURCE", 0)
+	tx = Transaction{
+		SourceAccount: &badSource,
+		Operations:    []Operation{&Inflation{}},
+		Network:       network.TestNetworkPassphrase,
+	}
+	err = tx.Build()
+	assert.EqualError(t, err, "Field: SourceAccount, Error: GBADSOURCE is not a valid stellar public key")
+
+	tx = Transaction{
+		SourceAccount: &sourceAccount,
+		Operations:    []Operation{&Payment{Destination: kp0.Address(), Amount: "10"}},
+		Network:       network.TestNetworkPassphrase,
+	}
+	err = tx.Build()
+	require.Error(t, err)
+	validationErr, ok := errors.Cause(err).(*ValidationError)
+	require.True(t, ok, "the cause should be a *ValidationError")
+	assert.Equal(t, "Asset", validationErr.Field)
+}
@@ -1,12 +1,138 @@
 package effects
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/stellar/go/protocols/horizon/base"
 	"github.com/stellar/go/support/render/hal"
 )
 
+// EffectType is the numeric type of an effect, found in the `type_i` field of effect resources.
+type EffectType int
+
+const (
+	// account effects
+
+	// EffectAccountCreated effects occur when a new account is created
+	EffectAccountCreated EffectType = 0 // from create_account
+
+	// EffectAccountRemoved effects occur when one account is merged into another
+	EffectAccountRemoved EffectType = 1 // from merge_account
+
+	// EffectAccountCredited effects occur when an account receives some currency
+	EffectAccountCredited EffectType = 2 // from create_account, payment, path_payment, merge_account
+
+	// EffectAccountDebited effects occur when an account sends some currency
+	EffectAccountDebited EffectType = 3 // from create_account, payment, path_payment, create_account
+
+	// EffectAccountThresholdsUpdated effects occur when an account changes its
+	// multisig thresholds.
+	EffectAccountThresholdsUpdated EffectType = 4 // from set_options
+
+	// EffectAccountHomeDomainUpdated effects occur when an account changes its
+	// home domain.
+	EffectAccountHomeDomainUpdated EffectType = 5 // from set_options
+
+	// EffectAccountFlagsUpdated effects occur when an account changes its
+	// account flags, either clearing or setting.
+	EffectAccountFlagsUpdated EffectType = 6 // from set_options
+
+	// EffectAccountInflationDestinationUpdated effects occur when an account changes its
+	// inflation destination.
+	EffectAccountInflationDestinationUpdated EffectType = 7 // from set_options
+
+	// signer effects
+
+	// EffectSignerCreated occurs when an account gains a signer
+	EffectSignerCreated EffectType = 10 // from set_options
+
+	// EffectSignerRemoved occurs when an account loses a signer
+	EffectSignerRemoved EffectType = 11 // from set_options
+
+	// EffectSignerUpdated occurs when an account changes the weight of one of its
+	// signers.
+	EffectSignerUpdated EffectType = 12 // from set_options
+
+	// trustline effects
+
+	// EffectTrustlineCreated occurs when an account trusts an anchor
+	EffectTrustlineCreated EffectType = 20 // from change_trust
+
+	// EffectTrustlineRemoved occurs when an account removes struct by setting the
+	// limit of a trustline to 0
+	EffectTrustlineRemoved EffectType = 21 // from change_trust
+
+	// EffectTrustlineUpdated occurs when an account changes a trustline's limit
+	EffectTrustlineUpdated EffectType = 22 // from change_trust, allow_trust
+
+	// EffectTrustlineAuthorized occurs when an anchor has AUTH_REQUIRED flag set
+	// to true and it authorizes another account's trustline
+	EffectTrustlineAuthorized EffectType = 23 // from allow_trust
+
+	// EffectTrustlineDeauthorized occurs when an anchor revokes access to a asset
+	// it issues.
+	EffectTrustlineDeauthorized EffectType = 24 // from allow_trust
+
+	// trading effects
+
+	// EffectOfferCreated occurs when an account offers to trade an asset
+	EffectOfferCreated EffectType = 30 // from manage_offer, creat_passive_offer
+
+	// EffectOfferRemoved occurs when an account removes an offer
+	EffectOfferRemoved EffectType = 31 // from manage_offer, creat_passive_offer, path_payment
+
+	// EffectOfferUpdated occurs when an offer is updated by the offering account.
+	EffectOfferUpdated EffectType = 32 // from manage_offer, creat_passive_offer, path_payment
+
+	// EffectTrade occurs when a trade is initiated because of a path payment or
+	// offer operation.
+	EffectTrade EffectType = 33 // from manage_offer, creat_passive_offer, path_payment
+
+	// data effects
+
+	// EffectDataCreated occurs when an account gets a new data field
+	EffectDataCreated EffectType = 40 // from manage_data
+
+	// EffectDataRemoved occurs when an account removes a data field
+	EffectDataRemoved EffectType = 41 // from manage_data
+
+	// EffectDataUpdated occurs when an account changes a data field's value
+	EffectDataUpdated EffectType = 42 // from manage_data
+
+	// EffectSequenceBumped occurs when an account bumps their sequence number
+	EffectSequenceBumped EffectType = 43 // from bump_sequence
+)
+
+// EffectTypeNames maps from effect type to the string used to represent that type
+// in horizon's JSON responses
+var EffectTypeNames = map[EffectType]string{
+	EffectAccountCreated:                     "account_created",
+	EffectAccountRemoved:                     "account_removed",
+	EffectAccountCredited:                    "account_credited",
+	EffectAccountDebited:                     "account_debited",
+	EffectAccountThresholdsUpdated:           "account_thresholds_updated",
+	EffectAccountHomeDomainUpdated:           "account_home_domain_updated",
+	EffectAccountFlagsUpdated:                "account_flags_updated",
+	EffectAccountInflationDestinationUpdated: "account_inflation_destination_updated",
+	EffectSignerCreated:                      "signer_created",
+	EffectSignerRemoved:                      "signer_removed",
+	EffectSignerUpdated:                      "signer_updated",
+	EffectTrustlineCreated:                   "trustline_created",
+	EffectTrustlineRemoved:                   "trustline_removed",
+	EffectTrustlineUpdated:                   "trustline_updated",
+	EffectTrustlineAuthorized:                "trustline_authorized",
+	EffectTrustlineDeauthorized:              "trustline_deauthorized",
+	EffectOfferCreated:                       "offer_created",
+	EffectOfferRemoved:                       "offer_removed",
+	EffectOfferUpdated:                       "offer_updated",
+	EffectTrade:                              "trade",
+	EffectDataCreated:                        "data_created",
+	EffectDataRemoved:                        "data_removed",
+	EffectDataUpdated:                        "data_updated",
+	EffectSequenceBumped:                     "sequence_bumped",
+}
+
 // Base provides the common structure for any effect resource effect.
 type Base struct {
 	Links struct {
@@ -28,11 +154,30 @@ func (this Base) PagingToken() string {
 	return this.PT
 }
 
+// GetType returns the type of effect
+func (this Base) GetType() string {
+	return this.Type
+}
+
+// GetID returns the ID of the effect
+func (this Base) GetID() string {
+	return this.ID
+}
+
+// GetAccount returns the account affected by the effect
+func (this Base) GetAccount() string {
+	return this.Account
+}
+
 type AccountCreated struct {
 	Base
 	StartingBalance string `json:"starting_balance"`
 }
 
+type AccountRemoved struct {
+	Base
+}
+
 type AccountCredited struct {
 	Base
 	base.Asset
@@ -63,6 +208,11 @@ type AccountFlagsUpdated struct {
 	AuthRevokable *bool `json:"auth_revokable_flag,omitempty"`
 }
 
+type AccountInflationDestinationUpdated struct {
+	Base
+	InflationDestination string `json:"inflation_destination"`
+}
+
 type SequenceBumped struct {
 	Base
 	NewSeq int64 `json:"new_seq"`
@@ -121,6 +271,18 @@ type TrustlineDeauthorized struct {
 	AssetCode string `json:"asset_code,omitempty"`
 }
 
+type OfferCreated struct {
+	Base
+}
+
+type OfferRemoved struct {
+	Base
+}
+
+type OfferUpdated struct {
+	Base
+}
+
 type Trade struct {
 	Base
 	Seller            string `json:"seller"`
@@ -135,6 +297,190 @@ type Trade struct {
 	BoughtAssetIssuer string `json:"bought_asset_issuer,omitempty"`
 }
 
+type DataCreated struct {
+	Base
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type DataRemoved struct {
+	Base
+	Name string `json:"name"`
+}
+
+type DataUpdated struct {
+	Base
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// Effect contains methods that are implemented by all effect types.
+type Effect interface {
+	PagingToken() string
+	GetType() string
+	GetID() string
+	GetAccount() string
+}
+
+// UnmarshalEffect decodes responses to the correct effect struct. Effects of an unknown type
+// are decoded into a Base.
+func UnmarshalEffect(effectType string, dataString []byte) (effects Effect, err error) {
+	switch effectType {
+	case EffectTypeNames[EffectAccountCreated]:
+		var effect AccountCreated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectAccountRemoved]:
+		var effect AccountRemoved
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectAccountCredited]:
+		var effect AccountCredited
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectAccountDebited]:
+		var effect AccountDebited
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectAccountThresholdsUpdated]:
+		var effect AccountThresholdsUpdated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectAccountHomeDomainUpdated]:
+		var effect AccountHomeDomainUpdated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectAccountFlagsUpdated]:
+		var effect AccountFlagsUpdated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectAccountInflationDestinationUpdated]:
+		var effect AccountInflationDestinationUpdated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectSignerCreated]:
+		var effect SignerCreated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectSignerRemoved]:
+		var effect SignerRemoved
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectSignerUpdated]:
+		var effect SignerUpdated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectTrustlineCreated]:
+		var effect TrustlineCreated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectTrustlineRemoved]:
+		var effect TrustlineRemoved
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectTrustlineUpdated]:
+		var effect TrustlineUpdated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectTrustlineAuthorized]:
+		var effect TrustlineAuthorized
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectTrustlineDeauthorized]:
+		var effect TrustlineDeauthorized
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectOfferCreated]:
+		var effect OfferCreated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectOfferRemoved]:
+		var effect OfferRemoved
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectOfferUpdated]:
+		var effect OfferUpdated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectTrade]:
+		var effect Trade
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectDataCreated]:
+		var effect DataCreated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectDataRemoved]:
+		var effect DataRemoved
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectDataUpdated]:
+		var effect DataUpdated
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	case EffectTypeNames[EffectSequenceBumped]:
+		var effect SequenceBumped
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	default:
+		var effect Base
+		if err = json.Unmarshal(dataString, &effect); err != nil {
+			return
+		}
+		effects = effect
+	}
+
+	return
+}
+
 // interface implementations
 var _ base.Rehydratable = &SignerCreated{}
 var _ base.Rehydratable = &SignerRemoved{}
@@ -59,6 +59,11 @@ func (a Account) GetAccountID() string {
 	return a.AccountID
 }
 
+// PagingToken implementation for hal.Pageable
+func (a Account) PagingToken() string {
+	return a.PT
+}
+
 // GetNativeBalance returns the native balance of the account
 func (a Account) GetNativeBalance() (string, error) {
 	for _, balance := range a.Balances {
@@ -251,6 +256,7 @@ type Path struct {
 	DestinationAssetIssuer string  `json:"destination_asset_issuer,omitempty"`
 	DestinationAmount      string  `json:"destination_amount"`
 	Path                   []Asset `json:"path"`
+	Slippage               string  `json:"slippage"`
 }
 
 // stub implementation to satisfy pageable interface
@@ -502,13 +508,50 @@ type AccountData struct {
 	Value string `json:"value"`
 }
 
+// Decode returns the decoded value of the data.
+func (d AccountData) Decode() ([]byte, error) {
+	return base64.StdEncoding.DecodeString(d.Value)
+}
+
 // EffectsPage contains page of effects returned by Horizon.
+// EffectsPage.Records can contain various effect types.
 type EffectsPage struct {
+	Links    hal.Links `json:"_links"`
 	Embedded struct {
-		Records []effects.Base
+		Records []effects.Effect
 	} `json:"_embedded"`
 }
 
+func (page *EffectsPage) UnmarshalJSON(data []byte) error {
+	var effectsPage struct {
+		Links    hal.Links `json:"_links"`
+		Embedded struct {
+			Records []json.RawMessage
+		} `json:"_embedded"`
+	}
+
+	if err := json.Unmarshal(data, &effectsPage); err != nil {
+		return err
+	}
+
+	for _, record := range effectsPage.Embedded.Records {
+		var b effects.Base
+		if err := json.Unmarshal(record, &b); err != nil {
+			return err
+		}
+
+		effect, err := effects.UnmarshalEffect(b.Type, record)
+		if err != nil {
+			return err
+		}
+
+		page.Embedded.Records = append(page.Embedded.Records, effect)
+	}
+
+	page.Links = effectsPage.Links
+	return nil
+}
+
 // TradeAggregationsPage returns a list of aggregated trade records, aggregated by resolution
 type TradeAggregationsPage struct {
 	Links    hal.Links `json:"_links"`
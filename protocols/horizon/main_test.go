@@ -90,3 +90,143 @@ func TestTransactionMemoTypeNone(t *testing.T) {
 	json.Unmarshal(marshaledTransaction, &result)
 	assert.Nil(t, result.Memo, "no memo field is present when memo input type was `none`")
 }
+
+// Transaction XDR Tests
+const xdrTestAccount = "GCLWGQPMKXQSPF776IU33AH4PZNOOWNAWGGKVTBQMIC5IMKUNP3E6NVU"
+
+func offerResult(opType xdr.OperationType, effect xdr.ManageOfferEffect, offerID xdr.Uint64) xdr.OperationResult {
+	var seller xdr.AccountId
+	if err := seller.SetAddress(xdrTestAccount); err != nil {
+		panic(err)
+	}
+
+	offer := xdr.ManageOfferSuccessResultOffer{Effect: effect}
+	if effect != xdr.ManageOfferEffectManageOfferDeleted {
+		offer.Offer = &xdr.OfferEntry{
+			SellerId: seller,
+			OfferId:  offerID,
+			Selling:  xdr.Asset{Type: xdr.AssetTypeAssetTypeNative},
+			Buying:   xdr.Asset{Type: xdr.AssetTypeAssetTypeNative},
+			Amount:   10,
+			Price:    xdr.Price{N: 1, D: 1},
+		}
+	}
+
+	result := xdr.ManageOfferResult{
+		Code:    xdr.ManageOfferResultCodeManageOfferSuccess,
+		Success: &xdr.ManageOfferSuccessResult{Offer: offer},
+	}
+	tr := xdr.OperationResultTr{Type: opType}
+	if opType == xdr.OperationTypeCreatePassiveOffer {
+		tr.CreatePassiveOfferResult = &result
+	} else {
+		tr.ManageOfferResult = &result
+	}
+	return xdr.OperationResult{Code: xdr.OperationResultCodeOpInner, Tr: &tr}
+}
+
+func TestTransaction_OperationResults(t *testing.T) {
+	var destination xdr.AccountId
+	assert.NoError(t, destination.SetAddress(xdrTestAccount))
+
+	pathPayment := xdr.OperationResult{
+		Code: xdr.OperationResultCodeOpInner,
+		Tr: &xdr.OperationResultTr{
+			Type: xdr.OperationTypePathPayment,
+			PathPaymentResult: &xdr.PathPaymentResult{
+				Code: xdr.PathPaymentResultCodePathPaymentSuccess,
+				Success: &xdr.PathPaymentResultSuccess{
+					Last: xdr.SimplePaymentResult{
+						Destination: destination,
+						Asset:       xdr.Asset{Type: xdr.AssetTypeAssetTypeNative},
+						Amount:      500,
+					},
+				},
+			},
+		},
+	}
+	results := []xdr.OperationResult{
+		pathPayment,
+		offerResult(xdr.OperationTypeManageOffer, xdr.ManageOfferEffectManageOfferCreated, 7),
+		offerResult(xdr.OperationTypeManageOffer, xdr.ManageOfferEffectManageOfferUpdated, 3),
+		offerResult(xdr.OperationTypeCreatePassiveOffer, xdr.ManageOfferEffectManageOfferCreated, 8),
+		offerResult(xdr.OperationTypeManageOffer, xdr.ManageOfferEffectManageOfferDeleted, 0),
+	}
+	resultXdr, err := xdr.MarshalBase64(xdr.TransactionResult{
+		FeeCharged: 500,
+		Result:     xdr.TransactionResultResult{Code: xdr.TransactionResultCodeTxSuccess, Results: &results},
+	})
+	assert.NoError(t, err)
+
+	tx := Transaction{ResultXdr: resultXdr}
+	decoded, err := tx.OperationResults()
+	if assert.NoError(t, err) && assert.Len(t, decoded, 5) {
+		success := decoded[0].MustTr().MustPathPaymentResult().MustSuccess()
+		assert.Equal(t, xdr.Int64(500), success.Last.Amount)
+	}
+
+	ids, err := tx.CreatedOfferIDs()
+	assert.NoError(t, err)
+	assert.Equal(t, []xdr.Uint64{7, 8}, ids)
+
+	resp := TransactionSuccess{Result: resultXdr}
+	ids, err = resp.CreatedOfferIDs()
+	assert.NoError(t, err)
+	assert.Equal(t, []xdr.Uint64{7, 8}, ids)
+
+	// Transactions rejected before their operations are applied have no operation results
+	resultXdr, err = xdr.MarshalBase64(xdr.TransactionResult{
+		FeeCharged: 100,
+		Result:     xdr.TransactionResultResult{Code: xdr.TransactionResultCodeTxBadSeq},
+	})
+	assert.NoError(t, err)
+	decoded, err = TransactionSuccess{Result: resultXdr}.OperationResults()
+	assert.NoError(t, err)
+	assert.Empty(t, decoded)
+
+	_, err = Transaction{ResultXdr: "AAAA"}.OperationResults()
+	assert.Error(t, err)
+}
+
+func TestTransaction_LedgerEntryChanges(t *testing.T) {
+	var seller xdr.AccountId
+	assert.NoError(t, seller.SetAddress(xdrTestAccount))
+
+	removed := func(offerID xdr.Uint64) xdr.LedgerEntryChange {
+		return xdr.LedgerEntryChange{
+			Type: xdr.LedgerEntryChangeTypeLedgerEntryRemoved,
+			Removed: &xdr.LedgerKey{
+				Type:  xdr.LedgerEntryTypeOffer,
+				Offer: &xdr.LedgerKeyOffer{SellerId: seller, OfferId: offerID},
+			},
+		}
+	}
+
+	metaXdr, err := xdr.MarshalBase64(xdr.TransactionMeta{
+		V: 1,
+		V1: &xdr.TransactionMetaV1{
+			TxChanges: xdr.LedgerEntryChanges{removed(1)},
+			Operations: []xdr.OperationMeta{
+				{Changes: xdr.LedgerEntryChanges{removed(2), removed(3)}},
+				{Changes: xdr.LedgerEntryChanges{removed(4)}},
+			},
+		},
+	})
+	assert.NoError(t, err)
+
+	changes, err := Transaction{ResultMetaXdr: metaXdr}.LedgerEntryChanges()
+	if assert.NoError(t, err) && assert.Len(t, changes, 4) {
+		for i, change := range changes {
+			assert.Equal(t, xdr.Uint64(i+1), change.MustRemoved().MustOffer().OfferId)
+		}
+	}
+
+	operations := []xdr.OperationMeta{{Changes: xdr.LedgerEntryChanges{removed(5)}}}
+	metaXdr, err = xdr.MarshalBase64(xdr.TransactionMeta{V: 0, Operations: &operations})
+	assert.NoError(t, err)
+
+	changes, err = TransactionSuccess{Meta: metaXdr}.LedgerEntryChanges()
+	if assert.NoError(t, err) && assert.Len(t, changes, 1) {
+		assert.Equal(t, xdr.Uint64(5), changes[0].MustRemoved().MustOffer().OfferId)
+	}
+}
@@ -25,6 +25,7 @@ var TypeNames = map[xdr.OperationType]string{
 	xdr.OperationTypeInflation:          "inflation",
 	xdr.OperationTypeManageData:         "manage_data",
 	xdr.OperationTypeBumpSequence:       "bump_sequence",
+	xdr.OperationTypeManageBuyOffer:     "manage_buy_offer",
 }
 
 // Base represents the common attributes of an operation resource
@@ -122,6 +123,14 @@ type ManageOffer struct {
 	OfferID int64 `json:"offer_id"`
 }
 
+// ManageBuyOffer is the json resource representing a single operation whose
+// type is ManageBuyOffer. Amount is the amount of the buying asset being bought,
+// and Price is the price of the buying asset in terms of the selling asset.
+type ManageBuyOffer struct {
+	CreatePassiveOffer
+	OfferID int64 `json:"offer_id"`
+}
+
 // SetOptions is the json resource representing a single operation whose type is
 // SetOptions.
 type SetOptions struct {
@@ -273,6 +282,12 @@ func UnmarshalOperation(operationType string, dataString []byte) (ops Operation,
 			return
 		}
 		ops = op
+	case TypeNames[xdr.OperationTypeManageBuyOffer]:
+		var op ManageBuyOffer
+		if err = json.Unmarshal(dataString, &op); err != nil {
+			return
+		}
+		ops = op
 	case TypeNames[xdr.OperationTypeCreatePassiveOffer]:
 		var op CreatePassiveOffer
 		if err = json.Unmarshal(dataString, &op); err != nil {
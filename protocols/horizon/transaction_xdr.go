@@ -0,0 +1,138 @@
+package horizon
+
+import (
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// DecodeEnvelope decodes the envelope_xdr field of a transaction.
+func (t Transaction) DecodeEnvelope() (xdr.TransactionEnvelope, error) {
+	return decodeEnvelope(t.EnvelopeXdr)
+}
+
+// DecodeResult decodes the result_xdr field of a transaction.
+func (t Transaction) DecodeResult() (xdr.TransactionResult, error) {
+	return decodeResult(t.ResultXdr)
+}
+
+// DecodeResultMeta decodes the result_meta_xdr field of a transaction.
+func (t Transaction) DecodeResultMeta() (xdr.TransactionMeta, error) {
+	return decodeResultMeta(t.ResultMetaXdr)
+}
+
+// OperationResults returns the results of the operations of a transaction, in the order of the
+// operations. It is empty when the transaction failed before its operations were applied.
+func (t Transaction) OperationResults() ([]xdr.OperationResult, error) {
+	return operationResults(t.ResultXdr)
+}
+
+// LedgerEntryChanges returns the changes a transaction made to the ledger entries, in the order
+// they were applied: the changes of the transaction itself, then those of each operation.
+func (t Transaction) LedgerEntryChanges() (xdr.LedgerEntryChanges, error) {
+	return ledgerEntryChanges(t.ResultMetaXdr)
+}
+
+// CreatedOfferIDs returns the IDs of the offers created by the operations of a transaction.
+func (t Transaction) CreatedOfferIDs() ([]xdr.Uint64, error) {
+	return createdOfferIDs(t.ResultXdr)
+}
+
+// DecodeEnvelope decodes the envelope_xdr field of a submitted transaction.
+func (resp TransactionSuccess) DecodeEnvelope() (xdr.TransactionEnvelope, error) {
+	return decodeEnvelope(resp.Env)
+}
+
+// DecodeResult decodes the result_xdr field of a submitted transaction.
+func (resp TransactionSuccess) DecodeResult() (xdr.TransactionResult, error) {
+	return decodeResult(resp.Result)
+}
+
+// DecodeResultMeta decodes the result_meta_xdr field of a submitted transaction.
+func (resp TransactionSuccess) DecodeResultMeta() (xdr.TransactionMeta, error) {
+	return decodeResultMeta(resp.Meta)
+}
+
+// OperationResults returns the results of the operations of a submitted transaction, in the order
+// of the operations.
+func (resp TransactionSuccess) OperationResults() ([]xdr.OperationResult, error) {
+	return operationResults(resp.Result)
+}
+
+// LedgerEntryChanges returns the changes a submitted transaction made to the ledger entries, in
+// the order they were applied.
+func (resp TransactionSuccess) LedgerEntryChanges() (xdr.LedgerEntryChanges, error) {
+	return ledgerEntryChanges(resp.Meta)
+}
+
+// CreatedOfferIDs returns the IDs of the offers created by the operations of a submitted
+// transaction.
+func (resp TransactionSuccess) CreatedOfferIDs() ([]xdr.Uint64, error) {
+	return createdOfferIDs(resp.Result)
+}
+
+func decodeEnvelope(data string) (envelope xdr.TransactionEnvelope, err error) {
+	err = xdr.SafeUnmarshalBase64(data, &envelope)
+	return envelope, errors.Wrap(err, "failed to decode envelope_xdr")
+}
+
+func decodeResult(data string) (result xdr.TransactionResult, err error) {
+	err = xdr.SafeUnmarshalBase64(data, &result)
+	return result, errors.Wrap(err, "failed to decode result_xdr")
+}
+
+func decodeResultMeta(data string) (meta xdr.TransactionMeta, err error) {
+	err = xdr.SafeUnmarshalBase64(data, &meta)
+	return meta, errors.Wrap(err, "failed to decode result_meta_xdr")
+}
+
+func operationResults(resultXdr string) ([]xdr.OperationResult, error) {
+	result, err := decodeResult(resultXdr)
+	if err != nil {
+		return nil, err
+	}
+
+	results, _ := result.Result.GetResults()
+	return results, nil
+}
+
+func ledgerEntryChanges(resultMetaXdr string) (xdr.LedgerEntryChanges, error) {
+	meta, err := decodeResultMeta(resultMetaXdr)
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := meta.LedgerEntryChanges()
+	return changes, errors.Wrap(err, "invalid result_meta_xdr")
+}
+
+func createdOfferIDs(resultXdr string) ([]xdr.Uint64, error) {
+	results, err := operationResults(resultXdr)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []xdr.Uint64
+	for _, result := range results {
+		tr, ok := result.GetTr()
+		if !ok {
+			continue
+		}
+
+		var success xdr.ManageOfferSuccessResult
+		switch tr.Type {
+		case xdr.OperationTypeManageOffer:
+			success, ok = tr.MustManageOfferResult().GetSuccess()
+		case xdr.OperationTypeCreatePassiveOffer:
+			success, ok = tr.MustCreatePassiveOfferResult().GetSuccess()
+		case xdr.OperationTypeManageBuyOffer:
+			success, ok = tr.MustManageBuyOfferResult().GetSuccess()
+		default:
+			ok = false
+		}
+
+		if ok && success.Offer.Effect == xdr.ManageOfferEffectManageOfferCreated {
+			ids = append(ids, success.Offer.MustOffer().OfferId)
+		}
+	}
+	return ids, nil
+}
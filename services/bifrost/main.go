@@ -16,6 +16,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/stellar/go/clients/horizon"
+	horizonclient "github.com/stellar/go/exp/clients/horizon"
 	"github.com/stellar/go/services/bifrost/bitcoin"
 	"github.com/stellar/go/services/bifrost/config"
 	"github.com/stellar/go/services/bifrost/database"
@@ -128,12 +129,14 @@ This command will create 3 server.Server's listening on ports 8000-8002.`,
 
 		accounts := make(chan server.GenerateAddressResponse)
 		users := stress.Users{
-			Horizon: &horizon.Client{
-				URL: cfg.Stellar.Horizon,
-				HTTP: &http.Client{
-					Timeout: 60 * time.Second,
+			Horizon: &horizon.Adapter{
+				Client: &horizonclient.Client{
+					HorizonURL: cfg.Stellar.Horizon,
+					HTTP: &http.Client{
+						Timeout: 60 * time.Second,
+					},
+					AppName: "bifrost",
 				},
-				AppName: "bifrost",
 			},
 			NetworkPassphrase: cfg.Stellar.NetworkPassphrase,
 			UsersPerSecond:    usersPerSecond,
@@ -398,12 +401,14 @@ func createServer(cfg config.Config, stressTest bool) *server.Server {
 		stellarAccountConfigurator.TokenPriceETH = cfg.Ethereum.TokenPrice
 	}
 
-	horizonClient := &horizon.Client{
-		URL: cfg.Stellar.Horizon,
-		HTTP: &http.Client{
-			Timeout: 20 * time.Second,
+	horizonClient := &horizon.Adapter{
+		Client: &horizonclient.Client{
+			HorizonURL: cfg.Stellar.Horizon,
+			HTTP: &http.Client{
+				Timeout: 20 * time.Second,
+			},
+			AppName: "bifrost",
 		},
-		AppName: "bifrost",
 	}
 
 	sseServer := &sse.Server{}
@@ -13,6 +13,7 @@ import (
 	"github.com/stellar/go/clients/federation"
 	"github.com/stellar/go/clients/horizon"
 	"github.com/stellar/go/clients/stellartoml"
+	horizonclient "github.com/stellar/go/exp/clients/horizon"
 	"github.com/stellar/go/services/bridge/internal/config"
 	"github.com/stellar/go/services/bridge/internal/db"
 	"github.com/stellar/go/services/bridge/internal/handlers"
@@ -137,10 +138,12 @@ func NewApp(config config.Config, migrateFlag bool, versionFlag bool, version st
 		Timeout: 60 * time.Second,
 	}
 
-	h := horizon.Client{
-		URL:     config.Horizon,
-		HTTP:    &httpClientWithTimeout,
-		AppName: "bridge-server",
+	h := horizon.Adapter{
+		Client: &horizonclient.Client{
+			HorizonURL: config.Horizon,
+			HTTP:       &httpClientWithTimeout,
+			AppName:    "bridge-server",
+		},
 	}
 
 	log.Print("Creating and initializing TransactionSubmitter")
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"github.com/stellar/go/clients/horizon"
+	horizonclient "github.com/stellar/go/exp/clients/horizon"
 	"github.com/stellar/go/services/friendbot/internal"
 	"github.com/stellar/go/strkey"
 )
@@ -24,10 +25,12 @@ func initFriendbot(
 
 	return &internal.Bot{
 		Secret: friendbotSecret,
-		Horizon: &horizon.Client{
-			URL:     horizonURL,
-			HTTP:    http.DefaultClient,
-			AppName: "friendbot",
+		Horizon: &horizon.Adapter{
+			Client: &horizonclient.Client{
+				HorizonURL: horizonURL,
+				HTTP:       http.DefaultClient,
+				AppName:    "friendbot",
+			},
 		},
 		Network:           networkPassphrase,
 		StartingBalance:   startingBalance,
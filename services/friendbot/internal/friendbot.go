@@ -18,7 +18,7 @@ type TxResult struct {
 
 // Bot represents the friendbot subsystem.
 type Bot struct {
-	Horizon           *horizon.Client
+	Horizon           horizon.ClientInterface
 	Secret            string
 	Network           string
 	StartingBalance   string
@@ -229,6 +229,12 @@ var configOpts = []*support.ConfigOption{
 		FlagDefault: false,
 		Usage:       "causes this horizon process to ingest failed transactions data",
 	},
+	&support.ConfigOption{
+		Name:      "history-archive-url",
+		ConfigKey: &config.HistoryArchiveURL,
+		OptType:   types.String,
+		Usage:     "history archive horizon's copy of the ledger state is bootstrapped from; when set, the ingester maintains accounts, trust lines, offers, data and signers in horizon's db",
+	},
 	&support.ConfigOption{
 		Name:        "history-retention-count",
 		ConfigKey:   &config.HistoryRetentionCount,
@@ -257,6 +263,13 @@ var configOpts = []*support.ConfigOption{
 		FlagDefault: false,
 		Usage:       "enables asset stats during the ingestion and expose `/assets` endpoint, Enabling it has a negative impact on CPU",
 	},
+	&support.ConfigOption{
+		Name:        "enable-in-memory-path-finding",
+		ConfigKey:   &config.EnableInMemoryPathFinding,
+		OptType:     types.Bool,
+		FlagDefault: false,
+		Usage:       "finds the paths of `/paths` endpoint in an in-memory graph of the offers instead of querying stellar-core's database, Enabling it increases memory usage",
+	},
 }
 
 func init() {
@@ -166,6 +166,17 @@ func (w *web) getAccountInfo(ctx context.Context, param interface{}) (interface{
 	return actions.AccountInfo(ctx, &core.Q{w.coreSession(ctx)}, addr)
 }
 
+// getAccountPage returns a page containing the accounts matching the filters of
+// the provided param, which is a pointer to AccountParams.
+func (w *web) getAccountPage(ctx context.Context, params interface{}) (interface{}, error) {
+	ap, ok := params.(*actions.AccountParams)
+	if !ok {
+		return nil, errors.New("Invalid param type for getAccountPage func")
+	}
+
+	return actions.AccountPage(ctx, &core.Q{w.coreSession(ctx)}, *ap)
+}
+
 // getTransactionPageByAccount returns a page containing the transaction records of an account.
 // The expected param here is a pointer to TransactionParams.
 func (w *web) getTransactionPageByAccount(ctx context.Context, params interface{}) (interface{}, error) {
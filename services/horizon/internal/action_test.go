@@ -14,6 +14,7 @@ import (
 	"github.com/stellar/go/services/horizon/internal/test"
 	"github.com/stellar/go/support/errors"
 	"github.com/stellar/go/support/render/hal"
+	"github.com/stellar/go/xdr"
 )
 
 func TestGetAccountInfo(t *testing.T) {
@@ -45,6 +46,35 @@ func TestGetAccountInfo(t *testing.T) {
 	tt.Assert.Equal(errors.Cause(err), sql.ErrNoRows)
 }
 
+func TestGetAccountPage(t *testing.T) {
+	tt := test.Start(t).Scenario("allow_trust")
+	defer tt.Finish()
+
+	w := mustInitWeb(context.Background(), &history.Q{tt.HorizonSession()}, &core.Q{tt.CoreSession()}, time.Duration(5), 0, true)
+
+	usd := xdr.MustNewCreditAsset("USD", "GC23QF2HUE52AMXUFUH3AYJAXXGXXV2VHXYYR6EYXETPKDXZSAW67XO4")
+	params := &actions.AccountParams{
+		AssetFilter: &usd,
+		PagingParams: db2.PageQuery{
+			Order:  db2.OrderDescending,
+			Limit:  db2.DefaultPageSize,
+			Cursor: "",
+		},
+	}
+
+	page, err := w.getAccountPage(context.Background(), params)
+	tt.Assert.NoError(err)
+	pageVal, ok := page.(hal.Page)
+	if !ok {
+		tt.Assert.FailNow("returned type mismatch")
+	}
+	if tt.Assert.Equal(2, len(pageVal.Embedded.Records)) {
+		account := pageVal.Embedded.Records[0].(horizon.Account)
+		tt.Assert.Equal("GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU", account.AccountID)
+		tt.Assert.Equal(2, len(account.Balances))
+	}
+}
+
 func TestGetTransactionPageByAccount(t *testing.T) {
 	tt := test.Start(t).Scenario("base")
 	defer tt.Finish()
@@ -5,27 +5,130 @@ import (
 
 	"github.com/stellar/go/clients/horizon"
 	pHorizon "github.com/stellar/go/protocols/horizon"
+	"github.com/stellar/go/services/horizon/internal/db2"
 	"github.com/stellar/go/services/horizon/internal/db2/core"
 	"github.com/stellar/go/services/horizon/internal/resourceadapter"
 	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/render/hal"
+	"github.com/stellar/go/xdr"
 )
 
 // AccountInfo returns the information about an account identified by addr.
 func AccountInfo(ctx context.Context, cq *core.Q, addr string) (*pHorizon.Account, error) {
+	var coreRecord core.Account
+	err := cq.AccountByAddress(&coreRecord, addr)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting core account record")
+	}
+
+	return accountResource(ctx, cq, coreRecord)
+}
+
+// AccountParams holds the params of the accounts index. Exactly one of
+// SignerFilter and AssetFilter is set.
+type AccountParams struct {
+	SignerFilter string
+	AssetFilter  *xdr.Asset
+	PagingParams db2.PageQuery
+}
+
+// AccountPage returns a page containing the accounts that the signer of params
+// can sign for or that hold a trustline to the asset of params.
+func AccountPage(ctx context.Context, cq *core.Q, params AccountParams) (hal.Page, error) {
+	pq := params.PagingParams
+	page := hal.Page{
+		Cursor: pq.Cursor,
+		Order:  pq.Order,
+		Limit:  pq.Limit,
+	}
+
+	var (
+		records []core.Account
+		err     error
+	)
+	if params.SignerFilter != "" {
+		err = cq.AccountsForSigner(&records, params.SignerFilter, pq)
+	} else if params.AssetFilter != nil {
+		err = cq.AccountsForAsset(&records, *params.AssetFilter, pq)
+	} else {
+		err = errors.New("accounts params without signer or asset filter")
+	}
+	if err != nil {
+		return page, errors.Wrap(err, "getting core account records")
+	}
+
+	addrs := make([]string, 0, len(records))
+	for _, record := range records {
+		addrs = append(addrs, record.Accountid)
+	}
+
 	var (
-		coreRecord     core.Account
 		coreData       []core.AccountData
 		coreSigners    []core.Signer
 		coreTrustlines []core.Trustline
-		resource       horizon.Account
 	)
 
-	err := cq.AccountByAddress(&coreRecord, addr)
+	err = cq.AllDataByAddresses(&coreData, addrs)
 	if err != nil {
-		return nil, errors.Wrap(err, "getting core account record")
+		return page, errors.Wrap(err, "getting core account data")
 	}
 
-	err = cq.AllDataByAddress(&coreData, addr)
+	err = cq.SignersByAddresses(&coreSigners, addrs)
+	if err != nil {
+		return page, errors.Wrap(err, "getting core signers")
+	}
+
+	err = cq.TrustlinesByAddresses(&coreTrustlines, addrs)
+	if err != nil {
+		return page, errors.Wrap(err, "getting core trustlines")
+	}
+
+	data := map[string][]core.AccountData{}
+	for _, d := range coreData {
+		data[d.Accountid] = append(data[d.Accountid], d)
+	}
+	signers := map[string][]core.Signer{}
+	for _, signer := range coreSigners {
+		signers[signer.Accountid] = append(signers[signer.Accountid], signer)
+	}
+	trustlines := map[string][]core.Trustline{}
+	for _, trustline := range coreTrustlines {
+		trustlines[trustline.Accountid] = append(trustlines[trustline.Accountid], trustline)
+	}
+
+	for _, record := range records {
+		var resource horizon.Account
+		err = resourceadapter.PopulateAccount(
+			ctx,
+			&resource,
+			record,
+			data[record.Accountid],
+			signers[record.Accountid],
+			trustlines[record.Accountid],
+		)
+		if err != nil {
+			return page, errors.Wrap(err, "populating account")
+		}
+		page.Add(resource)
+	}
+
+	page.FullURL = fullURL(ctx)
+	page.PopulateLinks()
+	return page, nil
+}
+
+// accountResource loads the data, signers and trustlines of coreRecord and
+// populates its resource.
+func accountResource(ctx context.Context, cq *core.Q, coreRecord core.Account) (*pHorizon.Account, error) {
+	var (
+		coreData       []core.AccountData
+		coreSigners    []core.Signer
+		coreTrustlines []core.Trustline
+		resource       horizon.Account
+	)
+	addr := coreRecord.Accountid
+
+	err := cq.AllDataByAddress(&coreData, addr)
 	if err != nil {
 		return nil, errors.Wrap(err, "getting core account data")
 	}
@@ -6,6 +6,7 @@ import (
 	"mime"
 	"net/url"
 	"strconv"
+	"strings"
 	"unicode/utf8"
 
 	"github.com/go-chi/chi"
@@ -431,6 +432,51 @@ func (base *Base) MaybeGetAsset(prefix string) (xdr.Asset, bool) {
 	return base.GetAsset(prefix), true
 }
 
+// GetAssets decodes a list of assets from the comma separated request field
+// name, each asset being either `native` or in the `code:issuer` form. It
+// returns nil when the field is empty.
+func (base *Base) GetAssets(name string) (result []xdr.Asset) {
+	if base.Err != nil {
+		return
+	}
+
+	val := base.GetString(name)
+	if base.Err != nil || val == "" {
+		return
+	}
+
+	for _, part := range strings.Split(val, ",") {
+		var asset xdr.Asset
+		if part == "native" {
+			asset.SetNative()
+			result = append(result, asset)
+			continue
+		}
+
+		codeAndIssuer := strings.Split(part, ":")
+		if len(codeAndIssuer) != 2 {
+			base.SetInvalidField(name, errors.New("assets must be `native` or in the code:issuer form"))
+			return nil
+		}
+
+		var issuer xdr.AccountId
+		err := issuer.SetAddress(codeAndIssuer[1])
+		if err != nil {
+			base.SetInvalidField(name, errors.New("invalid issuer"))
+			return nil
+		}
+
+		err = asset.SetCredit(codeAndIssuer[0], issuer)
+		if err != nil {
+			base.SetInvalidField(name, errors.New("invalid code"))
+			return nil
+		}
+		result = append(result, asset)
+	}
+
+	return
+}
+
 // GetTimeMillis retrieves a TimeMillis from the action parameter of the given name.
 // Populates err if the value is not a valid TimeMillis
 func (base *Base) GetTimeMillis(name string) (timeMillis time.Millis) {
@@ -65,6 +65,31 @@ func TestGetAsset(t *testing.T) {
 	})
 }
 
+func TestGetAssets(t *testing.T) {
+	tt := test.Start(t)
+	defer tt.Finish()
+	action := makeTestAction()
+
+	ts := action.GetAssets("assets")
+	if tt.Assert.NoError(action.Err) && tt.Assert.Len(ts, 2) {
+		tt.Assert.Equal(xdr.AssetTypeAssetTypeNative, ts[0].Type)
+		tt.Assert.Equal(xdr.MustNewCreditAsset("USD", "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H"), ts[1])
+	}
+
+	ts = action.GetAssets("blank")
+	if tt.Assert.NoError(action.Err) {
+		tt.Assert.Nil(ts)
+	}
+
+	// bad paths
+	action.GetAssets("4_asset_code")
+	tt.Assert.Error(action.Err)
+
+	action.Err = nil
+	action.GetAssets("bad_issuer_assets")
+	tt.Assert.Error(action.Err)
+}
+
 func TestGetAssetType(t *testing.T) {
 	tt := test.Start(t)
 	defer tt.Finish()
@@ -379,5 +404,7 @@ func testURLParams() map[string]string {
 		"long_12_asset_type":   "credit_alphanum12",
 		"long_12_asset_code":   "OHMYGODITSSOLONG",
 		"long_12_asset_issuer": "GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H",
+		"assets":               "native,USD:GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H",
+		"bad_issuer_assets":    "native,USD:GBRPYHIL2CI3",
 	}
 }
@@ -76,3 +76,43 @@ func TestAccountActions_InvalidID(t *testing.T) {
 	)
 	ht.Assert.Equal(400, w.Code)
 }
+
+func TestAccountActions_Index(t *testing.T) {
+	ht := StartHTTPTest(t, "allow_trust")
+	defer ht.Finish()
+
+	// by asset
+	w := ht.Get("/accounts?asset=USD:GC23QF2HUE52AMXUFUH3AYJAXXGXXV2VHXYYR6EYXETPKDXZSAW67XO4")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(2, w.Body)
+	}
+
+	w = ht.Get("/accounts?asset=USD:GC23QF2HUE52AMXUFUH3AYJAXXGXXV2VHXYYR6EYXETPKDXZSAW67XO4&cursor=GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON")
+	if ht.Assert.Equal(200, w.Code) {
+		var records []horizon.Account
+		ht.UnmarshalPage(w.Body, &records)
+		if ht.Assert.Len(records, 1) {
+			ht.Assert.Equal("GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU", records[0].AccountID)
+			ht.Assert.Equal("GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU", records[0].PT)
+		}
+	}
+
+	// by signer
+	w = ht.Get("/accounts?signer=GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+	}
+
+	// invalid params
+	w = ht.Get("/accounts")
+	ht.Assert.Equal(400, w.Code)
+
+	w = ht.Get("/accounts?signer=GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU&asset=USD:GC23QF2HUE52AMXUFUH3AYJAXXGXXV2VHXYYR6EYXETPKDXZSAW67XO4")
+	ht.Assert.Equal(400, w.Code)
+
+	w = ht.Get("/accounts?asset=USD")
+	ht.Assert.Equal(400, w.Code)
+
+	w = ht.Get("/accounts?signer=GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU&cursor=1")
+	ht.Assert.Equal(400, w.Code)
+}
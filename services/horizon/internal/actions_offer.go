@@ -1,6 +1,8 @@
 package horizon
 
 import (
+	"context"
+
 	"github.com/stellar/go/protocols/horizon"
 	"github.com/stellar/go/services/horizon/internal/actions"
 	"github.com/stellar/go/services/horizon/internal/db2"
@@ -12,11 +14,153 @@ import (
 )
 
 // This file contains the actions:
+//
+// OfferIndexAction: pages of offers, optionally filtered by seller and assets
+// OfferShowAction: single offer by id
+// OffersByAccountAction: pages of offers for an account
 
 // Interface verifications
+var _ actions.JSONer = (*OfferIndexAction)(nil)
+var _ actions.EventStreamer = (*OfferIndexAction)(nil)
+var _ actions.JSONer = (*OfferShowAction)(nil)
+var _ actions.SingleObjectStreamer = (*OfferShowAction)(nil)
 var _ actions.JSONer = (*OffersByAccountAction)(nil)
 var _ actions.EventStreamer = (*OffersByAccountAction)(nil)
 
+// OfferIndexAction renders a page of offer resources, filtered by the
+// `seller`, `selling_asset_*` and `buying_asset_*` params when present. These
+// offers are present in the ledger as of the latest validated ledger.
+type OfferIndexAction struct {
+	Action
+	Query   core.OffersQuery
+	Records []core.Offer
+	Ledgers *history.LedgerCache
+	Page    hal.Page
+}
+
+// JSON is a method for actions.JSON
+func (action *OfferIndexAction) JSON() error {
+	action.Do(
+		action.loadParams,
+		action.loadRecords,
+		action.loadLedgers,
+		action.loadPage,
+		func() { hal.Render(action.W, action.Page) },
+	)
+	return action.Err
+}
+
+// SSE is a method for actions.SSE
+func (action *OfferIndexAction) SSE(stream *sse.Stream) error {
+	// Load the params the first time SSE() is called. We update the pagination
+	// cursor below before sending each event to the stream.
+	if action.Query.PageQuery.Cursor == "" {
+		action.loadParams()
+		if action.Err != nil {
+			return action.Err
+		}
+	}
+
+	action.Do(
+		action.loadRecords,
+		action.loadLedgers,
+		func() {
+			stream.SetLimit(int(action.Query.PageQuery.Limit))
+			for _, record := range action.Records {
+				res := offerResource(action.R.Context(), record, action.Ledgers)
+				action.Query.PageQuery.Cursor = res.PagingToken()
+				stream.Send(sse.Event{ID: res.PagingToken(), Data: res})
+			}
+		},
+	)
+
+	return action.Err
+}
+
+func (action *OfferIndexAction) loadParams() {
+	action.Query.PageQuery = action.GetPageQuery()
+	action.Query.SellerID = action.GetAddress("seller")
+
+	selling, ok := action.MaybeGetAsset("selling_")
+	if ok {
+		action.Query.Selling = &selling
+	}
+
+	buying, ok := action.MaybeGetAsset("buying_")
+	if ok {
+		action.Query.Buying = &buying
+	}
+}
+
+func (action *OfferIndexAction) loadRecords() {
+	action.Err = action.CoreQ().Offers(&action.Records, action.Query)
+}
+
+func (action *OfferIndexAction) loadLedgers() {
+	action.Ledgers, action.Err = loadOfferLedgers(action.HistoryQ(), action.Records)
+}
+
+func (action *OfferIndexAction) loadPage() {
+	for _, record := range action.Records {
+		action.Page.Add(offerResource(action.R.Context(), record, action.Ledgers))
+	}
+
+	action.Page.FullURL = action.FullURL()
+	action.Page.Limit = action.Query.PageQuery.Limit
+	action.Page.Cursor = action.Query.PageQuery.Cursor
+	action.Page.Order = action.Query.PageQuery.Order
+	action.Page.PopulateLinks()
+}
+
+// OfferShowAction renders an offer found by its id. The offer is present in
+// the ledger as of the latest validated ledger.
+type OfferShowAction struct {
+	Action
+	ID       int64
+	Record   core.Offer
+	Ledgers  *history.LedgerCache
+	Resource horizon.Offer
+}
+
+// JSON is a method for actions.JSON
+func (action *OfferShowAction) JSON() error {
+	action.Do(
+		action.loadParams,
+		action.loadRecord,
+		action.loadLedgers,
+		action.loadResource,
+		func() { hal.Render(action.W, action.Resource) },
+	)
+	return action.Err
+}
+
+// LoadEvent is a method for actions.SingleObjectStreamer
+func (action *OfferShowAction) LoadEvent() (sse.Event, error) {
+	action.Do(
+		action.loadParams,
+		action.loadRecord,
+		action.loadLedgers,
+		action.loadResource,
+	)
+	return sse.Event{Data: action.Resource}, action.Err
+}
+
+func (action *OfferShowAction) loadParams() {
+	action.ID = action.GetInt64("id")
+}
+
+func (action *OfferShowAction) loadRecord() {
+	action.Err = action.CoreQ().OfferByID(&action.Record, action.ID)
+}
+
+func (action *OfferShowAction) loadLedgers() {
+	action.Ledgers, action.Err = loadOfferLedgers(action.HistoryQ(), []core.Offer{action.Record})
+}
+
+func (action *OfferShowAction) loadResource() {
+	action.Resource = offerResource(action.R.Context(), action.Record, action.Ledgers)
+}
+
 // OffersByAccountAction renders a page of offer resources, for a given
 // account.  These offers are present in the ledger as of the latest validated
 // ledger.
@@ -58,13 +202,7 @@ func (action *OffersByAccountAction) SSE(stream *sse.Stream) error {
 		func() {
 			stream.SetLimit(int(action.PageQuery.Limit))
 			for _, record := range action.Records {
-				ledger, found := action.Ledgers.Records[record.Lastmodified]
-				ledgerPtr := &ledger
-				if !found {
-					ledgerPtr = nil
-				}
-				var res horizon.Offer
-				resourceadapter.PopulateOffer(action.R.Context(), &res, record, ledgerPtr)
+				res := offerResource(action.R.Context(), record, action.Ledgers)
 				action.PageQuery.Cursor = res.PagingToken()
 				stream.Send(sse.Event{ID: res.PagingToken(), Data: res})
 			}
@@ -81,12 +219,7 @@ func (action *OffersByAccountAction) loadParams() {
 
 // loadLedgers populates the ledger cache for this action
 func (action *OffersByAccountAction) loadLedgers() {
-	action.Ledgers = &history.LedgerCache{}
-
-	for _, offer := range action.Records {
-		action.Ledgers.Queue(offer.Lastmodified)
-	}
-	action.Err = action.Ledgers.Load(action.HistoryQ())
+	action.Ledgers, action.Err = loadOfferLedgers(action.HistoryQ(), action.Records)
 }
 
 func (action *OffersByAccountAction) loadRecords() {
@@ -99,15 +232,7 @@ func (action *OffersByAccountAction) loadRecords() {
 
 func (action *OffersByAccountAction) loadPage() {
 	for _, record := range action.Records {
-		ledger, found := action.Ledgers.Records[record.Lastmodified]
-		ledgerPtr := &ledger
-		if !found {
-			ledgerPtr = nil
-		}
-
-		var res horizon.Offer
-		resourceadapter.PopulateOffer(action.R.Context(), &res, record, ledgerPtr)
-		action.Page.Add(res)
+		action.Page.Add(offerResource(action.R.Context(), record, action.Ledgers))
 	}
 
 	action.Page.FullURL = action.FullURL()
@@ -116,3 +241,28 @@ func (action *OffersByAccountAction) loadPage() {
 	action.Page.Order = action.PageQuery.Order
 	action.Page.PopulateLinks()
 }
+
+// loadOfferLedgers loads the ledgers in which offers were last modified.
+func loadOfferLedgers(q *history.Q, offers []core.Offer) (*history.LedgerCache, error) {
+	ledgers := &history.LedgerCache{}
+
+	for _, offer := range offers {
+		ledgers.Queue(offer.Lastmodified)
+	}
+	return ledgers, ledgers.Load(q)
+}
+
+// offerResource populates the resource of an offer. The last modified time of
+// the resource is left empty when its ledger is not in ledgers, which happens
+// when the history has been reaped.
+func offerResource(ctx context.Context, record core.Offer, ledgers *history.LedgerCache) horizon.Offer {
+	ledger, found := ledgers.Records[record.Lastmodified]
+	ledgerPtr := &ledger
+	if !found {
+		ledgerPtr = nil
+	}
+
+	var res horizon.Offer
+	resourceadapter.PopulateOffer(ctx, &res, record, ledgerPtr)
+	return res
+}
@@ -2,6 +2,7 @@ package horizon
 
 import (
 	"context"
+	"encoding/json"
 	"net/http/httptest"
 	"testing"
 	"time"
@@ -86,3 +87,80 @@ func TestOfferActions_SSE(t *testing.T) {
 	oa.SSE(stream)
 	tt.Require.NoError(oa.Err)
 }
+
+func TestOfferActions_AllIndex(t *testing.T) {
+	ht := StartHTTPTest(t, "trades")
+	defer ht.Finish()
+
+	w := ht.Get("/offers")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(4, w.Body)
+	}
+
+	// filters by seller
+	w = ht.Get("/offers?seller=GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(1, w.Body)
+	}
+
+	// filters by assets
+	w = ht.Get("/offers?selling_asset_type=credit_alphanum4&selling_asset_code=USD&selling_asset_issuer=GC23QF2HUE52AMXUFUH3AYJAXXGXXV2VHXYYR6EYXETPKDXZSAW67XO4&buying_asset_type=native")
+	if ht.Assert.Equal(200, w.Code) {
+		var records []map[string]interface{}
+		ht.UnmarshalPage(w.Body, &records)
+		if ht.Assert.Len(records, 1) {
+			ht.Assert.EqualValues(4, records[0]["id"])
+		}
+	}
+
+	w = ht.Get("/offers?buying_asset_type=credit_alphanum4&buying_asset_code=USD&buying_asset_issuer=GC23QF2HUE52AMXUFUH3AYJAXXGXXV2VHXYYR6EYXETPKDXZSAW67XO4&cursor=1")
+	if ht.Assert.Equal(200, w.Code) {
+		ht.Assert.PageOf(2, w.Body)
+	}
+
+	// rejects invalid params
+	w = ht.Get("/offers?seller=GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWV")
+	ht.Assert.Equal(400, w.Code)
+
+	w = ht.Get("/offers?selling_asset_type=credit_alphanum4")
+	ht.Assert.Equal(400, w.Code)
+}
+
+func TestOfferActions_Show(t *testing.T) {
+	ht := StartHTTPTest(t, "trades")
+	defer ht.Finish()
+
+	w := ht.Get("/offers/3")
+	if ht.Assert.Equal(200, w.Code) {
+		var result map[string]interface{}
+		err := json.Unmarshal(w.Body.Bytes(), &result)
+		ht.Require.NoError(err)
+		ht.Assert.EqualValues(3, result["id"])
+		ht.Assert.Equal("GA5WBPYA5Y4WAEHXWR2UKO2UO4BUGHUQ74EUPKON2QHV4WRHOIRNKKH2", result["seller"])
+		ht.Assert.Equal("1.2500000", result["price"])
+		ht.Assert.EqualValues(8, result["last_modified_ledger"])
+	}
+
+	w = ht.Get("/offers/100")
+	ht.Assert.Equal(404, w.Code)
+
+	w = ht.Get("/offers/foo")
+	ht.Assert.Equal(400, w.Code)
+}
+
+func TestOfferActions_AllIndexSSE(t *testing.T) {
+	tt := test.Start(t).Scenario("trades")
+	defer tt.Finish()
+
+	ctx := context.Background()
+	stream := sse.NewStream(ctx, httptest.NewRecorder())
+	oa := OfferIndexAction{Action: *NewTestAction(ctx, "/foo/bar?seller=GA5WBPYA5Y4WAEHXWR2UKO2UO4BUGHUQ74EUPKON2QHV4WRHOIRNKKH2")}
+
+	oa.SSE(stream)
+	tt.Require.NoError(oa.Err)
+	tt.Assert.Equal("3", oa.Query.PageQuery.Cursor)
+
+	oa.SSE(stream)
+	tt.Require.NoError(oa.Err)
+	tt.Assert.Equal("3", oa.Query.PageQuery.Cursor)
+}
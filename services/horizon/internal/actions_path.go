@@ -5,25 +5,30 @@ import (
 	"github.com/stellar/go/services/horizon/internal/actions"
 	"github.com/stellar/go/services/horizon/internal/paths"
 	"github.com/stellar/go/services/horizon/internal/resourceadapter"
+	"github.com/stellar/go/support/errors"
 	"github.com/stellar/go/support/render/hal"
+	"github.com/stellar/go/xdr"
 )
 
 // Interface verification
 var _ actions.JSONer = (*PathIndexAction)(nil)
 
-// PathIndexAction provides path finding
+// PathIndexAction provides path finding. Requests with a `source_amount` find
+// the paths sending that amount (strict send), the others find the paths
+// delivering the `destination_amount` (strict receive).
 type PathIndexAction struct {
 	Action
-	Query   paths.Query
-	Records []paths.Path
-	Page    hal.BasePage
+	StrictSend      bool
+	Query           paths.Query
+	StrictSendQuery paths.StrictSendQuery
+	Records         []paths.Path
+	Page            hal.BasePage
 }
 
 // JSON implements actions.JSON
 func (action *PathIndexAction) JSON() error {
 	action.Do(
 		action.loadQuery,
-		action.loadSourceAssets,
 		action.loadRecords,
 		action.loadPage,
 		func() { hal.Render(action.W, action.Page) },
@@ -32,19 +37,57 @@ func (action *PathIndexAction) JSON() error {
 }
 
 func (action *PathIndexAction) loadQuery() {
-	action.Query.DestinationAmount = action.GetPositiveAmount("destination_amount")
-	action.Query.DestinationAddress = action.GetAddress("destination_account", actions.RequiredParam)
-	action.Query.DestinationAsset = action.GetAsset("destination_")
+	action.StrictSend = action.GetString("source_amount") != ""
+	if !action.StrictSend {
+		action.Query.DestinationAmount = action.GetPositiveAmount("destination_amount")
+		action.Query.DestinationAddress = action.GetAddress("destination_account", actions.RequiredParam)
+		action.Query.DestinationAsset = action.GetAsset("destination_")
+		action.Query.SourceAssets = action.loadAssets("source_assets", "source_account")
+		return
+	}
+
+	if action.GetString("destination_amount") != "" {
+		action.SetInvalidField(
+			"destination_amount",
+			errors.New("`destination_amount` cannot be used with `source_amount`"),
+		)
+		return
+	}
+
+	action.StrictSendQuery.SourceAmount = action.GetPositiveAmount("source_amount")
+	action.StrictSendQuery.SourceAsset = action.GetAsset("source_")
+	action.StrictSendQuery.DestinationAssets = action.loadAssets("destination_assets", "destination_account")
 }
 
-func (action *PathIndexAction) loadSourceAssets() {
-	action.Err = action.CoreQ().AssetsForAddress(
-		&action.Query.SourceAssets,
-		action.GetAddress("source_account"),
-	)
+// loadAssets returns the assets listed in the assetsParam field or, when it is
+// empty, the assets the account in the accountParam field can hold.
+func (action *PathIndexAction) loadAssets(assetsParam, accountParam string) []xdr.Asset {
+	assets := action.GetAssets(assetsParam)
+	address := action.GetAddress(accountParam)
+	if action.Err != nil {
+		return nil
+	}
+
+	if len(assets) > 0 {
+		if address != "" {
+			action.SetInvalidField(
+				assetsParam,
+				errors.New("`"+assetsParam+"` cannot be used with `"+accountParam+"`"),
+			)
+			return nil
+		}
+		return assets
+	}
+
+	action.Err = action.CoreQ().AssetsForAddress(&assets, address)
+	return assets
 }
 
 func (action *PathIndexAction) loadRecords() {
+	if action.StrictSend {
+		action.Records, action.Err = action.App.paths.FindStrictSend(action.StrictSendQuery, action.App.config.MaxPathLength)
+		return
+	}
 	action.Records, action.Err = action.App.paths.Find(action.Query, action.App.config.MaxPathLength)
 }
 
@@ -52,7 +95,7 @@ func (action *PathIndexAction) loadPage() {
 	action.Page.Init()
 	for _, p := range action.Records {
 		var res horizon.Path
-		action.Err = resourceadapter.PopulatePath(action.R.Context(), &res, action.Query, p)
+		action.Err = resourceadapter.PopulatePath(action.R.Context(), &res, p)
 
 		if action.Err != nil {
 			return
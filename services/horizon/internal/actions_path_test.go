@@ -36,4 +36,48 @@ func TestPathActions_Index(t *testing.T) {
 	ht.Assert.Equal(200, w.Code)
 	ht.Assert.PageOf(3, w.Body)
 
+	// source assets given explicitly
+	q.Del("source_account")
+	q.Add("source_assets", "USD:GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN")
+	w = ht.Get("/paths?" + q.Encode())
+	ht.Assert.Equal(200, w.Code)
+	ht.Assert.PageOf(3, w.Body)
+
+	// source assets and source account are exclusive
+	q.Add(
+		"source_account",
+		"GARSFJNXJIHO6ULUBK3DBYKVSIZE7SC72S5DYBCHU7DKL22UXKVD7MXP",
+	)
+	w = ht.Get("/paths?" + q.Encode())
+	ht.Assert.Equal(400, w.Code)
+}
+
+func TestPathActions_StrictSend(t *testing.T) {
+	ht := StartHTTPTest(t, "paths")
+	defer ht.Finish()
+
+	var q = make(url.Values)
+	q.Add(
+		"source_asset_issuer",
+		"GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN",
+	)
+	q.Add("source_asset_type", "credit_alphanum4")
+	q.Add("source_asset_code", "USD")
+	q.Add("source_amount", "10")
+	q.Add("destination_assets", "EUR:GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN")
+
+	w := ht.Get("/paths?" + q.Encode())
+	ht.Assert.Equal(200, w.Code)
+	ht.Assert.PageOf(3, w.Body)
+
+	// destination_amount cannot be used in strict send mode
+	q.Add("destination_amount", "10")
+	w = ht.Get("/paths?" + q.Encode())
+	ht.Assert.Equal(400, w.Code)
+
+	// the source amount must be positive
+	q.Del("destination_amount")
+	q.Set("source_amount", "-10")
+	w = ht.Get("/paths?" + q.Encode())
+	ht.Assert.Equal(400, w.Code)
 }
@@ -17,9 +17,11 @@ import (
 	"github.com/stellar/go/services/horizon/internal/db2/core"
 	"github.com/stellar/go/services/horizon/internal/db2/history"
 	"github.com/stellar/go/services/horizon/internal/ingest"
+	"github.com/stellar/go/services/horizon/internal/ingest/state"
 	"github.com/stellar/go/services/horizon/internal/ledger"
 	"github.com/stellar/go/services/horizon/internal/logmetrics"
 	"github.com/stellar/go/services/horizon/internal/operationfeestats"
+	"github.com/stellar/go/services/horizon/internal/orderbook"
 	"github.com/stellar/go/services/horizon/internal/paths"
 	"github.com/stellar/go/services/horizon/internal/reap"
 	"github.com/stellar/go/services/horizon/internal/simplepath"
@@ -48,7 +50,9 @@ type App struct {
 	coreSupportedProtocolVersion int32
 	submitter                    *txsub.System
 	paths                        paths.Finder
+	orderBookUpdater             *orderbook.Updater
 	ingester                     *ingest.System
+	stateIngester                *state.System
 	reaper                       *reap.System
 	ticks                        *time.Ticker
 
@@ -327,6 +331,25 @@ func (a *App) UpdateMetrics() {
 	a.coreConnGauge.Update(int64(a.coreQ.Session.DB.Stats().OpenConnections))
 }
 
+// UpdateOrderBookGraph brings the in-memory order book graph used for path
+// finding up to date with the latest ledger.
+func (a *App) UpdateOrderBookGraph() {
+	err := a.orderBookUpdater.Update()
+	if err != nil {
+		log.WithStack(err).WithField("err", err.Error()).Error("failed to update the order book graph")
+	}
+}
+
+// UpdateStateIngestion brings horizon's copy of the ledger state up to date
+// with the latest ledger. It runs apart from the history ingestion, which it
+// never blocks.
+func (a *App) UpdateStateIngestion() {
+	err := a.stateIngester.Update()
+	if err != nil {
+		log.WithStack(err).WithField("err", err.Error()).Error("failed to update the ledger state")
+	}
+}
+
 // DeleteUnretainedHistory forwards to the app's reaper.  See
 // `reap.DeleteUnretainedHistory` for details
 func (a *App) DeleteUnretainedHistory() error {
@@ -349,6 +372,14 @@ func (a *App) Tick() {
 		go a.ingester.Tick()
 	}
 
+	if a.stateIngester != nil {
+		go a.UpdateStateIngestion()
+	}
+
+	if a.orderBookUpdater != nil {
+		go a.UpdateOrderBookGraph()
+	}
+
 	wg.Add(2)
 	go func() { a.reaper.Tick(); wg.Done() }()
 	go func() { a.submitter.Tick(a.ctx); wg.Done() }()
@@ -389,7 +420,13 @@ func (a *App) init() {
 	initSubmissionSystem(a)
 
 	// path-finder
-	a.paths = &simplepath.Finder{a.CoreQ()}
+	if a.config.EnableInMemoryPathFinding {
+		graph := orderbook.NewOrderBookGraph()
+		a.orderBookUpdater = &orderbook.Updater{Graph: graph, CoreQ: a.CoreQ()}
+		a.paths = &orderbook.Finder{Graph: graph}
+	} else {
+		a.paths = &simplepath.Finder{a.CoreQ()}
+	}
 
 	// reaper
 	a.reaper = reap.New(a.config.HistoryRetentionCount, a.HorizonSession(nil))
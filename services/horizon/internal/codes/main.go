@@ -169,6 +169,35 @@ func String(code interface{}) (string, error) {
 		case xdr.ManageOfferResultCodeManageOfferLowReserve:
 			return OpLowReserve, nil
 		}
+	case xdr.ManageBuyOfferResultCode:
+		switch code {
+		case xdr.ManageBuyOfferResultCodeManageBuyOfferSuccess:
+			return OpSuccess, nil
+		case xdr.ManageBuyOfferResultCodeManageBuyOfferMalformed:
+			return OpMalformed, nil
+		case xdr.ManageBuyOfferResultCodeManageBuyOfferSellNoTrust:
+			return "op_sell_no_trust", nil
+		case xdr.ManageBuyOfferResultCodeManageBuyOfferBuyNoTrust:
+			return "op_buy_no_trust", nil
+		case xdr.ManageBuyOfferResultCodeManageBuyOfferSellNotAuthorized:
+			return "sell_not_authorized", nil
+		case xdr.ManageBuyOfferResultCodeManageBuyOfferBuyNotAuthorized:
+			return "buy_not_authorized", nil
+		case xdr.ManageBuyOfferResultCodeManageBuyOfferLineFull:
+			return OpLineFull, nil
+		case xdr.ManageBuyOfferResultCodeManageBuyOfferUnderfunded:
+			return OpUnderfunded, nil
+		case xdr.ManageBuyOfferResultCodeManageBuyOfferCrossSelf:
+			return "op_cross_self", nil
+		case xdr.ManageBuyOfferResultCodeManageBuyOfferSellNoIssuer:
+			return "op_sell_no_issuer", nil
+		case xdr.ManageBuyOfferResultCodeManageBuyOfferBuyNoIssuer:
+			return "buy_no_issuer", nil
+		case xdr.ManageBuyOfferResultCodeManageBuyOfferNotFound:
+			return "op_offer_not_found", nil
+		case xdr.ManageBuyOfferResultCodeManageBuyOfferLowReserve:
+			return OpLowReserve, nil
+		}
 	case xdr.SetOptionsResultCode:
 		switch code {
 		case xdr.SetOptionsResultCodeSetOptionsSuccess:
@@ -304,6 +333,8 @@ func ForOperationResult(opr xdr.OperationResult) (string, error) {
 		ic = ir.MustManageDataResult().Code
 	case xdr.OperationTypeBumpSequence:
 		ic = ir.MustBumpSeqResult().Code
+	case xdr.OperationTypeManageBuyOffer:
+		ic = ir.MustManageBuyOfferResult().Code
 	}
 
 	return String(ic)
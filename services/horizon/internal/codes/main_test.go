@@ -17,6 +17,7 @@ func TestString(t *testing.T) {
 		{xdr.OperationResultCodeOpBadAuth, "op_bad_auth", nil},
 		{xdr.CreateAccountResultCodeCreateAccountLowReserve, "op_low_reserve", nil},
 		{xdr.PaymentResultCodePaymentSrcNoTrust, "op_src_no_trust", nil},
+		{xdr.ManageBuyOfferResultCodeManageBuyOfferCrossSelf, "op_cross_self", nil},
 		{0, "", ErrUnknownCode},
 	}
 
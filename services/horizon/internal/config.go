@@ -38,6 +38,9 @@ type Config struct {
 	Ingest bool
 	// IngestFailedTransactions toggles whether to ingest failed transactions
 	IngestFailedTransactions bool
+	// HistoryArchiveURL is the history archive horizon bootstraps its copy of
+	// the ledger state from. When empty, the ledger state is not ingested.
+	HistoryArchiveURL string
 	// HistoryRetentionCount represents the minimum number of ledgers worth of
 	// history data to retain in the horizon database. For the purposes of
 	// determining a "retention duration", each ledger roughly corresponds to 10
@@ -55,4 +58,9 @@ type Config struct {
 	// Enabling it has a negative impact on CPU when ingesting ledgers full of
 	// many different assets related operations.
 	EnableAssetStats bool
+	// EnableInMemoryPathFinding is a feature flag that determines whether to
+	// find the paths of the `/paths` endpoint in an in-memory graph of the
+	// offers, kept up to date as ledgers close, instead of querying the offers
+	// of stellar-core's database.
+	EnableInMemoryPathFinding bool
 }
@@ -4,10 +4,16 @@ import (
 	"encoding/base64"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/stellar/go/services/horizon/internal/db2"
 	"github.com/stellar/go/support/errors"
 	"github.com/stellar/go/xdr"
 )
 
+// PagingToken returns a suitable paging token for the Account
+func (ac Account) PagingToken() string {
+	return ac.Accountid
+}
+
 // IsAuthRequired returns true if the account has the "AUTH_REQUIRED" option
 // turned on.
 func (ac Account) IsAuthRequired() bool {
@@ -39,15 +45,139 @@ func (q *Q) AccountByAddress(dest *Account, addy string) error {
 		return err
 	}
 
-	if schemaVersion >= 9 {
-		// Since schema version 9, home_domain is base64 encoded.
-		decoded, err := base64.StdEncoding.DecodeString(dest.HomeDomain.String)
+	return decodeHomeDomain(dest, schemaVersion)
+}
+
+// AccountsForSigner loads a page of rows from `accounts`, ordered by address,
+// for the accounts that `signer` can sign for: the accounts it is a signer of
+// and the account of `signer` itself.
+func (q *Q) AccountsForSigner(dest *[]Account, signer string, pq db2.PageQuery) error {
+	schemaVersion, err := q.SchemaVersion()
+	if err != nil {
+		return err
+	}
+
+	var sql sq.SelectBuilder
+	if schemaVersion < 9 {
+		sql = selectAccount.Where(
+			"a.accountid = ? OR a.accountid IN (SELECT si.accountid FROM signers si WHERE si.publickey = ?)",
+			signer,
+			signer,
+		)
+	} else {
+		// Since schema version 9 the signers are stored in `accounts` as
+		// base64 encoded XDR, which holds the XDR encoded key of each signer.
+		var key xdr.SignerKey
+		err = key.SetAddress(signer)
+		if err != nil {
+			return errors.Wrap(err, "invalid signer")
+		}
+
+		var keyXDR []byte
+		keyXDR, err = key.MarshalBinary()
 		if err != nil {
-			return errors.Wrap(err, "Unable to base64 decode HomeDomain")
+			return errors.Wrap(err, "failed to encode signer")
 		}
-		dest.HomeDomain.String = string(decoded)
+
+		sql = selectAccount.Where(
+			"a.accountid = ? OR position(?::bytea IN decode(a.signers, 'base64')) > 0",
+			signer,
+			keyXDR,
+		)
 	}
 
+	sql, err = pageAccounts(sql, pq)
+	if err != nil {
+		return err
+	}
+
+	var accounts []Account
+	err = q.Select(&accounts, sql)
+	if err != nil {
+		return err
+	}
+
+	for i := range accounts {
+		err = decodeHomeDomain(&accounts[i], schemaVersion)
+		if err != nil {
+			return err
+		}
+	}
+
+	*dest = accounts
+	return nil
+}
+
+// AccountsForAsset loads a page of rows from `accounts`, ordered by address,
+// for the accounts holding a trustline to `asset`.
+func (q *Q) AccountsForAsset(dest *[]Account, asset xdr.Asset, pq db2.PageQuery) error {
+	schemaVersion, err := q.SchemaVersion()
+	if err != nil {
+		return err
+	}
+
+	var (
+		t xdr.AssetType
+		c string
+		i string
+	)
+
+	err = asset.Extract(&t, &c, &i)
+	if err != nil {
+		return err
+	}
+
+	sql := selectAccount.
+		Join("trustlines tl ON tl.accountid = a.accountid").
+		Where(sq.Eq{
+			"tl.assettype": t,
+			"tl.assetcode": c,
+			"tl.issuer":    i,
+		})
+	sql, err = pageAccounts(sql, pq)
+	if err != nil {
+		return err
+	}
+
+	var accounts []Account
+	err = q.Select(&accounts, sql)
+	if err != nil {
+		return err
+	}
+
+	for i := range accounts {
+		err = decodeHomeDomain(&accounts[i], schemaVersion)
+		if err != nil {
+			return err
+		}
+	}
+
+	*dest = accounts
+	return nil
+}
+
+// pageAccounts applies the paging effects of `pq` to `sql`, using the address
+// of the accounts as the cursor.
+func pageAccounts(sql sq.SelectBuilder, pq db2.PageQuery) (sq.SelectBuilder, error) {
+	if pq.Cursor == "" && pq.Order == db2.OrderDescending {
+		return sql.Limit(pq.Limit).OrderBy("a.accountid desc"), nil
+	}
+
+	return pq.ApplyToUsingCursor(sql, "a.accountid", pq.Cursor)
+}
+
+// decodeHomeDomain decodes the home domain of `account`, which is base64
+// encoded since schema version 9.
+func decodeHomeDomain(account *Account, schemaVersion int) error {
+	if schemaVersion < 9 {
+		return nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(account.HomeDomain.String)
+	if err != nil {
+		return errors.Wrap(err, "Unable to base64 decode HomeDomain")
+	}
+	account.HomeDomain.String = string(decoded)
 	return nil
 }
 
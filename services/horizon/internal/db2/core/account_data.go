@@ -44,12 +44,21 @@ func (q *Q) AccountDataByKey(dest *AccountData, addy string, key string) error {
 
 // AllDataByAddress loads all data for `addy`
 func (q *Q) AllDataByAddress(dest interface{}, addy string) error {
+	return q.selectAllData(dest, selectAccountData.Where("accountid = ?", addy))
+}
+
+// AllDataByAddresses loads all data for the accounts in `addys`
+func (q *Q) AllDataByAddresses(dest *[]AccountData, addys []string) error {
+	return q.selectAllData(dest, selectAccountData.Where(sq.Eq{"ad.accountid": addys}))
+}
+
+// selectAllData loads the data selected by sql, decoding their keys.
+func (q *Q) selectAllData(dest interface{}, sql sq.SelectBuilder) error {
 	schemaVersion, err := q.SchemaVersion()
 	if err != nil {
 		return err
 	}
 
-	sql := selectAccountData.Where("accountid = ?", addy)
 	err = q.Select(dest, sql)
 	if err != nil {
 		return err
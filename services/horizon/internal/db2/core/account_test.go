@@ -0,0 +1,56 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stellar/go/services/horizon/internal/db2"
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/xdr"
+)
+
+func TestAccountsForAsset(t *testing.T) {
+	tt := test.Start(t).Scenario("allow_trust")
+	defer tt.Finish()
+	q := &Q{tt.CoreSession()}
+
+	usd := xdr.MustNewCreditAsset("USD", "GC23QF2HUE52AMXUFUH3AYJAXXGXXV2VHXYYR6EYXETPKDXZSAW67XO4")
+	var accounts []Account
+
+	load := func(cursor, order string, limit uint64) bool {
+		accounts = []Account{}
+		pq, err := db2.NewPageQuery(cursor, false, order, limit)
+		if !tt.Assert.NoError(err) {
+			return false
+		}
+
+		err = q.AccountsForAsset(&accounts, usd, pq)
+		return tt.Assert.NoError(err)
+	}
+
+	if load("", "asc", db2.DefaultPageSize) {
+		if tt.Assert.Len(accounts, 2) {
+			tt.Assert.Equal("GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON", accounts[0].Accountid)
+			tt.Assert.Equal("GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU", accounts[1].Accountid)
+		}
+	}
+
+	// ordering works
+	if load("", "desc", db2.DefaultPageSize) {
+		if tt.Assert.Len(accounts, 2) {
+			tt.Assert.Equal("GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU", accounts[0].Accountid)
+		}
+	}
+
+	// cursor and limit work
+	if load("GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON", "asc", 1) {
+		if tt.Assert.Len(accounts, 1) {
+			tt.Assert.Equal("GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU", accounts[0].Accountid)
+		}
+	}
+
+	// filters by asset
+	err := q.AccountsForAsset(&accounts, xdr.MustNewCreditAsset("EUR", "GC23QF2HUE52AMXUFUH3AYJAXXGXXV2VHXYYR6EYXETPKDXZSAW67XO4"), db2.MustPageQuery("", false, "asc", 10))
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(accounts, 0)
+	}
+}
@@ -0,0 +1,16 @@
+package core
+
+import (
+	sq "github.com/Masterminds/squirrel"
+)
+
+// LedgerUpgradesByLedger is a query that loads all rows from `upgradehistory`
+// where ledgerseq matches `Sequence.`
+func (q *Q) LedgerUpgradesByLedger(dest interface{}, seq int32) error {
+	sql := sq.Select("cuh.*").
+		From("upgradehistory cuh").
+		OrderBy("cuh.upgradeindex ASC").
+		Where("cuh.ledgerseq = ?", seq)
+
+	return q.Select(dest, sql)
+}
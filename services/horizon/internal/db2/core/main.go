@@ -44,6 +44,15 @@ type LedgerHeader struct {
 	Data           xdr.LedgerHeader `db:"data"`
 }
 
+// LedgerUpgrade is a row of data from the `upgradehistory` table from
+// stellar-core
+type LedgerUpgrade struct {
+	LedgerSequence int32                  `db:"ledgerseq"`
+	Index          int32                  `db:"upgradeindex"`
+	Upgrade        string                 `db:"upgrade"`
+	Changes        xdr.LedgerEntryChanges `db:"changes"`
+}
+
 // Offer is row of data from the `offers` table from stellar-core
 type Offer struct {
 	SellerID string `db:"sellerid"`
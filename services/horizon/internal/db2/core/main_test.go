@@ -107,6 +107,14 @@ func TestSchemaVersion8(t *testing.T) {
 		return
 	}
 
+	var accounts []Account
+	err = q.AccountsForSigner(&accounts, "GAFEES4MDE5Z7Q6JBB2BYMLS7YWEHTPNR7ICANZA7TAOLMSRELE4H4S2", pq)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal(1, len(accounts))
+		tt.Assert.Equal("GBRPYHIL2CI3FNQ4BXLFMNDLFJUNPU2HY3ZMFSHONUCEOASW7QC7OX2H", accounts[0].Accountid)
+		tt.Assert.Equal("stellar.org", accounts[0].HomeDomain.String)
+	}
+
 	var offers []Offer
 	err = q.OffersByAddress(&offers, "GAXMF43TGZHW3QN3REOUA2U5PW5BTARXGGYJ3JIFHW3YT6QRKRL3CPPU", pq)
 	if tt.Assert.NoError(err) {
@@ -140,6 +148,14 @@ func TestSchemaVersion8(t *testing.T) {
 		tt.Assert.True(assets[0].Equals(connectedAsset))
 	}
 
+	assets = []xdr.Asset{}
+	err = q.ConnectedSellingAssets(&assets, xdr.MustNewCreditAsset("USD", "GAXMF43TGZHW3QN3REOUA2U5PW5BTARXGGYJ3JIFHW3YT6QRKRL3CPPU"))
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal(1, len(assets))
+		connectedAsset := xdr.MustNewNativeAsset()
+		tt.Assert.True(assets[0].Equals(connectedAsset))
+	}
+
 	var orderbookSummary OrderBookSummary
 	err = q.GetOrderBookSummary(&orderbookSummary, xdr.MustNewNativeAsset(), xdr.MustNewCreditAsset("USD", "GB2QIYT2IAUFMRXKLSLLPRECC6OCOGJMADSPTRK7TGNT2SFR2YGWDARD"), 10)
 	if tt.Assert.NoError(err) {
@@ -180,6 +196,12 @@ func TestSchemaVersion9(t *testing.T) {
 		tt.Assert.Equal("bWFu", data[0].Value)
 	}
 
+	var allData []AccountData
+	err = q.AllDataByAddresses(&allData, []string{"GDZOBPTVEECUYFCHSQ5NCEUVAV4JKRZI6KO5HFOM7HGQT22E3XIGRHNU"})
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal(data, allData)
+	}
+
 	var singleData AccountData
 	err = q.AccountDataByKey(&singleData, "GDZOBPTVEECUYFCHSQ5NCEUVAV4JKRZI6KO5HFOM7HGQT22E3XIGRHNU", "jam")
 	if tt.Assert.NoError(err) {
@@ -208,6 +230,30 @@ func TestSchemaVersion9(t *testing.T) {
 		return
 	}
 
+	var accounts []Account
+	err = q.AccountsForSigner(&accounts, "GC7BWB2ME4LII3TVWTHUIT7KGJXU4D5M6JUNLQ57WA7JERDNSAEXLOAN", pq)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal(1, len(accounts))
+		tt.Assert.Equal("GDZOBPTVEECUYFCHSQ5NCEUVAV4JKRZI6KO5HFOM7HGQT22E3XIGRHNU", accounts[0].Accountid)
+		tt.Assert.Equal("lobstr.co", accounts[0].HomeDomain.String)
+	}
+
+	// The master key of an account can sign for it
+	err = q.AccountsForSigner(&accounts, "GD7HOGYRECGFKFR2GGOWEF2FT3DVR3GU4K7BVRGGPWVSXAVKGSYKTXOH", pq)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal(1, len(accounts))
+		tt.Assert.Equal("GD7HOGYRECGFKFR2GGOWEF2FT3DVR3GU4K7BVRGGPWVSXAVKGSYKTXOH", accounts[0].Accountid)
+	}
+
+	var signers3 []Signer
+	err = q.SignersByAddresses(&signers3, []string{
+		"GDZOBPTVEECUYFCHSQ5NCEUVAV4JKRZI6KO5HFOM7HGQT22E3XIGRHNU",
+		"GD7HOGYRECGFKFR2GGOWEF2FT3DVR3GU4K7BVRGGPWVSXAVKGSYKTXOH",
+	})
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal(len(signers), len(signers3))
+	}
+
 	var offers []Offer
 	err = q.OffersByAddress(&offers, "GAXMF43TGZHW3QN3REOUA2U5PW5BTARXGGYJ3JIFHW3YT6QRKRL3CPPU", pq)
 	if tt.Assert.NoError(err) {
@@ -260,6 +306,14 @@ func TestSchemaVersion9(t *testing.T) {
 		tt.Assert.True(assets[0].Equals(connectedAsset))
 	}
 
+	assets = []xdr.Asset{}
+	err = q.ConnectedSellingAssets(&assets, xdr.MustNewCreditAsset("USD", "GAXMF43TGZHW3QN3REOUA2U5PW5BTARXGGYJ3JIFHW3YT6QRKRL3CPPU"))
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal(1, len(assets))
+		connectedAsset := xdr.MustNewNativeAsset()
+		tt.Assert.True(assets[0].Equals(connectedAsset))
+	}
+
 	var orderbookSummary OrderBookSummary
 	err = q.GetOrderBookSummary(&orderbookSummary, xdr.MustNewNativeAsset(), xdr.MustNewCreditAsset("USD", "GB2QIYT2IAUFMRXKLSLLPRECC6OCOGJMADSPTRK7TGNT2SFR2YGWDARD"), 10)
 	if tt.Assert.NoError(err) {
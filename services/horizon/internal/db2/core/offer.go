@@ -1,6 +1,7 @@
 package core
 
 import (
+	gosql "database/sql"
 	"fmt"
 	"math/big"
 
@@ -24,36 +25,49 @@ func (r Offer) PriceAsString() string {
 // finding.  Given the input asset type, a list of xdr.Assets is returned that
 // each have some available trades for the input asset.
 func (q *Q) ConnectedAssets(dest interface{}, selling xdr.Asset) error {
+	return q.connectedAssets(dest, "selling", "buying", selling)
+}
+
+// ConnectedSellingAssets loads xdr.Asset records for the purposes of strict
+// send path finding.  Given the input asset type, a list of xdr.Assets is
+// returned that each have some available trades buying the input asset.
+func (q *Q) ConnectedSellingAssets(dest interface{}, buying xdr.Asset) error {
+	return q.connectedAssets(dest, "buying", "selling", buying)
+}
+
+// connectedAssets loads the `to` side assets of the offers whose `from` side
+// ("selling" or "buying") is asset.
+func (q *Q) connectedAssets(dest interface{}, from, to string, asset xdr.Asset) error {
 	schemaVersion, err := q.SchemaVersion()
 	if err != nil {
 		return err
 	}
 
 	if schemaVersion < 9 {
-		return q.connectedAssetsSchema8(dest, selling)
+		return q.connectedAssetsSchema8(dest, from, to, asset)
 	} else {
-		return q.connectedAssetsSchema9(dest, selling)
+		return q.connectedAssetsSchema9(dest, from, to, asset)
 	}
 }
 
-func (q *Q) connectedAssetsSchema9(dest interface{}, selling xdr.Asset) error {
+func (q *Q) connectedAssetsSchema9(dest interface{}, from, to string, asset xdr.Asset) error {
 	assets, ok := dest.(*[]xdr.Asset)
 	if !ok {
 		return errors.New("dest is not *[]xdr.Asset")
 	}
 
-	sellingAssetXDRString, err := xdr.MarshalBase64(selling)
+	assetXDRString, err := xdr.MarshalBase64(asset)
 	if err != nil {
-		return errors.Wrap(err, "Error marshaling selling")
+		return errors.Wrap(err, "Error marshaling "+from)
 	}
 
-	sql := sq.Select("buyingasset").
+	sql := sq.Select(to + "asset AS asset").
 		From("offers").
-		Where(sq.Eq{"sellingasset": sellingAssetXDRString}).
-		GroupBy("buyingasset")
+		Where(sq.Eq{from + "asset": assetXDRString}).
+		GroupBy(to + "asset")
 
 	var rows []struct {
-		Asset xdr.Asset `db:"buyingasset"`
+		Asset xdr.Asset `db:"asset"`
 	}
 
 	err = q.Select(&rows, sql)
@@ -72,10 +86,9 @@ func (q *Q) connectedAssetsSchema9(dest interface{}, selling xdr.Asset) error {
 	return nil
 }
 
-// ConnectedAssets loads xdr.Asset records for the purposes of path
-// finding.  Given the input asset type, a list of xdr.Assets is returned that
-// each have some available trades for the input asset.
-func (q *Q) connectedAssetsSchema8(dest interface{}, selling xdr.Asset) error {
+// connectedAssetsSchema8 is connectedAssetsSchema9 for the offers table of
+// schema 8, whose assets are split in type, code and issuer columns.
+func (q *Q) connectedAssetsSchema8(dest interface{}, from, to string, asset xdr.Asset) error {
 	assets, ok := dest.(*[]xdr.Asset)
 	if !ok {
 		return errors.New("dest is not *[]xdr.Asset")
@@ -87,21 +100,21 @@ func (q *Q) connectedAssetsSchema8(dest interface{}, selling xdr.Asset) error {
 		i string
 	)
 
-	err := selling.Extract(&t, &c, &i)
+	err := asset.Extract(&t, &c, &i)
 	if err != nil {
 		return err
 	}
 
 	sql := sq.Select(
-		"buyingassettype AS type",
-		"coalesce(buyingassetcode, '') AS code",
-		"coalesce(buyingissuer, '') AS issuer").
+		to+"assettype AS type",
+		"coalesce("+to+"assetcode, '') AS code",
+		"coalesce("+to+"issuer, '') AS issuer").
 		From("offers").
-		Where(sq.Eq{"sellingassettype": t}).
-		GroupBy("buyingassettype", "buyingassetcode", "buyingissuer")
+		Where(sq.Eq{from + "assettype": t}).
+		GroupBy(to+"assettype", to+"assetcode", to+"issuer")
 
 	if t != xdr.AssetTypeAssetTypeNative {
-		sql = sql.Where(sq.Eq{"sellingassetcode": c, "sellingissuer": i})
+		sql = sql.Where(sq.Eq{from + "assetcode": c, from + "issuer": i})
 	}
 
 	var rows []struct {
@@ -129,21 +142,77 @@ func (q *Q) connectedAssetsSchema8(dest interface{}, selling xdr.Asset) error {
 	return nil
 }
 
+// OffersQuery holds the filters and the page of an offers query. Offers are not
+// filtered by an empty SellerID or a nil asset.
+type OffersQuery struct {
+	SellerID  string
+	Selling   *xdr.Asset
+	Buying    *xdr.Asset
+	PageQuery db2.PageQuery
+}
+
+// OfferByID loads a row from `offers`, by offer id.
+func (q *Q) OfferByID(dest *Offer, id int64) error {
+	schemaVersion, err := q.SchemaVersion()
+	if err != nil {
+		return err
+	}
+
+	sql := sq.Select("co.*").
+		From("offers co").
+		Where("co.offerid = ?", id).
+		Limit(1)
+
+	var offers []Offer
+	err = q.selectOffers(&offers, sql, schemaVersion)
+	if err != nil {
+		return err
+	}
+
+	if len(offers) == 0 {
+		return gosql.ErrNoRows
+	}
+
+	*dest = offers[0]
+	return nil
+}
+
 // OffersByAddress loads a page of active offers for the given
 // address.
 func (q *Q) OffersByAddress(dest interface{}, addy string, pq db2.PageQuery) error {
+	return q.Offers(dest, OffersQuery{SellerID: addy, PageQuery: pq})
+}
+
+// Offers loads a page of active offers matching the filters of query.
+func (q *Q) Offers(dest interface{}, query OffersQuery) error {
 	schemaVersion, err := q.SchemaVersion()
 	if err != nil {
 		return err
 	}
 
-	offers := []internalOffer{}
-
+	pq := query.PageQuery
 	sql := sq.Select("co.*").
 		From("offers co").
-		Where("co.sellerid = ?", addy).
 		Limit(uint64(pq.Limit))
 
+	if query.SellerID != "" {
+		sql = sql.Where("co.sellerid = ?", query.SellerID)
+	}
+
+	if query.Selling != nil {
+		sql, err = filterOffersByAsset(sql, "selling", *query.Selling, schemaVersion)
+		if err != nil {
+			return errors.Wrap(err, "Error filtering by selling asset")
+		}
+	}
+
+	if query.Buying != nil {
+		sql, err = filterOffersByAsset(sql, "buying", *query.Buying, schemaVersion)
+		if err != nil {
+			return errors.Wrap(err, "Error filtering by buying asset")
+		}
+	}
+
 	cursor, err := pq.CursorInt64()
 	if err != nil {
 		return err
@@ -156,11 +225,64 @@ func (q *Q) OffersByAddress(dest interface{}, addy string, pq db2.PageQuery) err
 		sql = sql.Where("co.offerid < ?", cursor).OrderBy("co.offerid desc")
 	}
 
-	err = q.Select(&offers, sql)
+	return q.selectOffers(dest, sql, schemaVersion)
+}
+
+// AllOffers loads all the active offers, ordered by offer id.
+func (q *Q) AllOffers(dest *[]Offer) error {
+	schemaVersion, err := q.SchemaVersion()
 	if err != nil {
 		return err
 	}
 
+	sql := sq.Select("co.*").
+		From("offers co").
+		OrderBy("co.offerid asc")
+
+	return q.selectOffers(dest, sql, schemaVersion)
+}
+
+// filterOffersByAsset restricts sql to the offers whose side ("selling" or
+// "buying") is asset.
+func filterOffersByAsset(sql sq.SelectBuilder, side string, asset xdr.Asset, schemaVersion int) (sq.SelectBuilder, error) {
+	if schemaVersion >= 9 {
+		assetXDRString, err := xdr.MarshalBase64(asset)
+		if err != nil {
+			return sql, err
+		}
+		return sql.Where(sq.Eq{"co." + side + "asset": assetXDRString}), nil
+	}
+
+	var (
+		t xdr.AssetType
+		c string
+		i string
+	)
+
+	err := asset.Extract(&t, &c, &i)
+	if err != nil {
+		return sql, err
+	}
+
+	sql = sql.Where(sq.Eq{"co." + side + "assettype": t})
+	if t != xdr.AssetTypeAssetTypeNative {
+		sql = sql.Where(sq.Eq{
+			"co." + side + "assetcode": c,
+			"co." + side + "issuer":    i,
+		})
+	}
+	return sql, nil
+}
+
+// selectOffers loads the offers selected by sql into dest, converting the
+// assets of schema 8 rows to xdr.Assets.
+func (q *Q) selectOffers(dest interface{}, sql sq.SelectBuilder, schemaVersion int) error {
+	offers := []internalOffer{}
+
+	err := q.Select(&offers, sql)
+	if err != nil {
+		return err
+	}
 	newOffers := make([]Offer, len(offers))
 
 	for i, offer := range offers {
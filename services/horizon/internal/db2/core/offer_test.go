@@ -5,6 +5,7 @@ import (
 
 	"github.com/stellar/go/services/horizon/internal/db2"
 	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/xdr"
 )
 
 func TestOffersByAddress(t *testing.T) {
@@ -69,3 +70,70 @@ func TestOffersByAddress(t *testing.T) {
 		tt.Assert.Equal(int64(2), offers[0].OfferID)
 	}
 }
+
+func TestOfferByID(t *testing.T) {
+	tt := test.Start(t).Scenario("trades")
+	defer tt.Finish()
+	q := &Q{tt.CoreSession()}
+
+	var offer Offer
+	err := q.OfferByID(&offer, 4)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Equal("GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU", offer.SellerID)
+		tt.Assert.Equal(xdr.AssetTypeAssetTypeNative, offer.BuyingAsset.Type)
+	}
+
+	err = q.OfferByID(&offer, 100)
+	tt.Assert.True(q.NoRows(err))
+}
+
+func TestOffers(t *testing.T) {
+	tt := test.Start(t).Scenario("trades")
+	defer tt.Finish()
+	q := &Q{tt.CoreSession()}
+
+	usd, err := AssetFromDB(xdr.AssetTypeAssetTypeCreditAlphanum4, "USD", "GC23QF2HUE52AMXUFUH3AYJAXXGXXV2VHXYYR6EYXETPKDXZSAW67XO4")
+	tt.Require.NoError(err)
+	eur, err := AssetFromDB(xdr.AssetTypeAssetTypeCreditAlphanum4, "EUR", "GCQPYGH4K57XBDENKKX55KDTWOTK5WDWRQOH2LHEDX3EKVIQRLMESGBG")
+	tt.Require.NoError(err)
+	native, err := AssetFromDB(xdr.AssetTypeAssetTypeNative, "", "")
+	tt.Require.NoError(err)
+
+	var offers []Offer
+
+	load := func(query OffersQuery) bool {
+		offers = []Offer{}
+		pq, err := db2.NewPageQuery("", true, "asc", db2.DefaultPageSize)
+		if !tt.Assert.NoError(err) {
+			return false
+		}
+
+		query.PageQuery = pq
+		err = q.Offers(&offers, query)
+		return tt.Assert.NoError(err)
+	}
+
+	// Loads all the offers without filters
+	if load(OffersQuery{}) {
+		tt.Assert.Len(offers, 4)
+	}
+
+	// Filters by assets
+	if load(OffersQuery{Selling: &usd, Buying: &native}) {
+		tt.Assert.Len(offers, 1)
+		tt.Assert.Equal(int64(4), offers[0].OfferID)
+	}
+
+	if load(OffersQuery{Selling: &eur}) {
+		tt.Assert.Len(offers, 3)
+	}
+
+	if load(OffersQuery{Buying: &eur}) {
+		tt.Assert.Len(offers, 0)
+	}
+
+	// Combines the seller and asset filters
+	if load(OffersQuery{SellerID: "GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU", Buying: &usd}) {
+		tt.Assert.Len(offers, 0)
+	}
+}
@@ -25,15 +25,63 @@ func (q *Q) SignersByAddress(dest interface{}, addy string) error {
 		return err
 	}
 
+	signers, err := decodeSigners(addy, signersXDRString)
+	if err != nil {
+		return err
+	}
+
+	*dest.(*[]Signer) = signers
+	return nil
+}
+
+// SignersByAddresses loads the signer rows of all the accounts in `addys`.
+func (q *Q) SignersByAddresses(dest *[]Signer, addys []string) error {
+	schemaVersion, err := q.SchemaVersion()
+	if err != nil {
+		return err
+	}
+
+	if schemaVersion < 9 {
+		sql := selectSigner.Where(sq.Eq{"si.accountid": addys})
+		return q.Select(dest, sql)
+	}
+
+	var rows []struct {
+		Accountid string
+		Signers   *string
+	}
+	sql := sq.Select("a.accountid", "a.signers").
+		From("accounts a").
+		Where(sq.Eq{"a.accountid": addys})
+	err = q.Select(&rows, sql)
+	if err != nil {
+		return err
+	}
+
+	signers := []Signer{}
+	for _, row := range rows {
+		accountSigners, err := decodeSigners(row.Accountid, row.Signers)
+		if err != nil {
+			return err
+		}
+		signers = append(signers, accountSigners...)
+	}
+
+	*dest = signers
+	return nil
+}
+
+// decodeSigners decodes the signers of the account `addy`, stored as base64
+// encoded XDR in the `accounts` table since schema version 9.
+func decodeSigners(addy string, signersXDRString *string) ([]Signer, error) {
 	if signersXDRString == nil {
-		*dest.(*[]Signer) = []Signer{}
-		return nil
+		return []Signer{}, nil
 	}
 
 	var signersXDR []xdr.Signer
-	err = xdr.SafeUnmarshalBase64(*signersXDRString, &signersXDR)
+	err := xdr.SafeUnmarshalBase64(*signersXDRString, &signersXDR)
 	if err != nil {
-		return errors.Wrap(err, "Error decoding []xdr.Signer")
+		return nil, errors.Wrap(err, "Error decoding []xdr.Signer")
 	}
 
 	signers := make([]Signer, 0, len(signersXDR))
@@ -44,9 +92,7 @@ func (q *Q) SignersByAddress(dest interface{}, addy string) error {
 			Weight:    int32(signer.Weight),
 		})
 	}
-
-	*dest.(*[]Signer) = signers
-	return nil
+	return signers, nil
 }
 
 var selectSigner = sq.Select(
@@ -79,6 +79,12 @@ func (q *Q) TrustlinesByAddress(dest interface{}, addy string) error {
 	return q.Select(dest, sql)
 }
 
+// TrustlinesByAddresses loads the trustlines of all the accounts in `addys`
+func (q *Q) TrustlinesByAddresses(dest *[]Trustline, addys []string) error {
+	sql := selectTrustline.Where(sq.Eq{"tl.accountid": addys})
+	return q.Select(dest, sql)
+}
+
 // BalancesForAsset returns all the balances by asset type, code, issuer
 func (q *Q) BalancesForAsset(
 	assetType int32,
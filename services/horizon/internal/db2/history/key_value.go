@@ -0,0 +1,59 @@
+package history
+
+import (
+	"strconv"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stellar/go/support/errors"
+)
+
+const lastLedgerStateKey = "last_ledger_state"
+
+// LastLedgerStateSequence loads the sequence of the ledger the ledger state
+// tables are up to date with. It returns 0 when the ledger state was never
+// ingested.
+func (q *Q) LastLedgerStateSequence() (uint32, error) {
+	value, err := q.getValueFromStore(lastLedgerStateKey)
+	if err != nil {
+		return 0, err
+	}
+
+	if value == "" {
+		return 0, nil
+	}
+
+	sequence, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, errors.Wrap(err, "invalid last ledger state sequence")
+	}
+	return uint32(sequence), nil
+}
+
+// UpdateLastLedgerStateSequence stores the sequence of the ledger the ledger
+// state tables are up to date with.
+func (q *Q) UpdateLastLedgerStateSequence(sequence uint32) error {
+	return q.updateValueInStore(lastLedgerStateKey, strconv.FormatUint(uint64(sequence), 10))
+}
+
+// getValueFromStore returns the value stored under key in the
+// `key_value_store` table, or an empty string when there is none.
+func (q *Q) getValueFromStore(key string) (string, error) {
+	var values []string
+	sql := sq.Select("kv.value").From("key_value_store kv").Where("kv.key = ?", key)
+	err := q.Select(&values, sql)
+	if err != nil || len(values) == 0 {
+		return "", err
+	}
+	return values[0], nil
+}
+
+// updateValueInStore stores value under key in the `key_value_store` table.
+func (q *Q) updateValueInStore(key, value string) error {
+	_, err := q.Exec(sq.Delete("key_value_store").Where("key = ?", key))
+	if err != nil {
+		return err
+	}
+
+	_, err = q.Exec(sq.Insert("key_value_store").Columns("key", "value").Values(key, value))
+	return err
+}
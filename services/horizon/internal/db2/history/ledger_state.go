@@ -0,0 +1,321 @@
+package history
+
+import (
+	"encoding/base64"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// ledgerStateTables are the tables holding horizon's copy of the ledger state.
+var ledgerStateTables = []string{
+	"accounts",
+	"accounts_data",
+	"accounts_signers",
+	"trust_lines",
+	"offers",
+}
+
+// maxLedgerEntryBatchParams is the number of query parameters a
+// LedgerEntryBatch inserts at once. PostgreSQL supports up to 65535 parameters
+// per query.
+const maxLedgerEntryBatchParams = 65000
+
+// ledgerStateColumns are the columns of the ledger state tables, in the order
+// of the values of their rows.
+var ledgerStateColumns = map[string][]string{
+	"accounts": {
+		"account_id",
+		"balance",
+		"buying_liabilities",
+		"selling_liabilities",
+		"sequence_number",
+		"num_subentries",
+		"inflation_destination",
+		"flags",
+		"home_domain",
+		"master_weight",
+		"threshold_low",
+		"threshold_medium",
+		"threshold_high",
+		"last_modified_ledger",
+	},
+	"accounts_data":    {"account_id", "name", "value", "last_modified_ledger"},
+	"accounts_signers": {"account_id", "signer", "weight"},
+	"trust_lines": {
+		"account_id",
+		"asset_type",
+		"asset_issuer",
+		"asset_code",
+		"balance",
+		"trust_line_limit",
+		"buying_liabilities",
+		"selling_liabilities",
+		"flags",
+		"last_modified_ledger",
+	},
+	"offers": {
+		"seller_id",
+		"offer_id",
+		"selling_asset",
+		"buying_asset",
+		"amount",
+		"pricen",
+		"priced",
+		"price",
+		"flags",
+		"last_modified_ledger",
+	},
+}
+
+// LedgerEntryBatch inserts ledger entries into the ledger state tables with
+// multi-row INSERT statements.
+type LedgerEntryBatch struct {
+	q    *Q
+	rows map[string][][]interface{}
+}
+
+// NewLedgerEntryBatch returns an empty batch inserting its entries with q.
+func (q *Q) NewLedgerEntryBatch() *LedgerEntryBatch {
+	return &LedgerEntryBatch{q: q, rows: map[string][][]interface{}{}}
+}
+
+// Add queues the rows of entry. The queued rows of a table are inserted once
+// they reach the maximum number of parameters of a query.
+func (b *LedgerEntryBatch) Add(entry xdr.LedgerEntry) error {
+	switch entry.Data.Type {
+	case xdr.LedgerEntryTypeAccount:
+		return b.addAccount(entry.Data.MustAccount(), entry.LastModifiedLedgerSeq)
+	case xdr.LedgerEntryTypeTrustline:
+		return b.addTrustLine(entry.Data.MustTrustLine(), entry.LastModifiedLedgerSeq)
+	case xdr.LedgerEntryTypeOffer:
+		return b.addOffer(entry.Data.MustOffer(), entry.LastModifiedLedgerSeq)
+	case xdr.LedgerEntryTypeData:
+		return b.addAccountData(entry.Data.MustData(), entry.LastModifiedLedgerSeq)
+	default:
+		return errors.Errorf("unknown ledger entry type %d", entry.Data.Type)
+	}
+}
+
+// Exec inserts all the queued rows.
+func (b *LedgerEntryBatch) Exec() error {
+	for _, table := range ledgerStateTables {
+		err := b.flush(table)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *LedgerEntryBatch) add(table string, values ...interface{}) error {
+	b.rows[table] = append(b.rows[table], values)
+	if len(b.rows[table])*len(ledgerStateColumns[table]) < maxLedgerEntryBatchParams {
+		return nil
+	}
+	return b.flush(table)
+}
+
+func (b *LedgerEntryBatch) flush(table string) error {
+	rows := b.rows[table]
+	if len(rows) == 0 {
+		return nil
+	}
+
+	sql := sq.Insert(table).Columns(ledgerStateColumns[table]...)
+	for _, row := range rows {
+		sql = sql.Values(row...)
+	}
+
+	_, err := b.q.Exec(sql)
+	if err != nil {
+		return errors.Wrapf(err, "failed to insert into %s", table)
+	}
+
+	b.rows[table] = nil
+	return nil
+}
+
+// InsertLedgerEntry writes entry to the ledger state table of its type.
+func (q *Q) InsertLedgerEntry(entry xdr.LedgerEntry) error {
+	batch := q.NewLedgerEntryBatch()
+	err := batch.Add(entry)
+	if err != nil {
+		return err
+	}
+	return batch.Exec()
+}
+
+// UpsertLedgerEntry writes entry to the ledger state table of its type,
+// replacing the existing row of the same ledger key, if any.
+func (q *Q) UpsertLedgerEntry(entry xdr.LedgerEntry) error {
+	err := q.RemoveLedgerEntry(entry.LedgerKey())
+	if err != nil {
+		return err
+	}
+	return q.InsertLedgerEntry(entry)
+}
+
+// RemoveLedgerEntry deletes the rows of the ledger entry identified by key
+// from the ledger state tables. Removing an account also removes its signers.
+func (q *Q) RemoveLedgerEntry(key xdr.LedgerKey) error {
+	var sqls []sq.DeleteBuilder
+
+	switch key.Type {
+	case xdr.LedgerEntryTypeAccount:
+		account := key.MustAccount()
+		address := account.AccountId.Address()
+		sqls = append(sqls,
+			sq.Delete("accounts").Where("account_id = ?", address),
+			sq.Delete("accounts_signers").Where("account_id = ?", address),
+		)
+	case xdr.LedgerEntryTypeTrustline:
+		trustLine := key.MustTrustLine()
+		var assetType xdr.AssetType
+		var code, issuer string
+		err := trustLine.Asset.Extract(&assetType, &code, &issuer)
+		if err != nil {
+			return errors.Wrap(err, "failed to extract trust line asset")
+		}
+		sqls = append(sqls, sq.Delete("trust_lines").Where(sq.Eq{
+			"account_id":   trustLine.AccountId.Address(),
+			"asset_type":   assetType,
+			"asset_code":   code,
+			"asset_issuer": issuer,
+		}))
+	case xdr.LedgerEntryTypeOffer:
+		sqls = append(sqls, sq.Delete("offers").Where("offer_id = ?", int64(key.MustOffer().OfferId)))
+	case xdr.LedgerEntryTypeData:
+		data := key.MustData()
+		sqls = append(sqls, sq.Delete("accounts_data").Where(sq.Eq{
+			"account_id": data.AccountId.Address(),
+			"name":       string(data.DataName),
+		}))
+	default:
+		return errors.Errorf("unknown ledger entry type %d", key.Type)
+	}
+
+	for _, sql := range sqls {
+		_, err := q.Exec(sql)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TruncateLedgerState removes all the rows of the ledger state tables.
+func (q *Q) TruncateLedgerState() error {
+	for _, table := range ledgerStateTables {
+		_, err := q.ExecRaw("TRUNCATE TABLE " + table)
+		if err != nil {
+			return errors.Wrapf(err, "failed to truncate %s", table)
+		}
+	}
+	return nil
+}
+
+func (b *LedgerEntryBatch) addAccount(account xdr.AccountEntry, lastModifiedLedger xdr.Uint32) error {
+	var inflationDestination string
+	if account.InflationDest != nil {
+		inflationDestination = account.InflationDest.Address()
+	}
+
+	var buyingLiabilities, sellingLiabilities xdr.Int64
+	if v1, ok := account.Ext.GetV1(); ok {
+		buyingLiabilities = v1.Liabilities.Buying
+		sellingLiabilities = v1.Liabilities.Selling
+	}
+
+	address := account.AccountId.Address()
+	err := b.add("accounts",
+		address,
+		int64(account.Balance),
+		int64(buyingLiabilities),
+		int64(sellingLiabilities),
+		int64(account.SeqNum),
+		uint32(account.NumSubEntries),
+		inflationDestination,
+		uint32(account.Flags),
+		string(account.HomeDomain),
+		account.Thresholds[0],
+		account.Thresholds[1],
+		account.Thresholds[2],
+		account.Thresholds[3],
+		uint32(lastModifiedLedger),
+	)
+	if err != nil {
+		return err
+	}
+
+	for signer, weight := range account.SignerSummary() {
+		err = b.add("accounts_signers", address, signer, weight)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *LedgerEntryBatch) addTrustLine(trustLine xdr.TrustLineEntry, lastModifiedLedger xdr.Uint32) error {
+	var assetType xdr.AssetType
+	var code, issuer string
+	err := trustLine.Asset.Extract(&assetType, &code, &issuer)
+	if err != nil {
+		return errors.Wrap(err, "failed to extract trust line asset")
+	}
+
+	var buyingLiabilities, sellingLiabilities xdr.Int64
+	if v1, ok := trustLine.Ext.GetV1(); ok {
+		buyingLiabilities = v1.Liabilities.Buying
+		sellingLiabilities = v1.Liabilities.Selling
+	}
+
+	return b.add("trust_lines",
+		trustLine.AccountId.Address(),
+		assetType,
+		issuer,
+		code,
+		int64(trustLine.Balance),
+		int64(trustLine.Limit),
+		int64(buyingLiabilities),
+		int64(sellingLiabilities),
+		uint32(trustLine.Flags),
+		uint32(lastModifiedLedger),
+	)
+}
+
+func (b *LedgerEntryBatch) addOffer(offer xdr.OfferEntry, lastModifiedLedger xdr.Uint32) error {
+	selling, err := xdr.MarshalBase64(offer.Selling)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode selling asset")
+	}
+
+	buying, err := xdr.MarshalBase64(offer.Buying)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode buying asset")
+	}
+
+	return b.add("offers",
+		offer.SellerId.Address(),
+		int64(offer.OfferId),
+		selling,
+		buying,
+		int64(offer.Amount),
+		int32(offer.Price.N),
+		int32(offer.Price.D),
+		float64(offer.Price.N)/float64(offer.Price.D),
+		uint32(offer.Flags),
+		uint32(lastModifiedLedger),
+	)
+}
+
+func (b *LedgerEntryBatch) addAccountData(data xdr.DataEntry, lastModifiedLedger xdr.Uint32) error {
+	return b.add("accounts_data",
+		data.AccountId.Address(),
+		string(data.DataName),
+		base64.StdEncoding.EncodeToString(data.DataValue),
+		uint32(lastModifiedLedger),
+	)
+}
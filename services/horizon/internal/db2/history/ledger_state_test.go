@@ -0,0 +1,43 @@
+package history
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/network"
+	"github.com/stellar/go/services/horizon/internal/db2/schema"
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/xdr"
+)
+
+func TestInsertLedgerEntryAccount(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("base")
+	defer tt.Finish()
+
+	_, err := schema.Migrate(tt.HorizonDB.DB, schema.MigrateUp, 0)
+	tt.Require.NoError(err)
+	q := &Q{tt.HorizonSession()}
+
+	// home domains can be up to 32 bytes long
+	homeDomain := strings.Repeat("a", 32)
+	address := keypair.Master(network.TestNetworkPassphrase).Address()
+	var accountID xdr.AccountId
+	tt.Require.NoError(accountID.SetAddress(address))
+
+	var entry xdr.LedgerEntry
+	entry.LastModifiedLedgerSeq = 2
+	entry.Data.Type = xdr.LedgerEntryTypeAccount
+	entry.Data.Account = &xdr.AccountEntry{
+		AccountId:  accountID,
+		Balance:    100000000,
+		HomeDomain: xdr.String32(homeDomain),
+		Thresholds: xdr.Thresholds{1, 0, 0, 0},
+	}
+	tt.Require.NoError(q.InsertLedgerEntry(entry))
+
+	var actual string
+	err = q.GetRaw(&actual, "SELECT home_domain FROM accounts WHERE account_id = ?", address)
+	tt.Require.NoError(err)
+	tt.Assert.Equal(homeDomain, actual)
+}
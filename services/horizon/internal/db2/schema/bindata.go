@@ -8,6 +8,7 @@
 // migrations/14_fix_asset_toml_field.sql
 // migrations/15_ledger_failed_txs.sql
 // migrations/16_ingest_failed_transactions.sql
+// migrations/17_add_ledger_state_tables.sql
 // migrations/1_initial_schema.sql
 // migrations/2_index_participants_by_toid.sql
 // migrations/3_use_sequence_in_history_accounts.sql
@@ -85,7 +86,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _latestSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5c\x6d\x6f\xdb\x38\x12\xfe\x9e\x5f\x41\x2c\x0a\xc4\xc6\x39\x3d\xcb\x89\xd3\xbc\x6c\x0b\x78\x1d\x35\x35\xd6\x55\xba\xb6\x7c\xdd\xa2\x28\x08\x5a\xa2\x6d\x5e\x65\x51\x15\xe9\x6e\xb2\x87\xfb\xef\x07\xbd\x5a\x2f\xa4\x28\xd9\x4a\x7b\xdf\x62\x6b\xfc\xcc\x33\xc3\x19\x0e\x39\xa4\x72\x76\x76\x72\x76\x06\x3e\x50\xc6\xd7\x3e\x9e\xff\x31\x05\x36\xe2\x68\x89\x18\x06\xf6\x6e\xeb\x9d\x9c\x9d\x9d\x04\xcf\xef\x76\x5b\x0f\xdb\x60\xe5\xd3\xed\x5e\xe0\x3b\xf6\x19\xa1\x2e\xb8\x7e\x79\xf9\x52\xcb\x48\x2d\x9f\x80\xb7\x86\xc1\xcf\x0b\x22\x27\x73\xdd\x04\x8c\x23\x8e\xb7\xd8\xe5\x90\x93\x2d\xa6\x3b\x0e\x5e\x83\xfe\x6d\xf8\xc8\xa1\xd6\xd7\xf2\xb7\x96\x43\x02\x69\xec\x5a\xd4\x26\xee\x1a\xbc\x06\xa7\x0b\xf3\xed\xd5\xe9\x6d\x02\xe7\xda\xc8\xb7\xa1\x45\xdd\x15\xf5\xb7\xc4\x5d\x43\xc6\x7d\xe2\xae\x19\x78\x0d\xa8\x1b\x63\x6c\xb0\xf5\x15\xae\x76\xae\xc5\x09\x75\xe1\x92\xda\x04\x07\xcf\x57\xc8\x61\x38\xa7\x66\x4b\x5c\xb8\xc5\x8c\xa1\x75\x28\xf0\x17\xf2\x5d\xe2\xae\x6f\x63\xee\x18\xf9\xd6\x06\x7a\x88\x6f\xc0\x6b\xe0\xed\x96\x0e\xb1\x7a\x81\xb1\x16\xe2\xc8\xa1\x81\x58\xe4\x4f\x03\x6d\xf1\x0d\x58\x11\x9f\x71\x88\xd6\xeb\x0e\x72\x9f\xb0\x13\x5a\xdd\x03\xfb\xbf\xbb\xb7\xc0\x7c\xf2\xf0\x0d\x78\xbb\x30\xc6\xe6\xe4\xc1\xb8\x05\x73\x6b\x83\xb7\xe8\x26\xc6\xbe\x05\x0f\x7f\xb9\xd8\xbf\x01\x67\xe1\x40\x8c\x67\xfa\xc8\xd4\x53\x69\x35\x3e\x98\xe9\xe6\x62\x66\xcc\x33\xdf\x9d\x00\x00\xc0\x74\x64\xdc\x2f\x46\xf7\x3a\x60\xdf\x1c\x30\x79\xff\x7e\x61\x8e\x7e\x9b\xea\x60\x6e\xce\x26\x63\x33\x94\x18\xcd\xc1\x0b\xf8\x02\xcc\xf5\xa9\x3e\x36\xc1\x0b\x2d\xf8\x74\x7b\x92\x37\xcf\x41\xcf\x6a\x9d\x0a\xbe\x35\xe3\x06\x22\xe3\xb6\xe8\x11\x7a\x3e\xb1\x70\x48\xc1\xdd\x6d\xb1\x4f\xac\xcf\x5f\x7a\x20\xfd\xf3\x58\xfb\x6a\x68\x48\x4d\x4c\xbf\x3a\xc8\xc2\xce\x09\x00\xe3\xd1\x5c\x07\x1f\xdf\xe9\x06\x78\xa1\x7d\xd6\xbe\xfc\xf3\x85\xf6\x79\xf0\xe5\xcd\x8b\x41\xf8\xf7\xe0\xf3\xe0\x0b\x30\xa3\x87\x40\x9f\xce\xf5\xc0\x29\xba\x71\xd7\x15\x7a\x86\xb8\xcf\xed\x19\xb5\x86\xe7\xf6\xcc\xaf\x87\x78\x26\xcc\xc7\x8e\x20\x03\x46\xf7\xf7\x33\xfd\x7e\x64\xea\xf5\x1c\x91\x8a\x97\x11\x43\xc6\x00\xcc\x03\x5f\x05\xf3\x57\x32\x03\xf4\xa2\xaf\xcd\x4f\x1f\x74\xf0\x3a\x9b\x11\x5d\x51\xd6\xb6\xca\xb1\x08\x58\xa0\x98\xa4\x71\x7d\x86\x69\x62\x74\xca\x11\x75\x30\x4b\x11\x68\x81\x69\x2e\x21\xf3\x74\xf7\x51\x56\x66\x9b\x04\x6b\xab\x6c\x05\xa0\x45\xb6\xd9\x24\xa9\x64\x1b\x54\x2e\x1b\xaf\xd0\xce\xe1\x90\xa3\xa5\x83\x99\x87\x2c\x1c\xd4\xd1\xd3\xdb\xfc\xd3\xbf\x08\xdf\x40\x4a\xec\x4c\x69\xcc\xd9\x8a\x18\xc3\x1c\x06\x15\x9c\x25\x26\x86\x09\x56\xcf\xbc\x28\x17\x33\x18\xb1\x45\xc4\x06\x4b\xb2\x26\x2e\x07\xc6\x83\x09\x8c\xc5\x74\x1a\x99\x83\xb6\x74\xe7\x72\x60\x6d\x90\x8f\x2c\x8e\x7d\xf0\x1d\xf9\x4f\xc1\x0a\x20\x2f\xe6\xee\xb6\x10\x59\x56\x20\xcb\x00\x71\x39\x5e\x63\xbf\x20\xb2\x72\xd0\x9a\x01\xb6\x45\x8e\x53\x56\xc3\xe9\xd6\x29\x2b\xe9\x0c\x86\xc3\x6e\x2a\x59\x1e\xf6\x35\xf5\x3d\xb8\x25\x6b\x1f\x05\x2b\x8a\xc3\xdd\x51\xc0\xd9\xbb\x84\xe3\xc7\x92\x43\x3c\xcf\x21\xd8\x86\x88\x83\x60\x95\xc4\x38\xda\x7a\x20\x18\xb3\xf0\x23\xf8\x9b\xba\xb8\x4c\x74\x43\x18\xa7\xfe\x53\xea\x22\x48\x6c\xc8\xf0\xb7\x84\xf0\x5c\xff\x63\xa1\x1b\xe3\x9a\x9c\x13\x69\x19\x6a\x1c\x86\xa3\x99\x09\x3e\x4e\xcc\x77\x40\x0b\xbf\x98\x18\xe3\x99\xfe\x5e\x37\x4c\xf0\xdb\xa7\xf8\x2b\xe3\x01\xbc\x9f\x18\xff\x1a\x4d\x17\x7a\xfa\x79\xf4\xe7\xfe\xf3\x78\x34\x7e\xa7\x03\x4d\x65\xcc\xc1\x6e\x2f\x02\x95\x42\xf1\x4e\x7f\x3b\x5a\x4c\x4d\xe0\xe2\x47\xfe\x1d\x39\x9d\x53\x89\xc5\xa7\x37\x37\x3e\x5e\x5b\x0e\x62\xac\x5b\x1c\x2e\xdb\xf6\x31\x63\x82\xd8\xba\xbc\xe8\x56\x0c\x54\x90\x20\x2d\x58\x16\xc2\xec\xed\x12\x67\x46\x94\x8d\xfc\xc9\xc3\x62\x9a\x42\x71\x8b\xda\x22\x71\x6d\x20\x16\x27\x8c\xed\xb0\x2f\xf8\xc1\xf0\xb2\x2a\xc3\xf2\x86\xb4\x1c\xb6\x59\xcc\x1f\x16\xb4\x55\x86\x80\x87\x8f\x86\x7e\x07\x7e\xfb\xa4\xb0\x68\x34\x35\xf5\x99\xc2\xa0\x14\xab\xf0\xf8\x25\xb1\x65\xdc\xf0\x6a\x85\xad\x16\xa2\x2e\xc6\x89\xc3\xae\x90\x33\x50\x36\xd3\x27\x72\xd4\xc3\xd1\x3c\x28\x95\xfc\x85\xfa\x36\xf6\x7f\x91\x44\x73\x18\xc7\xe2\x47\x36\xe6\x88\x38\x0c\xfc\x9b\x51\x77\x29\x0f\x36\x07\xdb\x6b\xec\x1f\xef\x87\x18\x27\xf6\x03\xc3\xdf\x76\xd8\xb5\x64\xdc\x22\x61\xb8\x41\x6c\x53\x2b\x0b\x3d\x1f\x7f\x27\x74\xc7\xa0\xf2\x87\xb1\x5b\x7c\xe4\x32\x14\x6d\x7d\xc3\x81\x48\x79\x24\xb3\x5c\xbf\xa0\x61\x3f\x10\xf5\xe4\x2d\x87\x32\x51\x61\x0a\x36\xf2\x69\x6d\x2a\xfe\xc6\xc7\x88\x2b\x7f\x14\xc9\xee\x3c\xbb\xb6\x6c\x1a\x3a\xf1\xc7\xad\x47\x7d\x8e\x7d\x98\xf4\x22\x8a\xb6\x68\xa5\xf5\x00\x47\x0e\xb4\x28\x71\x99\x38\x06\x57\x18\x43\x8f\x52\x47\xfc\x74\x89\x18\x86\x2b\x2c\x1b\xeb\xf0\xb1\x8f\x19\xf6\xbf\xcb\x44\x82\x75\x28\x7f\x84\xe1\x32\x89\xfc\x2d\x93\xf2\x7c\xca\xa9\x45\x1d\xa9\x5d\xc5\x31\x4a\x82\x05\x23\x1b\xfb\xe1\xf2\x22\xfa\x9e\xed\x2c\x0b\x33\xb6\xda\x39\x50\x1a\x28\xb1\xe1\x88\x38\xd8\x96\x4b\xc9\xd3\x6a\x1f\x4f\x1e\xf2\x39\xb1\x88\x87\xda\xa8\xde\x62\x58\x55\xcd\xab\x3f\xdb\xa8\xe7\xaf\xa6\x26\xb7\x5b\xc6\x2a\x75\xfc\xa8\xb2\xd6\xc8\xd0\x23\xcb\x5c\xa5\xae\x72\xd9\x13\x8b\x57\x94\xc1\xf4\x07\x2d\xc6\xa6\x6a\x9b\x93\x4d\x27\xe9\x56\x28\x58\xf9\x5b\x91\x29\x61\x05\x3c\xb2\x00\xc6\x99\x4f\x77\x7e\xb0\x7f\x8c\xa2\x5b\x52\x7a\x92\xe9\xe4\xf4\xf4\xe6\x46\xbe\x15\x93\xe7\x01\xf7\x91\x8d\x8f\x77\x67\x04\x53\x58\x57\x1c\xbb\x5e\x88\xa7\xc4\x43\xaa\x17\x5d\xad\xb0\x2f\x55\x1b\xce\xf2\xaa\x55\x4f\x24\x14\x2d\x91\x2b\x45\xa2\x7d\xb0\x50\x20\xd4\x80\x7d\xa5\xae\x54\xae\x52\x5d\x2a\x55\xa1\x31\xa4\x44\x18\x64\xd8\x71\xb0\x0f\x96\x94\x3a\x18\xb9\x49\x4d\x22\x16\x86\x6e\xae\xfe\x46\xdf\xe5\x6b\x72\x88\x51\xf0\x60\x9e\x81\xf0\xe1\xf8\xc1\x98\x9b\xb3\xd1\xc4\x30\x0b\x61\x01\x33\x7e\x82\x61\xaf\x1f\x8c\xdf\xe9\xe3\xdf\x41\xa7\x93\xf5\xe0\x1b\xd0\xef\x76\x55\x50\xa2\x9f\x27\x4e\xfb\xb5\xe4\xc7\x1a\x78\x39\x9f\x16\xe0\x0b\x0e\x0f\x09\x56\xa6\x52\x3a\x53\xb4\x5a\x47\x65\xc0\x75\x2b\x69\x9d\x29\xec\x98\x5a\x2a\xe3\xd7\x6e\x35\x55\x68\xf9\x51\xf5\xb4\xa1\xb1\x47\x56\x54\x85\xb6\x72\x4d\x95\xfd\xa0\xa2\xaa\x66\x7e\xd2\x6a\xac\x26\xf1\x99\xa5\x54\x7b\x13\x15\xcf\xfd\x8a\xad\x59\xdd\xc2\x5b\x5d\x43\x85\xb2\x7b\xd5\xf2\x5d\x06\x92\xa6\x9e\x6c\x87\xf6\x53\xf6\x58\xfc\x11\x62\xf7\x3b\x76\xa8\x87\x45\x7d\x4b\xfe\x18\xec\x78\x76\x0e\x97\x3c\xdc\x62\x8e\x24\x8f\x02\x2f\xc8\x1e\x33\xb2\x76\x11\xdf\xf9\x58\xd4\x62\xbb\xbe\xec\x7e\xfe\xb2\x5f\xbc\xfc\xe7\xbf\xa2\xe5\xcb\xe7\x2f\xc5\xad\x17\xde\x52\x49\x37\x6c\x8f\xe5\x52\x17\x57\x2e\x86\xf6\x58\x65\x98\xd8\x32\xb2\xc5\x70\x49\x77\xae\x1d\xb6\xac\xaf\x7c\xe4\xae\x71\x71\x3b\x96\xd4\x56\x55\x6b\x0c\x10\x3b\xc9\xaa\x98\x63\xad\xa9\x20\x4a\xab\x07\x63\x5a\x6c\x13\x81\xe8\xf9\xf8\x61\xba\x78\x6f\x04\x43\x3d\xd7\xcd\x8a\x7e\x68\xb6\xf3\x94\xed\x86\x36\xdb\x2f\xb4\x67\x84\x04\xbf\x91\x51\x95\xfb\x8c\x3a\x46\x4a\x2b\x6a\x6b\x66\x4a\x35\x34\x32\x54\x31\xfd\x8b\x4d\xbd\x43\x1c\x81\x15\xf5\x15\xa7\x42\xe0\x6e\x64\x8e\x14\xe6\x49\x20\xab\x4e\x57\xea\xc0\x4e\x8c\xb9\x3e\x33\xc1\xc4\x30\x1f\x4a\x27\x2c\x61\x21\x9e\x83\xce\xa9\x06\x89\x4b\x38\x41\x0e\x64\x21\xd6\x4b\xf6\xcd\x39\xed\x81\xd3\x41\x5f\xbb\x3e\xeb\x0f\xce\x06\x1a\xd0\xce\x6f\x86\x17\x37\xe7\x17\x2f\xfb\xe7\x83\xfe\xe0\xea\x1f\x7d\xed\xb4\x7b\x5b\x0f\x7d\x00\x89\x6b\xe3\xc7\xbc\x57\x97\x4f\x90\x53\x62\x57\x6a\xba\xb8\xbc\xd6\x2e\x9b\x68\x3a\x87\x3b\x86\xd3\x6a\x02\x89\x0b\x8b\x67\x15\x95\xfa\x86\xd7\x97\xaf\x06\x4d\xf4\x5d\x40\x64\xdb\xb0\xd8\x7f\xaa\xd4\xf1\xaa\x3f\xbc\xd2\x9a\xe8\x18\xc2\xa8\x74\x25\xab\xe8\xf0\xdc\xb2\x52\xc5\x95\x76\x31\x6c\xa2\xe1\x32\xd1\x10\x4f\x60\x35\x34\x5c\xf7\xaf\x1a\xa9\x78\x05\xb7\xd4\x26\xab\xa7\xda\x46\x68\xfd\x61\xbf\x51\x90\x5d\xe5\x8c\x88\x72\xb0\x86\x1a\x6d\x38\x7c\x75\xde\x4c\x4f\x30\xe4\x68\xbd\xf6\xf1\x1a\x71\xea\x57\x46\x94\x36\xb8\xb8\x3e\xbf\x68\x02\x7f\x1d\xc2\x47\x9d\x49\xf8\x68\xfb\xd5\xe8\x57\xfd\xeb\x26\xe0\x5a\x3f\x44\x8f\xc7\x20\xdc\x8e\x56\xe2\x9f\x6b\x83\xeb\x66\x0a\xb4\xac\x82\x74\x7f\x13\x64\x7f\xb5\xa2\x8b\xeb\x66\xa3\xa0\x0d\x72\xe3\x1c\xef\x28\xa3\xdb\x6e\x95\x9a\x2e\x86\xfd\x7e\xa3\x01\xd1\xce\x23\x73\xd2\x7d\x78\xf5\x80\x0f\xfb\xda\x55\x33\x97\x5d\xc0\x15\x79\x8c\xad\xe1\x74\xeb\xc0\x15\xc1\x4e\xe5\xbc\xa8\x0d\xb5\x57\xfd\x57\x8d\x94\x0c\x93\x03\x92\xa4\x71\xfd\xa8\x30\xe3\x42\x1b\x34\x33\xe3\x12\x12\x77\x8d\x19\x87\xe5\xd6\xb8\x42\xd5\xf0\xf2\x32\x19\x7b\x49\x0d\xac\x3c\xeb\x6e\x52\x5b\x1b\xdd\x03\x08\x96\x0b\x0a\xdc\xf8\xee\xd4\xfe\xda\xe3\x4b\x86\xab\xcf\xc8\x7b\x40\xeb\x45\x17\x4a\x6a\x98\x5b\x3e\xfe\x3e\xc2\xd8\xca\x23\xd7\x56\x4c\xcd\x2d\x7f\x9b\x18\x2a\x3a\x72\x3d\x62\xc9\x54\x75\x82\xd9\x02\x6c\x8d\x13\x9c\xc3\x87\xa9\xd9\x11\x42\x1b\xc3\x56\xbd\xc0\x6f\x32\x8c\x92\x23\x83\x16\x5c\x2e\xe8\x9c\xb7\x83\xaa\x6e\x22\x1e\x3e\x94\x4d\xbb\x57\x6d\x0c\xa6\x6a\x13\xd3\x64\x38\xa5\xbd\xaa\xe6\x2e\xc9\xde\x74\xcb\xd6\x6d\xef\x2b\x7e\x4a\xa0\xf7\x7d\xe3\xa6\xfb\xc0\x0c\x62\x74\xb1\xf5\xee\x2e\xdb\x85\x2e\x2a\x04\x1f\x66\x93\xf7\xa3\xd9\x27\xf0\xbb\xfe\x09\x74\x88\xad\xba\xd0\x56\xfc\xdc\x12\xeb\x02\xaa\x88\xb9\x48\xb1\x92\x7d\xa1\x83\x51\x98\x9d\xf7\xd7\x96\xe0\xfe\xc2\x13\xcc\xde\x4e\x82\xad\x58\x97\x57\x2b\x32\xee\x20\x62\x60\x61\x4c\xfe\x58\xe8\xa0\xb3\x17\xef\x65\x6e\x6e\xf5\x72\xf7\xac\x1a\xba\xa6\x9d\x61\x6d\x6c\x78\xa3\x41\x95\x74\x74\x14\x73\x79\xbb\x96\x89\x95\x54\x59\x5a\x41\xab\xb6\xe5\xd2\x26\x8f\x72\xea\x6b\xd7\x7a\x99\x9a\x2a\xfb\x2b\xa9\x29\x3d\x10\x85\xf4\xf2\x29\x8c\xf6\xc4\x90\x89\x71\xa7\xff\x59\xef\xd0\x20\x14\xcd\xa3\x80\x07\xa3\x98\x0c\x8b\xf9\xc4\xb8\x07\x4b\xee\x63\x9c\xcd\x2e\x39\x9b\x28\xc7\x8e\xe7\x13\xdf\x89\xac\xc5\x48\x92\xd7\xcb\x74\x9d\x7d\x30\x9d\x3d\x44\x96\x49\xee\x84\x25\xcf\x27\x12\xee\x95\x8e\x30\x44\xe4\x36\x88\x6d\x8e\x61\x16\x9e\xe4\xd4\xa2\x55\x3c\xff\x11\xb1\x89\x96\xc5\xc7\xf0\x89\x10\xea\x31\x2a\x1c\x2e\xf5\xca\xe7\x48\xc2\x94\x87\x38\x88\x8d\xf0\xf9\x01\x4c\xe3\x2a\x11\x11\x2e\xc0\x65\x69\x27\x77\x34\x73\x8c\x45\x57\x2a\x7a\xc9\xf5\x09\x19\xd9\x7d\x33\xfb\x48\x9a\xc4\xae\x4d\x70\x7f\x7e\xdc\x13\xde\x03\x51\x90\xa6\x1e\xf4\xda\xe2\x1d\x63\x65\xa9\x4b\x4a\xd5\x41\x96\x88\x0d\xe0\x8f\xed\x19\x10\x63\x49\x62\xfa\x40\x13\xf2\x97\x01\xca\x46\x50\x2f\x88\xca\x0d\x3d\xc8\x86\x98\xfc\x1e\xe3\x50\xe7\x57\x3b\x3a\xbd\x5a\x1b\x4c\xd5\xc7\xfb\x3a\x0f\x97\xa5\x9c\xdc\x13\xce\x71\x14\x33\xca\xfa\xb5\x2d\x5a\x25\xcc\x7a\xd3\x9b\x88\x20\x8f\x86\x84\x1f\x33\xac\x7b\x8c\xc3\x43\x52\x15\x7e\xdc\xb7\x03\x25\xd9\x1b\x5a\x47\x10\x2e\x83\x15\x98\xdb\xb8\xc0\xb3\x70\x35\xac\x9a\x60\xd8\x7e\x6d\x87\x5e\x08\x55\x8b\x5c\xd2\xf3\x95\x52\x2b\x5c\x3a\x3b\x9a\x5f\x01\x4f\x45\xb2\x7c\xe7\x4d\xc9\xb4\x1d\x3f\xe6\xd0\xea\xb2\x54\x7a\xb3\x1d\x6e\xb5\x38\x55\x73\x49\x18\x3b\x94\x7e\xdd\x79\xc7\x31\xca\x63\xd5\x1e\xd1\xe4\x56\x9d\x90\x9f\x87\x88\x1f\xfe\x7b\x80\x56\x18\x16\xd1\xea\xe5\x6d\x4c\xb0\x57\xba\x08\xd8\x2b\x5d\x26\x95\x18\xd1\xc2\xbc\x1d\xe3\xa8\x18\x37\x5c\x1d\x05\xa8\xad\x79\xb7\x81\x63\x95\x7e\x8b\xce\xd1\x4b\x67\x0b\xd4\x85\xf1\x1b\x76\xc7\x3a\x54\xa9\x20\xb7\x4f\x4b\xde\x18\xcc\xef\x8c\x22\xc1\x06\xdc\x8f\x8f\x83\x2a\x6c\x35\x63\x41\x96\xe5\x01\xe3\x55\x78\x80\xc7\x9f\xbc\xc3\xf7\xe0\x95\xa8\xca\x65\x7f\x20\xa4\x20\x1a\xaf\xa1\x02\xc8\x34\x88\x5a\x62\x2b\x82\x56\x2e\xdf\xea\x46\x72\x06\xbc\xed\x60\xc8\x41\x1f\xb2\xde\x94\xc3\x15\x5e\xa7\x6a\xdf\xd1\xa5\x17\xb6\x94\xf4\x0b\x3f\xa8\x6f\x4c\xe6\xfd\xb9\x67\xf3\x7f\xf6\x1d\x3d\x95\x25\x19\xd9\xfa\x46\x88\xde\x06\x7c\x36\x6b\x84\xaf\x1e\xaa\xcc\x12\xfd\xa8\xbe\x7d\x49\x13\xe5\xd9\x6c\x4a\xef\xe1\xaa\xec\x90\x76\xbb\xf2\xd0\xfb\x13\xc1\xe7\x48\xed\x22\xba\x70\x03\xdc\x34\xc1\xf3\xa0\xf9\x2d\x54\x4b\x19\x5e\xa5\xa2\x8e\x0d\x8a\x7d\x5d\xa5\xb2\xf6\xca\x57\x19\xb8\x16\x77\x75\x11\xcb\x6e\xb6\x9f\x23\x6c\xca\xf8\x07\x6f\xf5\xa3\x9b\x41\x49\x21\x4f\x3a\x8c\x70\x49\xe9\xd7\x83\xbd\x5c\x81\xa9\x5c\x22\x74\x3a\xc9\xbb\x6d\x67\x6f\xde\x80\x53\x46\x1d\x3b\x73\x9a\x76\x7a\x73\xc3\xf1\x23\xef\x76\x7b\x40\x2e\x68\x51\xbb\x9e\x60\xd4\x8b\x97\x8b\x2e\xe9\x6e\xbd\xe1\xb5\xd4\xe7\x44\xab\x09\xe4\x44\x0b\x14\xba\xe0\xe3\x3b\x7d\xa6\x47\x41\x06\x5e\x83\xf3\x73\xc9\xe9\x45\xf9\x20\x9a\xd8\x70\x95\x39\x26\x7a\xfb\xfb\x8f\x39\x8e\x8e\xd5\x82\xb7\x0f\x33\x7d\x72\x6f\xa4\x47\x40\x60\xa6\xbf\xd5\x67\xba\x31\xd6\xe7\x85\x53\x91\xf0\xe9\x83\x01\x16\x1f\xee\x82\x90\x99\xe9\xd1\x3f\x74\x0a\xbe\xba\xd3\xa7\xba\xa9\x83\xf1\x68\x3e\x1e\xdd\xe9\xd5\x2f\x21\x8a\xdf\x1a\x4b\xbb\x08\xed\x39\x23\xaf\x47\x71\x48\x26\x63\x92\xf7\x4f\xb1\x6d\x24\x74\x56\xbc\xd0\x57\x9c\x28\x4a\x3d\x11\x6f\x65\x7f\xba\x1f\xb2\x3c\x44\x5e\x48\xba\x04\xd5\x01\xd3\xcc\x03\xe5\xa6\xd2\x4f\x74\x83\x84\x4c\xde\x17\x82\x36\x58\xbb\x41\x51\x6c\x71\xfc\x3f\x38\x44\x1e\x1a\xa5\x1e\x52\xdd\xe8\x90\xfd\xef\x4b\x60\xd1\xad\xe7\x60\x8e\x43\x1b\xfe\x17\x00\x00\xff\xff\x6c\x88\xa8\x5e\x28\x53\x00\x00")
+var _latestSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcd\x5d\xfb\x73\xdb\x36\x12\xfe\x3d\x7f\x05\xa6\x93\x19\xdb\x73\xb2\x4f\x94\x2d\xf9\xd5\x66\x46\x95\x19\xd7\x13\x47\x4e\xf5\xb8\x34\xd3\xc9\x70\x28\x11\x92\x79\xa5\x48\x85\xa4\x12\xbb\x37\xf7\xbf\xdf\x02\x7c\x88\x04\xf1\xe0\xcb\xc9\xf5\x87\xd6\x22\x97\xdf\x7e\xbb\x58\x00\x0b\x2c\xc8\x1e\x1f\xbf\x3a\x3e\x46\x1f\xbc\x20\x5c\xfb\x78\xfa\xfb\x3d\xb2\xcc\xd0\x5c\x98\x01\x46\xd6\x6e\xb3\x85\x7b\xaf\xc8\xfd\x1b\xf8\x1b\x5b\x68\xe5\x7b\x9b\xbd\xc0\x57\xec\x07\xb6\xe7\xa2\xcb\x93\xc1\x89\x96\x91\x5a\x3c\xa3\xed\xda\x20\x8f\x33\x22\xaf\xa6\xfa\x0c\x05\xa1\x19\xe2\x0d\x76\x43\x23\xb4\x37\xd8\xdb\x85\xe8\x17\xd4\xbd\xa6\xb7\x1c\x6f\xf9\x57\xf1\xea\xd2\xb1\x89\x34\x76\x97\x9e\x65\xbb\x6b\xb8\x71\x30\x9f\xbd\xbd\x38\xb8\x4e\xe0\x5c\xcb\xf4\x2d\x63\xe9\xb9\x2b\xcf\xdf\x80\x84\x11\x84\x3e\xfc\x27\x00\x49\xcf\x8d\x31\x1e\x31\x40\xaf\x76\xee\x32\x04\x3a\xc6\x02\x90\x30\xb9\xbf\x32\x9d\x00\xe7\xd4\x00\x80\xb1\xc1\x41\x60\xae\xa9\xc0\x37\xd3\x77\x01\xeb\x3a\xe6\x8e\x4d\x7f\xf9\x68\x6c\xcd\xf0\x11\xee\x6d\x77\x0b\xc7\x5e\x76\x88\xb1\x4b\xf0\x89\xe3\x11\xb1\x63\xea\xcf\xb1\xb9\xc1\x57\x68\x65\xfb\x41\x68\x98\xeb\xf5\xa1\xe9\x3e\x63\x87\x5a\xdd\x41\xfb\xbf\x8f\xae\xd1\xec\x79\x0b\x82\x6f\xe7\xe3\xd1\xec\xee\x61\x7c\x8d\xa6\xc0\x74\x63\x5e\xc5\xd8\xd7\xe8\xe1\x9b\x8b\xfd\x2b\x74\x4c\x1b\x62\x34\xd1\x87\x33\x3d\x95\x56\xe3\xa3\x89\x3e\x9b\x4f\xc6\xd3\xcc\xb5\x57\x08\xfe\xb9\x1f\x8e\x6f\xe7\xc3\x5b\x1d\x05\x5f\x1c\x74\xf7\xfe\xfd\x7c\x36\xfc\xf5\x5e\x47\xd3\xd9\xe4\x6e\x34\xa3\x12\xc3\x29\x7a\x6d\xbc\x46\x53\xfd\x5e\x1f\xcd\xd0\x6b\x8d\xfc\x02\xeb\x72\xe6\x39\xe6\x8b\x5a\xa7\x82\x6f\xcd\xb8\x1e\xcf\xb8\x8d\xf9\x64\x6c\x7d\x7b\x89\x29\x05\x77\xb7\xc1\xf0\xe3\xcf\xcf\x1d\x94\xfe\xd9\xd4\xbe\x12\x1a\x52\x13\xd3\x4b\xb5\x2c\x3c\x84\x6b\xa3\xe1\x54\x47\x1f\x7f\xd3\xc7\xd0\x98\x7f\x6a\x9f\xff\x09\xff\xee\x7d\x7e\xf3\xba\x47\xff\xee\xc1\xdf\x68\x16\xdd\x44\xfa\x3d\x48\x82\x53\xf4\xf1\xcd\x11\xd7\x33\xd0\x43\x5e\xd8\x33\x6a\x0d\x2f\xed\x99\x9f\xeb\x78\x86\xf6\xc7\x43\x4e\x0f\x18\xde\xde\x4e\xf4\x5b\xb0\xb1\x9c\x23\x52\xf1\x22\x22\x65\x8c\xd0\x94\xf8\x8a\x8c\x5f\xc9\x08\xd0\x89\x2e\xcf\x3e\x7d\xd0\xe1\x72\xa6\x47\x1c\xf1\x7a\x6d\xab\x1c\x59\x40\x86\x62\xd2\x8d\xcb\x33\x4c\x3b\xc6\x61\x31\xa2\x6a\xb3\xe4\x81\x32\x4c\x73\x1d\x32\x4f\x77\x1f\x65\x47\xc2\xee\xd0\x2a\x5b\x0e\x28\xcb\x36\xdb\x49\xa4\x6c\xc9\xcc\x65\xe1\x95\xb9\x73\x60\xce\x35\x17\x0e\x0e\xb6\xe6\x12\x93\x79\xf4\xe0\x3a\x7f\xf7\x9b\x1d\x3e\x1a\x9e\x6d\x65\xa6\xc6\x9c\xad\xe6\x72\xe9\xed\xdc\x30\x48\xec\xa3\xbd\xab\x9c\x6d\x51\x47\x4c\x00\x62\x5b\xe2\x9f\x86\x6d\xc1\x0c\x6d\xfa\xe6\x32\xc4\x3e\xfa\x6a\xfa\xcf\x30\xe5\x1e\xf6\x07\x47\x68\xfc\x30\x43\xe3\xf9\xfd\x7d\x64\xdf\xc2\x74\x4c\x17\x98\x2f\xec\xb5\xed\x86\xec\xcd\x1d\x79\xca\x70\x6c\x73\x61\x3b\x76\x48\xe6\x77\xae\x5c\x80\x1d\xa7\xa4\xe0\x97\x1d\x24\x1d\xd8\x00\x77\x2e\x80\x17\x57\x08\xee\x19\xc1\x6e\x01\x71\xec\x13\x20\x10\xc0\x6b\x90\xcd\x0b\xd9\xee\xca\x31\x69\xe2\x61\xe1\x20\xb4\x5d\xfa\x77\x29\x8b\xe1\xb9\xb5\x08\xf5\xd1\xdb\x60\xc3\xf2\x36\xa6\xcd\xc3\x3a\xed\xb1\x58\x1b\xe8\x88\xd8\x37\xbe\x61\x7b\xfd\x18\xa2\x60\x63\x12\x3f\xb0\xf6\x84\x8f\x3e\x0e\x1e\x3d\xc7\x32\x1c\xef\x9b\x5a\x68\x83\x2d\x7b\xb7\x51\xcb\x3d\x82\x4e\x91\x14\x1d\x20\x36\x90\x91\xad\x6c\x0c\x6a\xb1\x45\x4c\xe5\x9b\x3c\x7a\x18\xc3\x50\x3e\xbc\x1b\xcf\xd2\x48\x32\xe2\xa0\x30\x68\x8a\x87\x46\xbf\xe9\xa3\x77\xe8\xf0\x30\x09\x95\x37\x90\x46\x1e\x1d\x49\x9e\x2e\x44\x0d\x0b\x54\x0c\x2b\x15\x66\x3e\x26\x18\x3c\x26\x60\x54\x58\x9c\x68\x65\x00\x79\xf1\x1c\xa1\x16\xc7\xaa\x14\x96\xe4\xf0\x8d\x3b\x31\x45\xa9\xd7\x93\x5d\xa0\xc3\x11\x1c\x9c\xb1\x82\x5f\x4d\x67\xc7\x93\xbc\xec\x1e\xd5\x09\x23\x89\x4b\x02\x7b\x0d\x06\x37\x1f\xda\x12\xa0\x7a\x8e\x89\x1e\x2e\x25\x1a\x77\xe4\x12\x26\x06\x01\x0e\x0d\xb2\xee\x6a\x60\xdd\x1e\x23\x36\x0c\x0c\xe2\x8e\x88\xe6\x86\xd8\x5b\xb4\x80\x33\x70\xa6\xb3\x01\xbf\xb7\x47\x83\x9f\x68\x6c\xf1\x36\x0e\xc7\x4d\xbd\x7e\xff\x48\xe2\x8a\xb5\xe7\x6f\x61\x89\xb7\xf6\xe9\x10\x5c\xdf\x1d\x0c\xce\xde\x25\x21\x7e\x2a\x38\x64\xbb\x75\x48\x48\x9a\x21\x22\x6b\x5b\xf0\x21\x2c\x8c\xc9\x4c\x4b\x7f\xa2\xbf\x3d\x17\x17\x89\x3e\xda\x41\xe8\xf9\xcf\xa9\x8b\x20\x7e\x60\x2c\xf8\x92\x10\x9e\xea\xbf\xcf\xf5\xf1\xa8\x24\xe7\x44\x5a\x84\x1a\x27\x0f\xc3\xc9\x0c\x7d\xbc\x9b\xfd\x86\x34\x7a\xe1\x6e\x0c\x8f\xbf\xd7\x61\x34\xfa\xf5\x53\x7c\x69\xfc\x80\xde\xdf\x8d\xff\x35\xbc\x9f\xeb\xe9\xef\xe1\x1f\xfb\xdf\xa3\x21\x8c\x4a\x48\x53\x19\x53\xdb\xed\x2c\x50\x21\x14\x6f\xf4\xb7\xc3\xf9\xfd\x0c\xb9\xd0\x0c\x30\x74\x1c\x1e\x08\x2c\x3e\xb8\xba\xf2\xf1\x7a\x09\x63\x46\xc0\x76\x2b\xd3\xb2\x60\xd6\x0a\xf8\xa3\x93\xa4\xa1\x48\x07\x69\xc1\x32\x0a\xb3\xb7\x8b\xdf\x33\xa2\xde\x18\x82\xaa\x52\x83\x68\x24\xbe\xf4\x2c\x9e\xb8\xd6\xe3\x8b\xdb\x41\xb0\x53\x8e\x44\x2a\x7f\xb4\x1c\xb6\x59\xcc\xef\x16\xb4\x32\x43\xd0\xc3\xc7\xb1\x7e\x03\xba\x14\x16\x0d\xef\x67\xfa\x44\x61\x50\x8a\xc5\xdc\x3e\xb1\x2d\x11\x37\xbc\x5a\xe1\x65\x0b\x51\x17\xe3\xc4\x61\xc7\xf4\x19\x43\x34\xd2\x27\x72\xde\x16\x47\xe3\xa0\x50\xf2\x27\xcf\xb7\xb0\xff\x93\x20\x9a\x69\x1c\xf3\x6f\x59\x38\x34\x6d\x27\x40\xff\x0e\x3c\x77\x21\x0e\xb6\x68\xb6\x6f\xee\x87\x18\x27\xf6\x43\xb2\x00\x10\x70\x8b\x84\x8d\x47\x33\x78\x2c\xd5\x0b\xb7\x3e\xfe\x6a\x7b\xbb\xc0\x50\x3e\x18\xbb\xc5\x37\xdd\xc0\x8c\x36\x2c\x69\x43\xa4\x3c\x92\x51\xae\xcb\x68\xd8\x37\x44\x39\xf9\xa5\xe3\x05\xbc\x89\x89\x6c\xbf\xa6\x73\x13\xfb\x8c\x8f\xcd\x50\xf9\x50\x24\xbb\xdb\x5a\xa5\x65\xd3\xd0\x89\x7f\x6e\xb6\x9e\x4f\x96\x2b\xc9\x0e\x32\x6b\x8b\x56\xc8\x07\x42\xd3\x01\xbb\x6d\x57\xb0\x9c\x5b\x61\x6c\x6c\x3d\xcf\x11\xac\x1e\xcd\x00\x1b\x20\x22\x68\x6b\x7a\x1b\xa6\x05\xec\x7f\x15\x89\x90\xdd\x83\xf0\xc9\xa0\x69\x92\xfd\xb7\x48\x6a\xeb\x7b\xa1\xb7\xf4\x1c\xa1\x5d\x5d\x41\x94\x61\x13\x7a\x10\x4d\x2f\xe2\x44\x71\xb7\x5c\xc2\x34\xb5\xda\x39\x86\x30\x50\x62\xc3\xa1\x07\x41\x23\x08\xa5\xc4\xdd\x6a\x1f\x4f\x5b\xd3\x0f\xed\xa5\xbd\x35\xdb\x98\xbd\xf9\xb0\xaa\x39\xaf\xfc\x68\xa3\x1e\xbf\xaa\x9a\xdc\xee\x34\x26\xd5\xf1\xbd\xa6\xb5\x4a\x86\x36\x9c\xe6\xa4\xba\x8a\xd3\x1e\x5f\x5c\x32\x0d\xa6\x0f\xb4\x18\x9b\xaa\x65\x4e\xb6\x3b\x09\x97\x42\x24\xf3\x5f\x46\xa6\xd0\x19\xb0\xe1\x04\x18\xf7\x7c\x6f\xe7\x93\x5d\xbf\x28\xba\x05\x53\x4f\x32\x9c\x1c\x40\xa6\x2b\x5e\x8a\x89\xfb\x01\x98\x67\xe1\xe6\xee\x8c\x60\x98\xbc\xa2\x69\xbe\x10\x0f\x89\x75\x66\x2f\x0f\x12\x1d\x5f\xa8\x96\x8e\xf2\xaa\xac\x27\x12\x8a\x52\x64\xa9\x48\xb4\x0e\xe6\x0a\x50\x0d\x40\x44\xa5\x2b\x95\x93\xaa\x4b\xa5\x24\x1a\x29\x25\x3b\xda\x4f\x22\x7b\x99\x30\x11\x62\xd3\x4d\xe6\x24\xb2\x8b\xec\xe6\xe6\xdf\xe8\x5a\x7e\x4e\xa6\x18\x8c\x07\xf3\x0c\xb8\x37\x33\xdb\x5a\xf9\xb0\x30\x32\x7e\x2a\x6c\xdf\xed\x3d\xf8\x86\xbb\x43\xc6\x40\xf1\x1e\x4f\x9c\xf6\x73\xc1\x8f\x25\xf0\x72\x3e\x65\xe0\x19\x87\xbf\xe1\x6f\xb6\x65\x10\xd3\x91\xa2\xd5\x79\x54\x04\x5c\x76\x26\x2d\x33\x84\x35\x99\x4b\x45\xfc\xda\x9d\x4d\x15\x5a\xbe\xd7\x7c\x5a\xd1\xd8\x86\x33\xaa\x42\x5b\x71\x4e\x15\x3d\x20\x99\x55\x33\x8f\xb4\x1a\xab\x49\x7c\x66\x29\x95\x5e\x44\xc5\x63\xbf\x62\x69\x56\x76\xe2\x95\xcf\xa1\x5c\xd9\xbd\x6a\xf1\x2a\xc3\x14\x76\x3d\xd1\x0a\xed\x87\xac\xb1\x60\xb5\x82\xdd\xaf\xd8\x01\x52\xbc\x7d\x4b\xb8\x0d\x2b\x9e\x9d\x13\x0a\x6e\x6e\x20\x35\x11\xdc\x22\x5e\x10\xdd\x26\x3b\xdc\x66\xb8\x03\x68\xde\xb6\xfe\xe0\xe8\xcf\xcf\xfb\xe4\xe5\x3f\xff\xe5\xa5\x2f\x20\xc1\x2c\xbd\xf0\xc6\x13\xec\x86\xed\xb1\x5c\x70\x83\x34\x19\xda\x63\x15\x61\x62\xcb\xc0\x9d\xc6\x02\x1a\xce\xa2\x5b\xd6\x17\x10\xc0\x6b\xcc\x2e\xc7\x92\xb9\xb5\x38\x2e\xfe\x85\x9f\x0d\x5a\xcf\x30\x48\x9f\xc0\xb5\xfb\x14\x83\x13\x77\x27\xb8\xaa\xda\x0f\x97\x97\x54\x14\x5b\xe7\x74\x6e\xaf\x3f\x10\x44\x8f\xa7\x3b\x2b\x24\x0b\x29\x5b\x13\x91\x27\x6d\x49\xfd\x8b\xce\xed\xbc\x80\x8b\x2b\x78\xc2\xfb\xb2\xb4\x89\xa6\x41\xae\x70\x2d\x0f\x37\x2d\xd9\x4d\x64\x79\xe0\x18\x4c\xb6\x7e\x96\x36\x5d\xee\x97\x2f\xef\xd6\x2b\x8b\x46\x6e\xe6\xa7\x2d\x49\xba\x22\x28\x0e\x86\xfe\x0e\x34\x82\x2f\x1b\xe4\xfd\x19\x8c\x7a\xf5\xaf\xcc\xce\xb6\x6c\xeb\xbb\xd4\xe6\x74\xad\xcd\x6f\xe9\x19\x83\xbd\x79\xf0\xaf\x8d\x1d\x7e\xa7\x93\x08\xed\x87\x49\xa6\x9d\xea\x15\xd0\x73\x00\xed\xd5\xd0\xb3\xb0\x4d\x4a\xdf\x52\x60\xb6\x15\x19\xd4\x42\x23\x2b\xf2\xfb\xb8\x64\x03\x89\x54\xdc\x6b\xe2\x29\xa7\x54\x66\x17\x75\x9b\x87\xf1\x3d\xbb\xeb\x8f\xa2\xfb\xa3\x87\xfb\xf9\xfb\x31\x99\xb9\xc9\x39\x1d\x71\x79\x2b\x5b\x48\xc8\x16\xb7\xaa\x6d\xff\xb4\x67\x84\x00\xbf\x92\x51\xd2\x6d\xa3\x32\x46\x0a\x17\x48\xad\x99\x29\xd4\x50\xc9\x50\x45\x36\xcf\x37\xf5\x86\x1c\xbe\x58\x79\xbe\xec\x68\x16\xba\x19\xce\x86\x0a\xdb\x14\x78\xc5\xa3\x22\x6d\x80\xf2\x0e\x5b\x34\xc1\x15\x9c\x70\x68\x00\x29\x3b\x29\x50\x06\xf6\x6e\x3c\xd5\x61\xcd\x09\x63\xcf\x43\xe1\xb4\x00\x5d\x54\x4e\xd1\xe1\x81\x66\xd8\x2e\x0c\x5b\xa6\x63\x04\x14\xeb\x24\xf8\xe2\x1c\x74\xd0\x41\xaf\xab\x5d\x1e\x77\x7b\xc7\x3d\x0d\x69\xa7\x57\xfd\xb3\xab\xd3\xb3\x93\xee\x69\xaf\xdb\xbb\xf8\x47\x57\x3b\x80\x20\x28\x85\xde\x03\x74\x0b\x3f\xe5\x43\x6a\x01\xe1\xe6\xd9\x96\x54\xd3\xd9\xe0\x52\x1b\x54\xd1\x74\x6a\xec\x02\x9c\xae\x8c\x40\xad\xc1\xd6\xdd\xa5\xfa\xfa\x97\x83\xf3\x5e\x15\x7d\x67\x86\x69\x59\x06\x5b\x4b\x91\xea\x38\xef\xf6\x2f\xb4\x2a\x3a\xfa\x46\xb4\x0c\x4b\x76\x84\xe8\xc9\x49\xa9\x8a\x0b\xed\xac\x5f\x45\xc3\x20\xd1\x10\x8f\xde\x25\x34\x5c\x76\x2f\x2a\xa9\x38\x8f\x52\x83\xe7\xd2\x46\x68\xdd\x7e\xb7\x52\x90\x5d\xe4\x8c\x88\xfa\x60\x09\x35\x5a\xbf\x7f\x7e\x5a\x4d\x0f\x69\x72\x73\xbd\x86\xa1\xd0\x84\xd0\x92\x46\x94\xd6\x3b\xbb\x3c\x3d\xab\x02\x7f\x49\xe1\xa3\x2a\x9b\xf1\x64\xf9\x72\xf4\x8b\xee\x65\x15\x70\xad\x4b\xd1\xe3\x36\xa0\x2b\x03\x29\xfe\xa9\xd6\xbb\xac\xa6\x40\xcb\x2a\x48\x53\x6e\xd2\xfb\xe5\x8a\xce\x2e\xab\xb5\x82\xd6\xcb\xb5\x73\xbc\xcc\x88\xde\xb7\x91\x6a\x3a\xeb\x77\xbb\x95\x1a\x44\x3b\x8d\xcc\x49\xf7\x94\xe5\x0d\xde\xef\x6a\x17\xd5\x5c\x76\x66\xac\xec\xa7\xd8\x1a\x72\x98\x0c\x7e\x62\xc7\x92\x2b\xd1\xce\xbb\xe7\x95\x94\xf4\x93\x62\x7f\x52\x84\x7d\x52\x98\x71\x06\x4d\x5f\x49\xc3\x00\x9a\x79\x8d\x21\x61\x2d\x96\x79\x15\xaa\xfa\x83\x41\xb5\xb6\x3f\xa7\x41\x96\xec\xc0\x85\x74\x6c\xa4\xc7\xc9\xf3\x7a\x4e\x8f\x7b\x03\xa4\x0d\xae\xba\x67\x57\x5a\xef\xe4\x74\xa0\xf5\x52\xa7\x09\xe6\x5a\xe9\xf9\xb0\x2a\x73\x78\xa5\xb3\x73\x24\x27\x53\xe0\xc6\x6f\x89\xec\x5f\xf0\x3a\x81\x68\x91\x9e\x2b\xeb\x20\xad\x13\x1d\x9d\x2f\x61\x6e\xf1\xc8\x58\x03\x63\xa5\xc7\x94\x5a\x31\x35\xb7\xc6\xa8\x62\x28\xef\x98\x52\x83\xd4\x4c\x76\xea\xa7\x05\xd8\x12\xa7\x1e\xea\x37\x53\xb5\xb2\x7b\x1b\xcd\x26\x5f\x45\x55\x69\x46\x41\x99\xbd\x05\x97\x73\xaa\xcd\xed\xa0\xaa\x0b\x6f\xf5\x9b\xb2\x6a\xc5\xa7\x8d\xc6\x54\xad\x14\xab\x34\xa7\xb0\xbe\xd3\xc0\xf5\xb2\x2d\xee\x06\xb0\x9c\xbd\xe7\x06\x68\xa2\x8d\xce\xea\xa1\x90\x9e\x43\x4e\xa7\x81\x2d\x78\x20\x01\xdd\x6f\x3f\x55\xdd\x5e\x48\xe0\xa2\x37\x16\x6f\x6e\xb8\xaf\x86\x10\x55\xe8\xc3\xe4\xee\xfd\x70\xf2\x09\xbd\xd3\x3f\xa1\xc3\xfd\x5e\xab\xfc\xcd\x8f\xfc\xaf\x96\x39\x53\x4c\x29\xf1\x54\xa9\x88\x7d\x87\xbe\x1f\xa2\x7c\x55\xa3\x70\xa1\x6d\x4b\x62\x58\xa9\x31\x59\xd5\x79\x7b\xa2\x3b\x1d\x24\x6b\x95\xcc\x5b\x15\xd9\xbc\xba\x25\x3b\xf6\x88\x5c\x13\x18\x85\x79\xf6\x1c\xb6\x6c\x66\xc8\xfc\x6e\x89\x35\x83\xca\x63\xce\x53\xac\x64\xcf\x6c\xaf\x32\x59\xcd\xbe\x4a\x60\xec\x4b\x10\x46\xb6\xd8\x60\xb4\x62\x5d\x5e\x2d\xcf\xb8\x5a\xc4\xd0\x7c\x7c\x07\xd3\x0c\x74\xa2\x54\xbc\x93\xa9\xa5\x74\x72\x65\x93\x8a\xae\xd9\xfe\x18\xc3\x2b\x35\xaa\x60\xbb\x59\x91\x03\xb5\x6b\x19\x5f\x89\xcc\x52\x09\xad\xd2\x96\x0b\x77\xa0\x95\x29\x43\xbb\xd6\x8b\xd4\xc8\xec\x97\x52\x53\x7a\x80\x2d\x83\x33\xbf\x5b\xb2\x8f\x41\xe5\x99\xc3\x53\x9c\x67\x0f\x17\x04\xf5\xf4\xa4\x60\xdb\x0e\xd9\x08\x8c\xc7\x31\xa3\x26\x4f\x2d\xd9\x5e\x91\x95\x83\x73\xd5\xb3\x76\x98\x66\x10\x79\x74\x59\x85\xe2\x4c\x41\x34\xc8\x75\x32\x85\x5f\x65\x26\x41\x76\xc5\xe3\x6b\x89\x69\x77\xe3\x1b\xfd\x8f\x72\xa5\x6f\x2a\x2a\xc3\x04\x93\x8b\xc9\xcb\x7c\x7a\x37\xbe\x45\x8b\xd0\xc7\x58\x9e\xb9\x51\x33\x00\x8c\x58\x52\x9f\x5d\x16\x85\xf0\x61\xc6\xfc\x3c\x1b\x89\xe7\x12\x9c\xc8\xcb\xcd\xf9\xc4\x95\xfc\x52\x8c\x04\xd3\x57\x0b\x8d\x97\x6f\x2b\xee\xa1\x35\x5e\x7b\x75\x0a\xa7\xc2\x78\xe4\xc8\xe1\xb6\x26\xcc\xe8\xe1\xb8\x52\xb4\xd8\x23\x75\x3c\x36\xd1\xae\x49\x13\x3e\xf1\x21\x83\x52\x8c\x98\xf3\x7a\x9d\xe2\xd1\x3c\xee\xcc\x66\x60\x12\x1b\xf4\x7e\x0d\xa6\x71\x32\x14\x11\x66\xe0\xb2\xb4\x93\xd7\xde\x72\x8c\x79\xa7\xd4\x3b\xc9\x89\x74\x11\xd9\x7d\x41\xb9\x21\x4d\xdb\x2a\x4d\x30\x3b\x0a\xd6\x20\xed\x6d\x8d\x6d\x5b\xbc\x63\xac\x2c\x75\x41\x46\x56\xcb\x12\xbe\x01\xe1\x53\x7b\x06\xc4\x58\x82\x98\xae\x69\x42\xfe\x7c\x75\xd1\x08\xf0\x1a\xe9\xdd\x5e\x2d\x1b\x62\xf2\x7b\x8c\xba\xce\x97\x3b\x3a\x7d\x5b\x91\x0c\xd5\xcd\x7d\x9d\x87\xcb\x52\x4e\x5e\xbd\xcc\x71\xe4\x33\xca\xfa\xb5\x2d\x5a\x05\xcc\x72\xc3\x1b\x8f\x60\x18\x35\x49\xd8\xa4\x59\xf7\x18\xf5\x43\x52\x15\x7e\xa1\x6f\x11\x25\xd9\x97\x5e\x1a\x10\x2e\x82\x31\xcc\xc9\x7b\x40\x39\x9e\xcc\xdb\x36\x72\x82\x34\x4d\x6d\x87\x1e\x85\x2a\x45\x4e\x98\x1b\x27\x78\xcc\x7b\x3c\x8d\xf9\x31\x78\x2a\x92\xc5\xd7\x88\x94\x4c\xdb\xf1\x63\x0e\xad\x2c\x4b\xa5\x37\xdb\xe1\x56\x8a\x93\x9c\x4b\xc2\xd8\xf1\xbc\xbf\x76\xdb\x66\x8c\xf2\x58\xa5\x5b\x34\x79\x51\x89\xcb\x6f\x6b\xda\x3e\xfd\x4e\x66\x2b\x0c\x59\xb4\x72\xfd\x36\x26\xd8\x29\xbc\x5b\xd5\x29\xbc\x9f\x27\x30\xa2\x85\x71\x3b\xc6\x51\x31\xae\x98\x1d\x11\xd4\xd6\xbc\x5b\xc1\xb1\x4a\xbf\x45\xc7\xb9\x0a\xa5\x67\xb0\x27\xfe\x68\x49\x53\x87\x2a\x15\xe4\xd6\x69\x49\xf1\x23\xbf\x32\x8a\x04\x2b\x70\x6f\x1e\x07\x32\x6c\x35\x63\x4e\x2f\xcb\x03\xc6\x59\x38\xc1\x23\xfb\x0c\xb5\xe3\x41\x8a\xaa\x4c\xfb\x89\x90\x82\x68\x9c\x43\x11\xc8\x34\x88\x5a\x62\xcb\x83\x56\xa6\x6f\x65\x23\x39\x03\xde\x76\x30\xe4\xa0\xeb\xe4\x9b\x62\x38\xe6\x0b\x15\xed\x3b\xba\xf0\x0d\x0c\x25\x7d\xe6\x81\xf2\xc6\x64\x3e\x49\xf2\x62\xfe\xcf\x7e\xf6\x44\x65\x49\x46\xb6\xbc\x11\xbc\x0f\xac\xbc\x98\x35\xdc\xaf\xb9\xa8\xcc\xe2\x3d\x54\xde\xbe\x64\x13\xe5\xc5\x6c\x4a\x5f\x6d\x54\xd9\x21\xdc\xed\xca\x43\xef\x0f\x8c\xbc\x44\xd7\x66\xd1\xb9\x0b\xe0\xaa\x1d\x3c\x0f\x9a\x5f\x42\xb5\xd4\xc3\x65\x2a\xca\xd8\xa0\x58\xd7\x49\x95\xb5\x37\x7d\x15\x81\x4b\x71\x57\x4f\x62\xd9\xc5\xf6\x4b\x84\x4d\x11\xbf\xf6\x52\x3f\x2e\xaa\x90\x85\x65\xe6\x1d\xc3\xda\x0e\xe6\xc3\x11\x76\x71\xad\x28\x9f\x86\x67\x64\x24\xcc\xa2\x57\x2d\x5b\xe0\x14\x7f\x39\x42\xc0\x26\x7d\xa3\x53\x41\xa5\x4d\x2f\xe5\xdf\xfc\x94\x10\x13\xfb\x29\x3a\x62\x9c\xa4\x62\xc9\x1e\xb1\xb1\x80\x7c\xbd\x36\x41\x09\xa6\x32\xc9\x3b\x3c\x4c\x3e\xf8\x72\xfc\xe6\x0d\x3a\x08\xc8\xb7\x73\xf7\x15\xae\x83\xab\x2b\xf2\xfe\xea\xd1\x51\x07\x89\x05\x49\xd9\xa6\x94\x60\x54\x4d\x11\x8b\x2e\xbc\xdd\xfa\x31\x2c\xa5\x3e\x27\x2a\x27\x90\x13\x65\x28\x1c\x91\xcf\xb0\x4f\xf4\x68\x98\x40\xbf\xa0\xd3\x53\x59\x55\x92\x16\xd8\x1a\x85\x12\x0f\x8c\x34\x51\xb6\xf4\xc9\x29\x43\x65\x8b\x8d\xd9\x93\x16\x82\xf2\x94\xe8\x90\x8f\x6d\x19\xab\x4c\x2d\xf5\xed\xbb\xef\x73\xd4\x27\x56\x8b\xde\x3e\x4c\xf4\xbb\xdb\x71\x5a\x5e\x47\x13\xfd\x2d\x38\x7f\x3c\xd2\xa7\x4c\x29\x8e\xde\x05\xb7\xcc\x3f\xdc\x10\xdf\x4d\xf4\xe8\x73\xfa\xe4\xd2\x8d\x7e\xaf\xc3\xa5\xd1\x70\x3a\x1a\xde\xe8\xf2\x8f\x09\xf1\xbf\xfe\x92\x6e\x5d\xb5\xe7\x8c\xbc\x1e\xc5\x01\x04\x11\x93\xbc\x7f\xd8\xbd\x4a\xae\xb3\xe2\xd5\xa5\xe2\xb4\x86\xd0\x13\xf1\xfe\xc9\x0f\xf7\x43\x96\x07\xcf\x0b\xc9\xd6\x94\x3c\x60\xaa\x79\xa0\xb8\x93\xf9\x03\xdd\x20\x20\x93\xf7\x05\x67\xef\xb5\xdd\xa0\x60\xf7\xd5\xfe\x1f\x1c\x22\x0e\x8d\xc2\xc6\x65\xd9\xe8\x10\xfd\x9f\x87\xd0\xd2\xdb\x6c\x1d\x1c\x62\x6a\xc3\xff\x00\xce\x28\x52\x7c\xa6\x68\x00\x00")
 
 func latestSqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -100,7 +101,7 @@ func latestSql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "latest.sql", size: 21288, mode: os.FileMode(420), modTime: time.Unix(1551104083, 0)}
+	info := bindataFileInfo{name: "latest.sql", size: 26790, mode: os.FileMode(420), modTime: time.Unix(1553612652, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -245,6 +246,26 @@ func migrations16_ingest_failed_transactionsSql() (*asset, error) {
 	return a, nil
 }
 
+var _migrations17_add_ledger_state_tablesSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcd\x56\x5d\x6f\xda\x30\x14\x7d\xcf\xaf\xf0\x23\x68\x54\xea\xaa\x51\x69\xaa\x56\x89\x41\xb4\xa1\x32\xa8\x18\x48\xeb\x93\xe5\xc4\x97\xc4\x5a\xe2\xb0\xd8\x29\xe3\xdf\xcf\x4e\x42\xc8\x87\x13\x32\xba\x87\xbd\x20\x92\x7b\x7c\xee\xc7\x39\xb6\x73\x73\x83\xde\x85\xcc\x8b\x89\x04\xb4\xdd\x5b\xd6\x74\x6d\x4f\x36\x36\xda\x4c\x3e\x2f\x6c\xf4\x13\x8e\xf8\x95\x04\x09\x60\x21\xa3\x18\xd0\xc0\x42\xfa\x1d\x7a\x25\xb1\xeb\x93\x78\x70\x37\x1e\x0f\xd1\x72\xb5\x41\xcb\xed\x62\x31\x52\xc1\x14\xdc\x1e\x7e\x5e\xcf\xbf\x4d\xd6\x2f\xe8\xc9\x7e\x41\x03\x45\x34\xb4\x86\x0f\xb5\x94\xc4\x75\xa3\x84\x4b\x91\xe6\xca\x1f\x30\xa3\x48\x13\x12\x57\x42\xac\xd9\x8f\x8c\x7b\x83\xf1\x7d\x95\xdc\x21\x01\xe1\x2e\x20\x87\x79\x8c\xcb\x22\x84\xa6\x5f\xed\xe9\x13\x1a\x9c\xc2\x8f\x9f\xd0\xed\x30\xc5\x27\x9a\x06\x07\x8c\x38\x2c\x60\x92\x81\x68\x5d\xda\x44\x16\x2c\x02\x82\xa0\x27\x8d\x09\x5a\xe2\xf9\x95\x80\xaa\x0f\xf3\x24\x74\x54\x97\x35\x0e\x0d\x51\x11\x2c\x12\x07\xb8\x8c\xf5\x52\x15\x06\x4f\x21\xeb\x69\x6a\xb0\x22\x03\xe3\xbb\x80\x48\x16\x71\x4c\x41\x48\xc6\xd3\xff\x3d\xe6\xaa\x56\x79\xcd\x6c\x3a\xe2\x47\x21\x60\x1a\x85\x84\x71\x24\xe1\x77\xb5\xda\x90\x08\xc5\x8a\x0f\xc0\x3c\x5f\x22\x11\x12\xdd\x7c\x15\x22\xfd\x18\x84\x1f\x05\x14\x07\xd1\xe1\x12\x24\x04\xca\x92\xf0\x12\xca\x57\xd9\xcc\x98\x40\xd5\x83\xc3\x88\xb2\x1d\x03\x95\x10\xa8\xee\xc6\xd4\x55\xc5\xa4\x67\x07\x76\x78\x15\x53\x22\xc9\xdf\x1b\x96\x93\x10\x0c\xb0\xfb\x0f\xa6\x3d\xd5\xc4\x7d\xbc\x1d\xfe\xd3\x06\x47\x69\x41\x5d\x6d\x0a\xe6\x71\x88\xaf\xd8\x9a\xd9\xc2\x1e\xc0\xdc\x2d\x17\xab\xce\x08\x47\xa8\x45\x9e\xf9\x72\x66\xff\x68\xd4\x8d\x9d\x23\xce\xdf\xa1\xd5\xb2\xd9\xd6\xf6\xfb\x7c\xf9\x05\x39\x32\x06\xa8\x08\x5f\x9f\x87\x8c\x13\x35\x69\x65\x30\xb8\x62\x14\x44\x08\x90\x58\x1e\xf7\x60\xec\x32\x0b\x33\x21\x92\x5e\xf3\xca\xe0\x6e\x44\x4d\x06\x79\x7f\xf7\xb6\x03\xf2\xdc\xa7\xfa\x09\x99\x6c\x5b\xd8\xc0\x3d\xfe\x97\x27\x6c\xfb\x39\xf6\xf6\x9d\x73\x56\x75\x54\x91\x70\x54\x52\xc8\xe0\xd0\x92\x93\x52\x73\x6a\xac\xb6\x66\xd9\x61\x55\x57\x36\xf2\x68\xe6\x6a\xce\x86\x5f\xa3\xdd\xee\xb4\x6b\xf5\x80\xd4\xa1\xdc\xcb\xa9\xe9\x32\x0d\x35\x5c\x45\xa7\x41\x67\x15\x37\xce\xfe\x5c\xce\x96\x28\x09\xd3\x2d\xd8\xa2\x61\x1e\x2d\x64\xdb\xc7\xcc\x05\x6e\x94\x23\x0d\xd1\xf6\x10\xa2\x51\xe2\x04\xa0\x1e\xc0\x65\x42\xdf\x76\xfd\xee\xb5\xeb\xfc\x70\x9a\x97\x41\xe7\x4c\x01\x2d\x71\x26\x80\xd6\x38\x57\xa5\x22\x6f\x21\x8f\x62\xe8\x20\x38\xcf\xb6\x83\xa7\x00\xb5\x73\x55\x64\x6a\xa1\x2a\x63\x74\x5f\x37\xa5\xef\xc5\x59\x74\xe0\x96\x35\x5b\xaf\x9e\xab\x4e\x73\x89\x70\x09\x85\x87\x72\xa8\x6c\x69\x53\xbc\x71\x1a\x77\x82\xd2\x0b\xb7\x0b\x61\x0c\xd6\x3f\x67\x0b\xcc\x1f\x2e\x0d\x3f\x83\x05\x0b\x00\x00")
+
+func migrations17_add_ledger_state_tablesSqlBytes() ([]byte, error) {
+	return bindataRead(
+		_migrations17_add_ledger_state_tablesSql,
+		"migrations/17_add_ledger_state_tables.sql",
+	)
+}
+
+func migrations17_add_ledger_state_tablesSql() (*asset, error) {
+	bytes, err := migrations17_add_ledger_state_tablesSqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "migrations/17_add_ledger_state_tables.sql", size: 2821, mode: os.FileMode(420), modTime: time.Unix(1553612652, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _migrations1_initial_schemaSql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x5a\x5f\x6f\xdb\xc8\x11\x7f\xf7\xa7\x18\xdc\x8b\x6c\xd4\x6a\x2f\xb8\xe2\x70\x95\xe1\x03\x14\x99\x69\x84\xca\x54\x22\x51\x4d\x82\xc3\x61\xb1\x22\x47\xd4\xd6\xe4\x2e\xb3\xbb\x74\xa4\x2b\xfa\xdd\x0b\x52\x24\xc5\xff\xa4\x1c\xc9\xf7\x28\xee\xec\xcc\xfc\x66\x66\x7f\x33\x5c\x6a\x38\x84\xbf\xf8\xcc\x95\x54\x23\xac\x82\xab\xe1\xf0\x6a\x38\x84\x0f\x42\x69\x57\xe2\xf2\xe3\x0c\x1c\xaa\xe9\x9a\x2a\x04\x27\xf4\xe3\xe5\xab\xa5\x61\x81\xd2\x54\xa3\x8f\x5c\x13\xcd\x7c\x14\xa1\x86\x7b\xf8\xf1\x2e\x5e\xf2\x84\xfd\x54\x7d\x6a\x7b\x2c\x92\x46\x6e\x0b\x87\x71\x17\xee\x61\xb0\xb2\xde\xfd\x32\xb8\x4b\xd5\x71\x87\x4a\x87\xd8\x82\x6f\x84\xf4\x19\x77\x89\xd2\x92\x71\x57\xc1\x3d\x08\x9e\xe8\xd8\xa2\xfd\x44\x36\x21\xb7\x35\x13\x9c\xac\x85\xc3\x30\x5a\xdf\x50\x4f\x61\xc1\x8c\xcf\x38\xf1\x51\x29\xea\xc6\x02\xdf\xa8\xe4\x8c\xbb\x77\x57\x09\x3c\x93\xfa\x38\x82\xc0\x0b\x5c\xf5\xd5\xbb\x03\x6b\x1f\xe0\x08\x8c\xcf\x96\x61\x2e\xa7\x73\xf3\x0e\x96\xf6\x16\x7d\x3a\x82\xe1\x1d\xcc\xbf\x71\x94\x23\x18\xc6\xc8\x27\x0b\x63\x6c\x19\x47\x49\x98\xbe\x03\x73\x6e\x81\xf1\x79\xba\xb4\x96\xa9\x42\xf8\x34\xb5\xde\xc3\x72\xf2\xde\x78\x1c\x43\xe0\x12\x9b\x6a\xea\x89\xc8\x7a\xc1\xfc\x51\x4b\xc9\x91\xc9\xfc\xf1\xd1\x30\xad\x16\x37\x0e\x02\x30\x37\xab\x4a\x60\xba\x84\xc1\x87\xd9\xdf\x02\x37\x4a\x5e\x20\x85\x8d\x4e\x28\xa9\x07\x1e\xe5\x6e\x48\x5d\x1c\x94\xfd\xd8\x2a\x2d\x24\x9e\x2f\x0a\x07\x7d\xc5\x20\x84\x6b\x8f\xd9\xcd\x01\x28\xba\xf0\x32\xfc\x89\xd9\x08\x7e\x54\xb2\xa0\xf7\x01\xc2\x46\x48\x88\x9e\x47\x15\xa7\x50\x2b\x10\x1b\xb8\x7e\xc2\xfd\x2d\x3c\x53\x2f\xc4\x1b\x08\x28\x93\x2a\x0e\x49\x5c\x86\x48\xa5\xbd\x25\x01\xd5\x5b\xb8\x4f\xbc\xbe\x2d\xa6\x30\x12\x73\x70\x43\x43\x4f\x13\x4d\xd7\x1e\xaa\x80\xda\x18\x95\xf3\xa0\xb4\xfa\x8d\xe9\x2d\x11\xcc\xc9\x55\x68\x31\xee\x2c\xf2\x6c\x4f\xa8\x6d\x8b\x90\x6b\x95\xc2\xb7\xc6\x6f\x67\xc6\x11\x7c\x12\xbb\x2c\x02\x77\x60\x65\x66\x47\xf9\x7c\xc4\xfb\x2a\x5a\xe1\xfa\x0a\x00\x80\x39\xb0\x66\x2e\xe3\x3a\xce\x94\xb9\x9a\xcd\x6e\xe3\xe7\xd4\x71\x24\x2a\x05\xf6\x96\x4a\x6a\x6b\x94\xf0\x4c\xe5\x9e\x71\xf7\xfa\xe7\xbf\xdf\x5c\xdd\x54\x6a\x25\xd1\x8e\x9b\x0d\xda\xe7\x76\x39\x51\x9a\x78\x5c\x02\x42\x9a\x10\xa4\x72\x22\x40\x49\x63\x5e\x68\x92\xfc\x41\x48\x07\xe5\x0f\xc0\xb8\x46\x17\x65\x69\x35\xae\x97\xfa\x25\x07\x35\x65\x9e\x82\xff\x28\xc1\xd7\xcd\x41\xf1\xd0\x71\x51\x9e\x39\x28\x89\xd2\x24\x28\x0a\xbf\x86\xc8\xed\x26\x47\x0f\xc2\x64\x4b\xd5\xb6\x3e\xa3\x25\xf9\x40\xe2\x33\x13\xa1\x22\x9d\x1b\x93\x18\x49\xca\x15\x3d\xb0\x6f\x9c\x95\xcc\x8f\x07\xe3\xdd\x78\x35\xb3\xe0\xc7\x92\x85\x63\x56\xfa\xc9\xdb\x9e\x50\xe8\x10\xaa\x21\xea\x20\x4a\x53\x3f\x80\xe8\x20\x45\xbd\x24\x7a\x02\x7f\x08\x8e\xe5\x3d\x12\xa9\xee\xdc\x74\x90\x0d\x03\xa7\xb7\x6c\x56\x47\xc9\x4f\x3f\x10\x52\xa3\x24\xcf\x28\x15\x13\xbc\x82\xe5\x4d\xb9\xa2\x84\xa6\x1e\xb1\x05\xe3\xaa\xbe\x20\x37\x88\x24\x10\xc2\xab\x5f\x8d\x9a\x2e\xd9\x60\x53\xae\xe3\x65\x89\x0a\xe5\x73\x93\x88\x4f\x77\x44\xef\x88\x42\x4d\x14\xfb\xa3\x2a\xd5\x5c\xca\xc7\xb4\x05\x54\x6a\x66\xb3\x80\x9e\x9d\xa1\xea\x6d\x1c\xf9\xaa\x1e\x53\xff\xe3\xde\x4d\x20\xa7\xe2\x27\xcc\x21\x0a\xbf\xa6\x61\x58\x1a\x1f\x57\x86\x39\x69\x89\x44\x1e\x7c\x2a\xdd\xcf\x46\x8c\x60\x69\x8d\x17\xd6\xa1\x91\xbe\x89\x1f\x4c\xcd\xc9\xc2\x88\x5b\xdf\xdb\x2f\xc9\x23\x73\x0e\x8f\x53\xf3\xdf\xe3\xd9\xca\xc8\x7e\x8f\x3f\x1f\x7f\x4f\xc6\x93\xf7\x06\xbc\x39\x0b\x50\x98\x7f\x32\x8d\x07\x78\xfb\xa5\x03\xf1\x78\x66\x19\x8b\x13\x01\x67\xba\x3b\xc4\xff\xca\x9c\x4e\x2c\x97\x2a\xd4\xae\x66\x9a\xa7\xc7\xc6\x86\x1b\x04\x1e\xb3\x0f\xb8\xe2\x7e\xf4\x9d\xed\xe8\xf0\x48\x89\x50\xda\x98\x96\x7a\x03\xf7\xa7\x3c\x35\x18\x8c\x46\x15\x89\x1e\x87\x22\x0f\xef\x72\xb4\xd0\x64\x25\x8e\x7d\x03\x2d\xd4\xed\xad\x4f\xc0\xf7\x90\x42\x93\x67\xe7\xa5\x85\x0e\x2b\xaf\x45\x0c\x27\x82\xfd\x4e\x6a\xe8\xb0\x56\x25\x87\xa6\x0d\x2d\xf4\x90\xdb\x72\xb9\x92\x4d\x29\x22\xef\x5f\xef\x71\x2c\x99\xc2\x3a\x86\xbc\xbe\x0c\xd2\x4e\x06\xb5\xb2\x47\xd3\xcd\xf3\x0a\x6d\x6c\xcd\x4d\xb3\xde\x9f\x32\xad\xe9\x1d\x41\xfe\x8c\x9e\x08\x10\x34\xee\x2a\x54\xbd\x8b\x66\xa7\xd0\xd3\x0d\x8b\x3e\x46\xaf\x90\xb5\x4b\x51\x14\x9a\x96\x15\x73\x39\xd5\xa1\xc4\xba\x37\xaa\x7f\xfc\x7c\xf3\xdb\xef\x47\x16\xfe\xef\xff\xea\x78\xf8\xb7\xdf\xcb\x43\x1c\xfa\x82\xc4\xdd\xa0\xca\xd9\x99\x2e\x2e\x38\xb6\xb2\xfa\x51\x57\x55\x4d\x82\x8c\xf9\x48\xd6\x22\xe4\x8e\x8a\x32\xf7\x8b\xa4\xdc\xc5\x98\x0c\xf3\x87\x89\x39\xe9\xd1\x49\x6c\xf7\x3a\xef\x87\xe3\x32\x37\x67\x5d\xdd\x1d\x0e\xf2\x93\xf9\x6c\xf5\x68\x46\x29\x8d\x5e\xa8\x53\x94\x1c\x77\xfa\x99\x7a\xd7\x83\x5e\x03\xc5\x60\x34\x92\xe8\xda\x1e\x55\xaa\xc2\xe8\x67\x43\xd1\xd8\xac\x4e\xc2\xd1\xc1\x7e\x6d\x48\x3a\x42\x11\x3c\xe1\xfe\x78\xad\x62\x2e\xad\xc5\x78\x6a\xb6\xa0\xad\x12\xde\x89\x09\x8c\x4b\x69\xfc\xf0\x90\xb3\xd6\xc7\x47\xf8\xb0\x98\x3e\x8e\x17\x5f\xe0\x5f\xc6\x17\xb8\x66\xce\xe9\x3d\xf8\x82\x48\x9b\x6c\xb6\x61\x6d\xf5\xb3\x13\xed\x3a\x1b\x50\x52\x48\x53\xf3\xc1\xf8\xfc\x82\x46\x15\xef\xcb\xe9\x83\xb9\x59\xdf\xb6\x56\xcb\xa9\xf9\x4f\x58\x6b\x89\x08\xd7\x89\xf0\x6d\xa5\x2f\xd4\x79\x1a\xb5\xb7\xb3\xb9\x19\xf7\xca\x5e\x3e\x96\x3b\x6c\x9d\x6b\x87\x86\x7a\x36\xe7\x0e\xea\xfa\xb9\x57\xea\xe5\xb7\xd5\xb6\x5d\x5b\xe3\x04\xc9\x7a\x7f\x58\xff\x5e\xb7\x57\xe6\xf4\xe3\x2a\xf5\xbe\xa4\x3b\x8f\x21\xbd\x76\x2b\xb8\x5f\xf7\x9a\x7d\x9b\xde\xa0\x35\x79\x7e\xa4\xd5\x73\xfa\xcc\x9c\xde\xde\x1e\xa7\xfa\xdb\xda\x8b\x82\x0e\x04\x22\x20\xc1\x45\x40\x24\x8a\xf3\x38\x1a\xfa\xdf\x8b\x60\x55\xd1\x64\x37\x7a\xeb\xfd\xd9\x01\x15\x75\xe7\x31\xa5\x77\x95\x05\x10\xf5\xee\xe5\x4f\xef\x45\x7c\xac\x18\xe8\x77\x6c\x6b\xbc\x65\xdc\xc1\x1d\x29\xdf\xab\x13\xc1\x49\x72\x79\x7e\x56\xd7\x3b\xad\xe5\x71\x64\x97\xfc\x45\xf6\x3e\x08\x9e\x00\xe4\xcc\xe1\x6f\x33\xd4\xed\x7e\x67\x0a\x12\x0a\x88\xf4\x45\x73\xf1\x79\xe8\xbd\xd5\x44\x27\x01\x45\x42\x1d\x5e\x27\x87\x23\x52\x99\x5d\x72\x5f\xc2\xf5\x3a\x3b\x9d\x87\x34\x93\xec\x0f\xe2\xa2\x35\x53\xb0\xf3\x12\x8a\x69\x56\x57\xba\xc5\xbf\x70\x0a\x2a\x1f\x0d\x3a\xb1\x94\x36\xf4\x47\x96\xfb\x86\xf3\x3a\x99\xc9\x7f\x34\xea\x82\x95\x93\xed\x8f\xa8\xee\xf3\xd4\xeb\x40\xab\xfd\x30\xd6\x85\xb1\x6e\x53\x7f\xb0\xe9\xa4\xf8\x3a\x00\xb3\x8b\x9e\x2e\x50\x8d\x93\x7f\x51\xf5\xf1\x8e\xfc\xe2\xdc\x50\x36\x55\x3b\x55\x9d\xca\x10\x45\xa5\xc5\x7b\xe4\x4b\x50\x44\x9b\xbd\x3e\x80\x8a\x3b\x4e\x03\x77\xa1\x9e\x59\xb5\xd2\x0b\x48\x5d\xe7\x8c\x87\x66\xbd\xbb\xd0\x34\x9e\x28\x6e\x18\x08\x5f\x38\x8f\x57\x13\xd2\x9c\x8f\xfc\xf8\x79\xf1\xe3\x52\x35\xf6\xe2\x49\x58\x4b\xea\x60\x36\x1b\xa5\xef\x92\x64\x2d\xc4\xd3\x79\x0a\xaa\xc5\x40\xe7\x08\x76\x7d\x9d\x7e\x17\x1b\xfe\xfa\x2b\x0c\x94\xf0\x1c\x42\x95\x42\x1d\x97\xe2\x60\x34\xd2\xb8\xd3\x37\x37\xb7\xd0\x2c\x68\x0b\xa7\x9f\x20\x53\x2a\x44\xd9\x2c\xba\x16\xa1\xbb\xd5\xbd\xcc\x17\x44\xdb\x1d\x28\x88\x96\x5c\xb8\x81\x4f\xef\x8d\x85\x71\x38\x4f\x70\x0f\x3f\xfd\x94\xcb\x5e\xd3\xbf\xf9\xc0\x16\x7e\xe0\xa1\xc6\x38\x13\xf9\x3f\x02\x3e\x88\x6f\xfc\xca\x91\x22\x80\xf8\x3f\x4e\xf5\xe5\x62\x53\x65\x53\x07\xef\x3a\x04\x8b\x07\xaa\x6d\x53\x8e\x23\x7a\x89\xf5\xd7\x9c\xb6\xb6\x36\x99\xb4\xaa\xda\x64\xb2\x37\x96\x4c\xe8\xff\x01\x00\x00\xff\xff\x5d\xb2\x1f\x7d\x3f\x29\x00\x00")
 
 func migrations1_initial_schemaSqlBytes() ([]byte, error) {
@@ -505,6 +526,7 @@ var _bindata = map[string]func() (*asset, error){
 	"migrations/14_fix_asset_toml_field.sql":            migrations14_fix_asset_toml_fieldSql,
 	"migrations/15_ledger_failed_txs.sql":               migrations15_ledger_failed_txsSql,
 	"migrations/16_ingest_failed_transactions.sql":      migrations16_ingest_failed_transactionsSql,
+	"migrations/17_add_ledger_state_tables.sql":         migrations17_add_ledger_state_tablesSql,
 	"migrations/1_initial_schema.sql":                   migrations1_initial_schemaSql,
 	"migrations/2_index_participants_by_toid.sql":       migrations2_index_participants_by_toidSql,
 	"migrations/3_use_sequence_in_history_accounts.sql": migrations3_use_sequence_in_history_accountsSql,
@@ -567,6 +589,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"14_fix_asset_toml_field.sql":            &bintree{migrations14_fix_asset_toml_fieldSql, map[string]*bintree{}},
 		"15_ledger_failed_txs.sql":               &bintree{migrations15_ledger_failed_txsSql, map[string]*bintree{}},
 		"16_ingest_failed_transactions.sql":      &bintree{migrations16_ingest_failed_transactionsSql, map[string]*bintree{}},
+		"17_add_ledger_state_tables.sql":         &bintree{migrations17_add_ledger_state_tablesSql, map[string]*bintree{}},
 		"1_initial_schema.sql":                   &bintree{migrations1_initial_schemaSql, map[string]*bintree{}},
 		"2_index_participants_by_toid.sql":       &bintree{migrations2_index_participants_by_toidSql, map[string]*bintree{}},
 		"3_use_sequence_in_history_accounts.sql": &bintree{migrations3_use_sequence_in_history_accountsSql, map[string]*bintree{}},
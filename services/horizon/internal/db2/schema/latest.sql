@@ -96,6 +96,55 @@ SET default_tablespace = '';
 
 SET default_with_oids = false;
 
+--
+-- Name: accounts; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE accounts (
+    account_id character varying(56) NOT NULL,
+    balance bigint NOT NULL,
+    buying_liabilities bigint NOT NULL,
+    selling_liabilities bigint NOT NULL,
+    sequence_number bigint NOT NULL,
+    num_subentries integer NOT NULL,
+    inflation_destination character varying(56) NOT NULL,
+    flags integer NOT NULL,
+    home_domain character varying(32) NOT NULL,
+    master_weight smallint NOT NULL,
+    threshold_low smallint NOT NULL,
+    threshold_medium smallint NOT NULL,
+    threshold_high smallint NOT NULL,
+    last_modified_ledger integer NOT NULL,
+    CONSTRAINT accounts_balance_check CHECK ((balance >= 0)),
+    CONSTRAINT accounts_buying_liabilities_check CHECK ((buying_liabilities >= 0)),
+    CONSTRAINT accounts_num_subentries_check CHECK ((num_subentries >= 0)),
+    CONSTRAINT accounts_selling_liabilities_check CHECK ((selling_liabilities >= 0))
+);
+
+
+--
+-- Name: accounts_data; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE accounts_data (
+    account_id character varying(56) NOT NULL,
+    name character varying(64) NOT NULL,
+    value character varying(90) NOT NULL,
+    last_modified_ledger integer NOT NULL
+);
+
+
+--
+-- Name: accounts_signers; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE accounts_signers (
+    account_id character varying(56) NOT NULL,
+    signer character varying(56) NOT NULL,
+    weight integer NOT NULL
+);
+
+
 --
 -- Name: asset_stats; Type: TABLE; Schema: public; Owner: -
 --
@@ -339,6 +388,57 @@ CREATE TABLE history_transactions (
 );
 
 
+--
+-- Name: key_value_store; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE key_value_store (
+    key character varying(255) NOT NULL,
+    value character varying(255) NOT NULL
+);
+
+
+--
+-- Name: offers; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE offers (
+    seller_id character varying(56) NOT NULL,
+    offer_id bigint NOT NULL,
+    selling_asset text NOT NULL,
+    buying_asset text NOT NULL,
+    amount bigint NOT NULL,
+    pricen integer NOT NULL,
+    priced integer NOT NULL,
+    price double precision NOT NULL,
+    flags integer NOT NULL,
+    last_modified_ledger integer NOT NULL,
+    CONSTRAINT offers_amount_check CHECK ((amount >= 0))
+);
+
+
+--
+-- Name: trust_lines; Type: TABLE; Schema: public; Owner: -
+--
+
+CREATE TABLE trust_lines (
+    account_id character varying(56) NOT NULL,
+    asset_type integer NOT NULL,
+    asset_issuer character varying(56) NOT NULL,
+    asset_code character varying(12) NOT NULL,
+    balance bigint NOT NULL,
+    trust_line_limit bigint NOT NULL,
+    buying_liabilities bigint NOT NULL,
+    selling_liabilities bigint NOT NULL,
+    flags integer NOT NULL,
+    last_modified_ledger integer NOT NULL,
+    CONSTRAINT trust_lines_balance_check CHECK ((balance >= 0)),
+    CONSTRAINT trust_lines_buying_liabilities_check CHECK ((buying_liabilities >= 0)),
+    CONSTRAINT trust_lines_selling_liabilities_check CHECK ((selling_liabilities >= 0)),
+    CONSTRAINT trust_lines_trust_line_limit_check CHECK ((trust_line_limit > 0))
+);
+
+
 --
 -- Name: history_assets id; Type: DEFAULT; Schema: public; Owner: -
 --
@@ -360,6 +460,24 @@ ALTER TABLE ONLY history_operation_participants ALTER COLUMN id SET DEFAULT next
 ALTER TABLE ONLY history_transaction_participants ALTER COLUMN id SET DEFAULT nextval('history_transaction_participants_id_seq'::regclass);
 
 
+--
+-- Data for Name: accounts; Type: TABLE DATA; Schema: public; Owner: -
+--
+
+
+
+--
+-- Data for Name: accounts_data; Type: TABLE DATA; Schema: public; Owner: -
+--
+
+
+
+--
+-- Data for Name: accounts_signers; Type: TABLE DATA; Schema: public; Owner: -
+--
+
+
+
 --
 -- Data for Name: asset_stats; Type: TABLE DATA; Schema: public; Owner: -
 --
@@ -387,6 +505,7 @@ INSERT INTO gorp_migrations VALUES ('13_trade_offer_ids.sql', '2019-02-21 13:54:
 INSERT INTO gorp_migrations VALUES ('14_fix_asset_toml_field.sql', '2019-02-21 13:54:34.151707+01');
 INSERT INTO gorp_migrations VALUES ('15_ledger_failed_txs.sql', '2019-02-21 13:54:34.154129+01');
 INSERT INTO gorp_migrations VALUES ('16_ingest_failed_transactions.sql', '2019-02-21 13:54:34.155663+01');
+INSERT INTO gorp_migrations VALUES ('17_add_ledger_state_tables.sql', '2019-03-26 16:04:12.361207+01');
 
 
 --
@@ -471,6 +590,48 @@ SELECT pg_catalog.setval('history_transaction_participants_id_seq', 1, false);
 
 
 
+--
+-- Data for Name: key_value_store; Type: TABLE DATA; Schema: public; Owner: -
+--
+
+
+
+--
+-- Data for Name: offers; Type: TABLE DATA; Schema: public; Owner: -
+--
+
+
+
+--
+-- Data for Name: trust_lines; Type: TABLE DATA; Schema: public; Owner: -
+--
+
+
+
+--
+-- Name: accounts accounts_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY accounts
+    ADD CONSTRAINT accounts_pkey PRIMARY KEY (account_id);
+
+
+--
+-- Name: accounts_data accounts_data_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY accounts_data
+    ADD CONSTRAINT accounts_data_pkey PRIMARY KEY (account_id, name);
+
+
+--
+-- Name: accounts_signers accounts_signers_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY accounts_signers
+    ADD CONSTRAINT accounts_signers_pkey PRIMARY KEY (signer, account_id);
+
+
 --
 -- Name: asset_stats asset_stats_pkey; Type: CONSTRAINT; Schema: public; Owner: -
 --
@@ -519,6 +680,37 @@ ALTER TABLE ONLY history_transaction_participants
     ADD CONSTRAINT history_transaction_participants_pkey PRIMARY KEY (id);
 
 
+--
+-- Name: key_value_store key_value_store_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY key_value_store
+    ADD CONSTRAINT key_value_store_pkey PRIMARY KEY (key);
+
+
+--
+-- Name: offers offers_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY offers
+    ADD CONSTRAINT offers_pkey PRIMARY KEY (offer_id);
+
+
+--
+-- Name: trust_lines trust_lines_pkey; Type: CONSTRAINT; Schema: public; Owner: -
+--
+
+ALTER TABLE ONLY trust_lines
+    ADD CONSTRAINT trust_lines_pkey PRIMARY KEY (account_id, asset_type, asset_issuer, asset_code);
+
+
+--
+-- Name: accounts_signers_by_account; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX accounts_signers_by_account ON accounts_signers USING btree (account_id);
+
+
 --
 -- Name: asset_by_code; Type: INDEX; Schema: public; Owner: -
 --
@@ -764,6 +956,27 @@ CREATE INDEX index_history_operations_on_type ON history_operations USING btree
 CREATE UNIQUE INDEX index_history_transactions_on_id ON history_transactions USING btree (id);
 
 
+--
+-- Name: offers_by_buying_asset; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX offers_by_buying_asset ON offers USING btree (buying_asset);
+
+
+--
+-- Name: offers_by_seller; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX offers_by_seller ON offers USING btree (seller_id);
+
+
+--
+-- Name: offers_by_selling_asset; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX offers_by_selling_asset ON offers USING btree (selling_asset);
+
+
 --
 -- Name: trade_effects_by_order_book; Type: INDEX; Schema: public; Owner: -
 --
@@ -771,6 +984,13 @@ CREATE UNIQUE INDEX index_history_transactions_on_id ON history_transactions USI
 CREATE INDEX trade_effects_by_order_book ON history_effects USING btree (((details ->> 'sold_asset_type'::text)), ((details ->> 'sold_asset_code'::text)), ((details ->> 'sold_asset_issuer'::text)), ((details ->> 'bought_asset_type'::text)), ((details ->> 'bought_asset_code'::text)), ((details ->> 'bought_asset_issuer'::text))) WHERE (type = 33);
 
 
+--
+-- Name: trust_lines_by_asset; Type: INDEX; Schema: public; Owner: -
+--
+
+CREATE INDEX trust_lines_by_asset ON trust_lines USING btree (asset_type, asset_code, asset_issuer);
+
+
 --
 -- Name: asset_stats asset_stats_id_fkey; Type: FK CONSTRAINT; Schema: public; Owner: -
 --
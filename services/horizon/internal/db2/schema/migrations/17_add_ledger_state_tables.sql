@@ -0,0 +1,85 @@
+-- +migrate Up
+
+CREATE TABLE key_value_store (
+  key varchar(255) NOT NULL,
+  value varchar(255) NOT NULL,
+  PRIMARY KEY (key)
+);
+
+CREATE TABLE accounts (
+  account_id character varying(56) NOT NULL,
+  balance bigint NOT NULL CHECK (balance >= 0),
+  buying_liabilities bigint NOT NULL CHECK (buying_liabilities >= 0),
+  selling_liabilities bigint NOT NULL CHECK (selling_liabilities >= 0),
+  sequence_number bigint NOT NULL,
+  num_subentries integer NOT NULL CHECK (num_subentries >= 0),
+  inflation_destination character varying(56) NOT NULL,
+  flags integer NOT NULL,
+  home_domain text NOT NULL,
+  master_weight smallint NOT NULL,
+  threshold_low smallint NOT NULL,
+  threshold_medium smallint NOT NULL,
+  threshold_high smallint NOT NULL,
+  last_modified_ledger integer NOT NULL,
+  PRIMARY KEY (account_id)
+);
+
+CREATE TABLE accounts_data (
+  account_id character varying(56) NOT NULL,
+  name character varying(64) NOT NULL,
+  value character varying(90) NOT NULL,
+  last_modified_ledger integer NOT NULL,
+  PRIMARY KEY (account_id, name)
+);
+
+CREATE TABLE accounts_signers (
+  account_id character varying(56) NOT NULL,
+  signer character varying(56) NOT NULL,
+  weight integer NOT NULL,
+  PRIMARY KEY (signer, account_id)
+);
+
+CREATE INDEX accounts_signers_by_account ON accounts_signers USING btree (account_id);
+
+CREATE TABLE trust_lines (
+  account_id character varying(56) NOT NULL,
+  asset_type integer NOT NULL,
+  asset_issuer character varying(56) NOT NULL,
+  asset_code character varying(12) NOT NULL,
+  balance bigint NOT NULL CHECK (balance >= 0),
+  trust_line_limit bigint NOT NULL CHECK (trust_line_limit > 0),
+  buying_liabilities bigint NOT NULL CHECK (buying_liabilities >= 0),
+  selling_liabilities bigint NOT NULL CHECK (selling_liabilities >= 0),
+  flags integer NOT NULL,
+  last_modified_ledger integer NOT NULL,
+  PRIMARY KEY (account_id, asset_type, asset_issuer, asset_code)
+);
+
+CREATE INDEX trust_lines_by_asset ON trust_lines USING btree (asset_type, asset_code, asset_issuer);
+
+CREATE TABLE offers (
+  seller_id character varying(56) NOT NULL,
+  offer_id bigint NOT NULL,
+  selling_asset text NOT NULL,
+  buying_asset text NOT NULL,
+  amount bigint NOT NULL CHECK (amount >= 0),
+  pricen integer NOT NULL,
+  priced integer NOT NULL,
+  price double precision NOT NULL,
+  flags integer NOT NULL,
+  last_modified_ledger integer NOT NULL,
+  PRIMARY KEY (offer_id)
+);
+
+CREATE INDEX offers_by_seller ON offers USING btree (seller_id);
+CREATE INDEX offers_by_selling_asset ON offers USING btree (selling_asset);
+CREATE INDEX offers_by_buying_asset ON offers USING btree (buying_asset);
+
+-- +migrate Down
+
+DROP TABLE offers cascade;
+DROP TABLE trust_lines cascade;
+DROP TABLE accounts_signers cascade;
+DROP TABLE accounts_data cascade;
+DROP TABLE accounts cascade;
+DROP TABLE key_value_store cascade;
@@ -21,6 +21,7 @@ import (
 	"github.com/stellar/go/support/errors"
 	"github.com/stellar/go/support/render/hal"
 	"github.com/stellar/go/support/render/problem"
+	"github.com/stellar/go/xdr"
 )
 
 // jsonResponderFunc represents the signature of the function that handles
@@ -175,6 +176,100 @@ func (we *web) accountHandler(jfn jsonResponderFunc) http.HandlerFunc {
 	})
 }
 
+// accountIndexHandler gets the filters and the page query of the accounts
+// index from the request and pass them on to streamableEndpointHandler.
+func (we *web) accountIndexHandler(jfn jsonResponderFunc) http.HandlerFunc {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		params, err := getAccountQueryParams(r)
+		if err != nil {
+			problem.Render(ctx, w, err)
+			return
+		}
+
+		we.streamableEndpointHandler(jfn, false, nil, params).ServeHTTP(w, r)
+	})
+}
+
+// getAccountQueryParams gets the available query params for the accounts
+// index. Exactly one of signer and asset must be provided, and the cursor is
+// an account id.
+func getAccountQueryParams(r *http.Request) (*actions.AccountParams, error) {
+	signer, err := getAccountID(r, "signer", false)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting signer")
+	}
+
+	asset, err := getAssetFromURL(r, "asset")
+	if err != nil {
+		return nil, errors.Wrap(err, "getting asset")
+	}
+
+	if (signer == "") == (asset == nil) {
+		return nil, problem.MakeInvalidFieldProblem(
+			"signer",
+			errors.New("exactly one of `signer` and `asset` must be provided"),
+		)
+	}
+
+	cursor, err := getAccountID(r, actions.ParamCursor, false)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting param cursor")
+	}
+
+	order, err := getOrder(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting param order")
+	}
+
+	limit, err := getLimit(r, db2.DefaultPageSize, db2.MaxPageSize)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting param limit")
+	}
+
+	return &actions.AccountParams{
+		SignerFilter: signer,
+		AssetFilter:  asset,
+		PagingParams: db2.PageQuery{
+			Cursor: cursor,
+			Order:  order,
+			Limit:  limit,
+		},
+	}, nil
+}
+
+// getAssetFromURL retrieves the credit asset, in the `code:issuer` form, by the
+// provided key. It returns nil if the param is empty.
+func getAssetFromURL(r *http.Request, key string) (*xdr.Asset, error) {
+	val, err := hchi.GetStringFromURL(r, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if val == "" {
+		return nil, nil
+	}
+
+	parts := strings.Split(val, ":")
+	if len(parts) != 2 {
+		return nil, problem.MakeInvalidFieldProblem(key, errors.New("asset must be in the code:issuer form"))
+	}
+
+	var issuer xdr.AccountId
+	err = issuer.SetAddress(parts[1])
+	if err != nil {
+		return nil, problem.MakeInvalidFieldProblem(key, errors.New("invalid issuer"))
+	}
+
+	var asset xdr.Asset
+	err = asset.SetCredit(parts[0], issuer)
+	if err != nil {
+		return nil, problem.MakeInvalidFieldProblem(key, errors.New("invalid code"))
+	}
+
+	return &asset, nil
+}
+
 // getAccountID retrieves the account id by the provided key. The key is
 // usually "account_id", "source_account", and "destination_account". The
 // function would return an error if the account id is empty and the required
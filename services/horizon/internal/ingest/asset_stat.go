@@ -88,6 +88,10 @@ func (assetStats *AssetStats) IngestOperation(op *xdr.Operation, source *xdr.Acc
 		// if this gets expensive then we can limit it to only include those assets that includes the issuer
 		assetStats.add(body.ManageOfferOp.Buying)
 		assetStats.add(body.ManageOfferOp.Selling)
+	case xdr.OperationTypeManageBuyOffer:
+		// if this gets expensive then we can limit it to only include those assets that includes the issuer
+		assetStats.add(body.ManageBuyOfferOp.Buying)
+		assetStats.add(body.ManageBuyOfferOp.Selling)
 	case xdr.OperationTypeCreatePassiveOffer:
 		// if this gets expensive then we can limit it to only include those assets that includes the issuer
 		assetStats.add(body.CreatePassiveOfferOp.Buying)
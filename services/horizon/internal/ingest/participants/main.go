@@ -46,6 +46,8 @@ func ForOperation(
 		// the only direct participant is the source_account
 	case xdr.OperationTypeBumpSequence:
 		// the only direct participant is the source_account
+	case xdr.OperationTypeManageBuyOffer:
+		// the only direct participant is the source_account
 	default:
 		err = fmt.Errorf("Unknown operation type: %s", op.Body.Type)
 	}
@@ -200,6 +200,9 @@ func (is *Session) ingestEffects() {
 	case xdr.OperationTypeManageOffer:
 		result := is.Cursor.OperationResult().MustManageOfferResult().MustSuccess()
 		is.ingestTradeEffects(effects, source, result.OffersClaimed)
+	case xdr.OperationTypeManageBuyOffer:
+		result := is.Cursor.OperationResult().MustManageBuyOfferResult().MustSuccess()
+		is.ingestTradeEffects(effects, source, result.OffersClaimed)
 	case xdr.OperationTypeCreatePassiveOffer:
 		claims := []xdr.ClaimOfferAtom{}
 		result := is.Cursor.OperationResult()
@@ -544,6 +547,11 @@ func (is *Session) ingestTrades() {
 		trades = manageOfferResult.OffersClaimed
 		buyOffer, buyOfferExists = manageOfferResult.Offer.GetOffer()
 
+	case xdr.OperationTypeManageBuyOffer:
+		manageBuyOfferResult := cursor.OperationResult().MustManageBuyOfferResult().MustSuccess()
+		trades = manageBuyOfferResult.OffersClaimed
+		buyOffer, buyOfferExists = manageBuyOfferResult.Offer.GetOffer()
+
 	case xdr.OperationTypeCreatePassiveOffer:
 		result := cursor.OperationResult()
 
@@ -759,6 +767,17 @@ func (is *Session) operationDetails() map[string]interface{} {
 		}
 		is.assetDetails(details, op.Buying, "buying_")
 		is.assetDetails(details, op.Selling, "selling_")
+	case xdr.OperationTypeManageBuyOffer:
+		op := c.Operation().Body.MustManageBuyOfferOp()
+		details["offer_id"] = op.OfferId
+		details["amount"] = amount.String(op.BuyAmount)
+		details["price"] = op.Price.String()
+		details["price_r"] = map[string]interface{}{
+			"n": op.Price.N,
+			"d": op.Price.D,
+		}
+		is.assetDetails(details, op.Buying, "buying_")
+		is.assetDetails(details, op.Selling, "selling_")
 
 	case xdr.OperationTypeCreatePassiveOffer:
 		op := c.Operation().Body.MustCreatePassiveOfferOp()
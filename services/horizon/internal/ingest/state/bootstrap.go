@@ -0,0 +1,147 @@
+package state
+
+import (
+	"io"
+
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/historyarchive"
+	"github.com/stellar/go/xdr"
+)
+
+// Bootstrap replaces the ledger state tables with the state of the ledger at
+// checkpoint, loaded from the buckets of the history archive. The entries are
+// written with multi-row inserts, in a single database transaction.
+func (s *System) Bootstrap(checkpoint uint32) error {
+	has, err := s.Archive.GetCheckpointHAS(checkpoint)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load history archive state of checkpoint %d", checkpoint)
+	}
+
+	q := &history.Q{Session: s.HorizonDB.Clone()}
+	err = q.Begin()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer q.Rollback()
+
+	err = q.TruncateLedgerState()
+	if err != nil {
+		return err
+	}
+
+	log.WithField("checkpoint", checkpoint).Info("Loading ledger state from history archive...")
+
+	var count int
+	batch := q.NewLedgerEntryBatch()
+	err = readBucketEntries(s.Archive, has, func(entry xdr.LedgerEntry) error {
+		count++
+		return batch.Add(entry)
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to load ledger state from history archive")
+	}
+
+	err = batch.Exec()
+	if err != nil {
+		return errors.Wrap(err, "failed to load ledger state from history archive")
+	}
+
+	err = q.UpdateLastLedgerStateSequence(checkpoint)
+	if err != nil {
+		return errors.Wrap(err, "failed to update last ledger state sequence")
+	}
+
+	err = q.Commit()
+	if err != nil {
+		return errors.Wrap(err, "failed to commit transaction")
+	}
+
+	log.WithField("checkpoint", checkpoint).
+		WithField("entries", count).
+		Info("Finished loading ledger state from history archive")
+	return nil
+}
+
+// readBucketEntries calls fn with each ledger entry of the bucket list of has.
+// Buckets are read from the newest to the oldest: an entry shadowed by a newer
+// bucket, or removed by a dead entry of a newer bucket, is skipped.
+func readBucketEntries(
+	archive *historyarchive.Archive,
+	has historyarchive.HistoryArchiveState,
+	fn func(xdr.LedgerEntry) error,
+) error {
+	seen := map[string]bool{}
+
+	for _, level := range has.CurrentBuckets {
+		for _, bucket := range []string{level.Curr, level.Snap} {
+			hash, err := historyarchive.DecodeHash(bucket)
+			if err != nil {
+				return errors.Wrapf(err, "invalid bucket hash %s", bucket)
+			}
+			if hash.IsZero() {
+				continue
+			}
+
+			err = readBucket(archive, hash, seen, fn)
+			if err != nil {
+				return errors.Wrapf(err, "failed to read bucket %s", bucket)
+			}
+		}
+	}
+	return nil
+}
+
+func readBucket(
+	archive *historyarchive.Archive,
+	hash historyarchive.Hash,
+	seen map[string]bool,
+	fn func(xdr.LedgerEntry) error,
+) error {
+	stream, err := archive.GetXdrStream(historyarchive.BucketPath(hash))
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	for {
+		var entry xdr.BucketEntry
+		err = stream.ReadOne(&entry)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// Since protocol 11, created entries are INITENTRY entries and each
+		// bucket starts with a METAENTRY entry holding its metadata.
+		var key xdr.LedgerKey
+		switch entry.Type {
+		case xdr.BucketEntryTypeLiveentry, xdr.BucketEntryTypeInitentry:
+			key = entry.LiveEntry.LedgerKey()
+		case xdr.BucketEntryTypeDeadentry:
+			key = entry.MustDeadEntry()
+		case xdr.BucketEntryTypeMetaentry:
+			continue
+		default:
+			return errors.Errorf("unknown bucket entry type %d", entry.Type)
+		}
+
+		keyBytes, err := key.MarshalBinary()
+		if err != nil {
+			return errors.Wrap(err, "failed to encode ledger key")
+		}
+		if seen[string(keyBytes)] {
+			continue
+		}
+		seen[string(keyBytes)] = true
+
+		if live, ok := entry.GetLiveEntry(); ok {
+			err = fn(live)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
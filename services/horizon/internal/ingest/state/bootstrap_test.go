@@ -0,0 +1,210 @@
+package state
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stellar/go/support/historyarchive"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestArchive writes a history archive to a temporary directory, whose root
+// and checkpoint history archive states at checkpoint reference buckets, from
+// the newest to the oldest.
+func newTestArchive(t *testing.T, checkpoint uint32, buckets ...[]xdr.BucketEntry) (*historyarchive.Archive, func()) {
+	dir, err := ioutil.TempDir("", "state-archive")
+	require.NoError(t, err)
+	cleanup := func() { os.RemoveAll(dir) }
+
+	archive, err := historyarchive.Connect("file://"+dir, historyarchive.ConnectOptions{})
+	require.NoError(t, err)
+
+	has := historyarchive.HistoryArchiveState{Version: 1, CurrentLedger: checkpoint}
+	zero := historyarchive.Hash{}.String()
+	for i := range has.CurrentBuckets {
+		has.CurrentBuckets[i].Curr = zero
+		has.CurrentBuckets[i].Snap = zero
+	}
+
+	for i, bucket := range buckets {
+		var raw bytes.Buffer
+		for _, entry := range bucket {
+			require.NoError(t, historyarchive.WriteFramedXdr(&raw, entry))
+		}
+		hash := historyarchive.Hash(sha256.Sum256(raw.Bytes()))
+
+		var compressed bytes.Buffer
+		writer := gzip.NewWriter(&compressed)
+		_, err = writer.Write(raw.Bytes())
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+
+		path := filepath.Join(dir, historyarchive.BucketPath(hash))
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, ioutil.WriteFile(path, compressed.Bytes(), 0644))
+
+		if i%2 == 0 {
+			has.CurrentBuckets[i/2].Curr = hash.String()
+		} else {
+			has.CurrentBuckets[i/2].Snap = hash.String()
+		}
+	}
+
+	opts := &historyarchive.CommandOptions{}
+	require.NoError(t, archive.PutCheckpointHAS(checkpoint, has, opts))
+	require.NoError(t, archive.PutRootHAS(has, opts))
+	return archive, cleanup
+}
+
+const (
+	testAlice = "GCXKG6RN4ONIEPCMNFB732A436Z5PNDSRLGWK7GBLCMQLIFO4S7EYWVU"
+	testBob   = "GBXGQJWVLWOYHFLVTKWV5FGHA3LNYY2JQKM7OAJAUEQFU6LPCSEFVXON"
+)
+
+func mustAccountID(address string) xdr.AccountId {
+	var aid xdr.AccountId
+	if err := aid.SetAddress(address); err != nil {
+		panic(err)
+	}
+	return aid
+}
+
+func liveAccountEntry(address string, balance xdr.Int64) xdr.BucketEntry {
+	return xdr.BucketEntry{
+		Type: xdr.BucketEntryTypeLiveentry,
+		LiveEntry: &xdr.LedgerEntry{
+			LastModifiedLedgerSeq: 1,
+			Data: xdr.LedgerEntryData{
+				Type: xdr.LedgerEntryTypeAccount,
+				Account: &xdr.AccountEntry{
+					AccountId:  mustAccountID(address),
+					Balance:    balance,
+					Thresholds: xdr.Thresholds{1, 0, 0, 0},
+				},
+			},
+		},
+	}
+}
+
+func liveOfferEntry(seller string, id xdr.Uint64) xdr.BucketEntry {
+	return xdr.BucketEntry{
+		Type: xdr.BucketEntryTypeLiveentry,
+		LiveEntry: &xdr.LedgerEntry{
+			LastModifiedLedgerSeq: 1,
+			Data: xdr.LedgerEntryData{
+				Type: xdr.LedgerEntryTypeOffer,
+				Offer: &xdr.OfferEntry{
+					SellerId: mustAccountID(seller),
+					OfferId:  id,
+					Selling:  xdr.Asset{Type: xdr.AssetTypeAssetTypeNative},
+					Buying:   xdr.Asset{Type: xdr.AssetTypeAssetTypeNative},
+					Amount:   10,
+					Price:    xdr.Price{N: 1, D: 1},
+				},
+			},
+		},
+	}
+}
+
+func deadEntry(live xdr.BucketEntry) xdr.BucketEntry {
+	key := live.LiveEntry.LedgerKey()
+	return xdr.BucketEntry{Type: xdr.BucketEntryTypeDeadentry, DeadEntry: &key}
+}
+
+func initEntry(live xdr.BucketEntry) xdr.BucketEntry {
+	return xdr.BucketEntry{Type: xdr.BucketEntryTypeInitentry, LiveEntry: live.LiveEntry}
+}
+
+func metaEntry(ledgerVersion xdr.Uint32) xdr.BucketEntry {
+	return xdr.BucketEntry{
+		Type:      xdr.BucketEntryTypeMetaentry,
+		MetaEntry: &xdr.BucketMetadata{LedgerVersion: ledgerVersion},
+	}
+}
+
+func TestReadBucketEntries(t *testing.T) {
+	archive, cleanup := newTestArchive(t, 63,
+		// level 0, curr
+		[]xdr.BucketEntry{
+			liveAccountEntry(testAlice, 20),
+			deadEntry(liveOfferEntry(testAlice, 1)),
+		},
+		// level 0, snap
+		[]xdr.BucketEntry{},
+		// level 1, curr
+		[]xdr.BucketEntry{
+			liveAccountEntry(testAlice, 10),
+			liveOfferEntry(testAlice, 1),
+			liveAccountEntry(testBob, 30),
+			liveOfferEntry(testBob, 2),
+		},
+	)
+	defer cleanup()
+
+	has, err := archive.GetCheckpointHAS(63)
+	require.NoError(t, err)
+
+	var entries []xdr.LedgerEntry
+	err = readBucketEntries(archive, has, func(entry xdr.LedgerEntry) error {
+		entries = append(entries, entry)
+		return nil
+	})
+	require.NoError(t, err)
+
+	// Entries are shadowed by newer buckets and removed by newer dead entries
+	require.Len(t, entries, 3)
+	first := entries[0].Data.MustAccount()
+	assert.Equal(t, testAlice, first.AccountId.Address())
+	assert.Equal(t, xdr.Int64(20), first.Balance)
+	second := entries[1].Data.MustAccount()
+	assert.Equal(t, testBob, second.AccountId.Address())
+	assert.Equal(t, xdr.Int64(30), second.Balance)
+	assert.Equal(t, xdr.Uint64(2), entries[2].Data.MustOffer().OfferId)
+}
+
+func TestReadBucketEntriesProtocol11(t *testing.T) {
+	archive, cleanup := newTestArchive(t, 63,
+		// level 0, curr
+		[]xdr.BucketEntry{
+			metaEntry(11),
+			initEntry(liveOfferEntry(testBob, 3)),
+			liveAccountEntry(testAlice, 20),
+		},
+		// level 0, snap
+		[]xdr.BucketEntry{},
+		// level 1, curr
+		[]xdr.BucketEntry{
+			metaEntry(11),
+			initEntry(liveAccountEntry(testAlice, 10)),
+			initEntry(liveAccountEntry(testBob, 30)),
+		},
+	)
+	defer cleanup()
+
+	has, err := archive.GetCheckpointHAS(63)
+	require.NoError(t, err)
+
+	var entries []xdr.LedgerEntry
+	err = readBucketEntries(archive, has, func(entry xdr.LedgerEntry) error {
+		entries = append(entries, entry)
+		return nil
+	})
+	require.NoError(t, err)
+
+	// Init entries are live entries and meta entries are skipped
+	require.Len(t, entries, 3)
+	assert.Equal(t, xdr.Uint64(3), entries[0].Data.MustOffer().OfferId)
+	first := entries[1].Data.MustAccount()
+	assert.Equal(t, testAlice, first.AccountId.Address())
+	assert.Equal(t, xdr.Int64(20), first.Balance)
+	second := entries[2].Data.MustAccount()
+	assert.Equal(t, testBob, second.AccountId.Address())
+	assert.Equal(t, xdr.Int64(30), second.Balance)
+}
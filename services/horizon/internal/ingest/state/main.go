@@ -0,0 +1,43 @@
+// Package state maintains horizon's own copy of the ledger state: the
+// accounts, signers, trust lines, offers and data entries of the ledger,
+// stored in the `accounts`, `accounts_signers`, `trust_lines`, `offers` and
+// `accounts_data` tables of the horizon database.
+//
+// The state is bootstrapped from the buckets of a history archive checkpoint,
+// then kept up to date by replaying, ledger by ledger, the ledger entry
+// changes stellar-core records for the fees, transactions and upgrades of
+// each ledger.
+package state
+
+import (
+	"sync"
+
+	"github.com/stellar/go/support/db"
+	"github.com/stellar/go/support/historyarchive"
+	ilog "github.com/stellar/go/support/log"
+)
+
+var log = ilog.DefaultLogger.WithField("service", "ingest_state")
+
+// System bootstraps and updates the ledger state tables of the horizon
+// database.
+type System struct {
+	// Archive is the history archive the state is bootstrapped from.
+	Archive *historyarchive.Archive
+	// CoreDB is the stellar-core database the ledgers are replayed from.
+	CoreDB *db.Session
+	// HorizonDB is the horizon database holding the ledger state tables.
+	HorizonDB *db.Session
+
+	lock     sync.Mutex
+	updating bool
+}
+
+// New initializes the state ingestion system.
+func New(archive *historyarchive.Archive, coreDB, horizonDB *db.Session) *System {
+	return &System{
+		Archive:   archive,
+		CoreDB:    coreDB,
+		HorizonDB: horizonDB,
+	}
+}
@@ -0,0 +1,179 @@
+package state
+
+import (
+	"github.com/stellar/go/services/horizon/internal/db2/core"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// Update brings the ledger state tables up to date with the latest ledger in
+// stellar-core. When the state was never ingested, it is first bootstrapped
+// from the latest checkpoint of the history archive that stellar-core already
+// closed. The ledgers closed since the last ingested ledger are then replayed
+// from the stellar-core database, each in its own database transaction. It
+// returns immediately when another update is in progress.
+func (s *System) Update() error {
+	s.lock.Lock()
+	if s.updating {
+		s.lock.Unlock()
+		log.Debug("ingest: ledger state update already in progress")
+		return nil
+	}
+	s.updating = true
+	s.lock.Unlock()
+
+	defer func() {
+		s.lock.Lock()
+		s.updating = false
+		s.lock.Unlock()
+	}()
+
+	var coreLatest int32
+	cq := &core.Q{Session: s.CoreDB}
+	err := cq.LatestLedger(&coreLatest)
+	if err != nil {
+		return errors.Wrap(err, "failed to load core latest ledger")
+	}
+
+	hq := &history.Q{Session: s.HorizonDB}
+	last, err := hq.LastLedgerStateSequence()
+	if err != nil {
+		return errors.Wrap(err, "failed to load last ledger state sequence")
+	}
+
+	if last == 0 {
+		has, err := s.Archive.GetRootHAS()
+		if err != nil {
+			return errors.Wrap(err, "failed to load history archive state")
+		}
+
+		checkpoint := has.CurrentLedger
+		if checkpoint > uint32(coreLatest) {
+			log.WithField("checkpoint", checkpoint).
+				WithField("core_latest", coreLatest).
+				Info("ingest: waiting for stellar-core to reach the latest checkpoint")
+			return nil
+		}
+
+		err = s.Bootstrap(checkpoint)
+		if err != nil {
+			return errors.Wrap(err, "failed to bootstrap ledger state")
+		}
+		last = checkpoint
+	}
+
+	if int32(last) >= coreLatest {
+		return nil
+	}
+
+	var coreElder int32
+	err = cq.ElderLedger(&coreElder)
+	if err != nil {
+		return errors.Wrap(err, "failed to load core elder ledger")
+	}
+
+	if coreElder > int32(last)+1 {
+		return errors.Errorf(
+			"stellar-core database is missing ledgers %d to %d needed to update the ledger state",
+			last+1, coreElder-1,
+		)
+	}
+
+	for seq := int32(last) + 1; seq <= coreLatest; seq++ {
+		err = s.ingestLedger(seq)
+		if err != nil {
+			return errors.Wrapf(err, "failed to update ledger state with ledger %d", seq)
+		}
+	}
+	return nil
+}
+
+// ingestLedger applies the ledger entry changes of the ledger at seq to the
+// ledger state tables.
+func (s *System) ingestLedger(seq int32) error {
+	changes, err := LedgerChanges(&core.Q{Session: s.CoreDB}, seq)
+	if err != nil {
+		return err
+	}
+
+	q := &history.Q{Session: s.HorizonDB.Clone()}
+	err = q.Begin()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer q.Rollback()
+
+	for _, change := range changes {
+		err = applyChange(q, change)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = q.UpdateLastLedgerStateSequence(uint32(seq))
+	if err != nil {
+		return errors.Wrap(err, "failed to update last ledger state sequence")
+	}
+
+	return errors.Wrap(q.Commit(), "failed to commit transaction")
+}
+
+// LedgerChanges loads the ledger entry changes of the ledger at seq from the
+// stellar-core database, in the order stellar-core applied them: the fees of
+// all the transactions, then each transaction, then the upgrades.
+func LedgerChanges(cq *core.Q, seq int32) (xdr.LedgerEntryChanges, error) {
+	var fees []core.TransactionFee
+	err := cq.TransactionFeesByLedger(&fees, seq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load transaction fees")
+	}
+
+	var transactions []core.Transaction
+	err = cq.TransactionsByLedger(&transactions, seq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load transactions")
+	}
+
+	var upgrades []core.LedgerUpgrade
+	err = cq.LedgerUpgradesByLedger(&upgrades, seq)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load ledger upgrades")
+	}
+
+	var changes xdr.LedgerEntryChanges
+	for _, fee := range fees {
+		changes = append(changes, fee.Changes...)
+	}
+	for _, transaction := range transactions {
+		txChanges, err := transaction.ResultMeta.LedgerEntryChanges()
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid meta of transaction %s", transaction.TransactionHash)
+		}
+		changes = append(changes, txChanges...)
+	}
+	for _, upgrade := range upgrades {
+		changes = append(changes, upgrade.Changes...)
+	}
+	return changes, nil
+}
+
+// applyChange writes a single ledger entry change to the ledger state tables.
+// State changes are skipped: they hold the value of an entry before the change
+// that follows them, which the tables already hold.
+func applyChange(q *history.Q, change xdr.LedgerEntryChange) error {
+	var err error
+	switch change.Type {
+	case xdr.LedgerEntryChangeTypeLedgerEntryCreated:
+		err = q.UpsertLedgerEntry(change.MustCreated())
+	case xdr.LedgerEntryChangeTypeLedgerEntryUpdated:
+		err = q.UpsertLedgerEntry(change.MustUpdated())
+	case xdr.LedgerEntryChangeTypeLedgerEntryState:
+		return nil
+	case xdr.LedgerEntryChangeTypeLedgerEntryRemoved:
+		err = q.RemoveLedgerEntry(change.MustRemoved())
+	default:
+		err = errors.Errorf("unknown ledger entry change type %d", change.Type)
+	}
+	return errors.Wrap(err, "failed to apply ledger entry change")
+}
@@ -0,0 +1,82 @@
+package state
+
+import (
+	"testing"
+
+	"github.com/stellar/go/keypair"
+	"github.com/stellar/go/network"
+	"github.com/stellar/go/services/horizon/internal/db2/core"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stellar/go/services/horizon/internal/db2/schema"
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/xdr"
+)
+
+func TestUpdate(t *testing.T) {
+	tt := test.Start(t).ScenarioWithoutHorizon("kahuna")
+	defer tt.Finish()
+
+	_, err := schema.Migrate(tt.HorizonDB.DB, schema.MigrateUp, 0)
+	tt.Require.NoError(err)
+
+	// The state of the genesis ledger only holds the root account
+	root := keypair.Master(network.TestNetworkPassphrase).Address()
+	archive, cleanup := newTestArchive(t, 1, []xdr.BucketEntry{
+		liveAccountEntry(root, 1000000000000000000),
+	})
+	defer cleanup()
+
+	sys := New(archive, tt.CoreSession(), tt.HorizonSession())
+	tt.Require.NoError(sys.Update())
+
+	var coreLatest int32
+	cq := &core.Q{Session: tt.CoreSession()}
+	tt.Require.NoError(cq.LatestLedger(&coreLatest))
+
+	q := &history.Q{Session: tt.HorizonSession()}
+	last, err := q.LastLedgerStateSequence()
+	tt.Require.NoError(err)
+	tt.Assert.Equal(uint32(coreLatest), last)
+
+	// The replayed ledger state matches the one of stellar-core
+	type row struct {
+		ID    string `db:"id"`
+		Value int64  `db:"value"`
+	}
+	queries := []struct {
+		core    string
+		horizon string
+	}{
+		{
+			"SELECT accountid AS id, balance AS value FROM accounts ORDER BY accountid",
+			"SELECT account_id AS id, balance AS value FROM accounts ORDER BY account_id",
+		},
+		{
+			"SELECT accountid AS id, seqnum AS value FROM accounts ORDER BY accountid",
+			"SELECT account_id AS id, sequence_number AS value FROM accounts ORDER BY account_id",
+		},
+		{
+			"SELECT accountid AS id, balance AS value FROM trustlines ORDER BY accountid, balance",
+			"SELECT account_id AS id, balance AS value FROM trust_lines ORDER BY account_id, balance",
+		},
+		{
+			"SELECT sellerid AS id, amount AS value FROM offers ORDER BY offerid",
+			"SELECT seller_id AS id, amount AS value FROM offers ORDER BY offer_id",
+		},
+		{
+			"SELECT accountid AS id, COUNT(*) AS value FROM accountdata GROUP BY accountid ORDER BY accountid",
+			"SELECT account_id AS id, COUNT(*) AS value FROM accounts_data GROUP BY account_id ORDER BY account_id",
+		},
+	}
+
+	for _, query := range queries {
+		var expected, actual []row
+		tt.Require.NoError(tt.CoreSession().SelectRaw(&expected, query.core))
+		tt.Require.NoError(tt.HorizonSession().SelectRaw(&actual, query.horizon))
+		tt.Assert.NotEmpty(actual, query.horizon)
+		tt.Assert.Equal(expected, actual, query.horizon)
+	}
+
+	// Updating again is a no-op
+	tt.Require.NoError(sys.Update())
+}
@@ -311,7 +311,6 @@ func (i *System) runOnce() {
 
 	logFields["duration"] = time.Since(ingestStart).Seconds()
 	log.WithFields(logFields).Info("Finished ingesting ledgers")
-
 	return
 }
 
@@ -11,10 +11,12 @@ import (
 	"github.com/stellar/go/services/horizon/internal/db2/core"
 	"github.com/stellar/go/services/horizon/internal/db2/history"
 	"github.com/stellar/go/services/horizon/internal/ingest"
+	"github.com/stellar/go/services/horizon/internal/ingest/state"
 	"github.com/stellar/go/services/horizon/internal/txsub"
 	results "github.com/stellar/go/services/horizon/internal/txsub/results/db"
 	"github.com/stellar/go/services/horizon/internal/txsub/sequence"
 	"github.com/stellar/go/support/db"
+	"github.com/stellar/go/support/historyarchive"
 	"github.com/stellar/go/support/log"
 )
 
@@ -66,6 +68,15 @@ func initIngester(app *App) {
 
 	app.ingester.SkipCursorUpdate = app.config.SkipCursorUpdate
 	app.ingester.HistoryRetentionCount = app.config.HistoryRetentionCount
+
+	if app.config.HistoryArchiveURL != "" {
+		archive, err := historyarchive.Connect(app.config.HistoryArchiveURL, historyarchive.ConnectOptions{})
+		if err != nil {
+			log.Fatalf("cannot connect to history archive: %v", err)
+		}
+
+		app.stateIngester = state.New(archive, app.CoreSession(nil), app.HorizonSession(nil))
+	}
 }
 
 // initSentry initialized the default sentry client with the configured DSN
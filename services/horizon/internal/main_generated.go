@@ -52,6 +52,18 @@ func (action NotImplementedAction) Handle(w http.ResponseWriter, r *http.Request
 	ap.Execute(&action)
 }
 
+func (action OfferIndexAction) Handle(w http.ResponseWriter, r *http.Request) {
+	ap := &action.Action
+	ap.Prepare(w, r)
+	ap.Execute(&action)
+}
+
+func (action OfferShowAction) Handle(w http.ResponseWriter, r *http.Request) {
+	ap := &action.Action
+	ap.Prepare(w, r)
+	ap.Execute(&action)
+}
+
 func (action OffersByAccountAction) Handle(w http.ResponseWriter, r *http.Request) {
 	ap := &action.Action
 	ap.Prepare(w, r)
@@ -0,0 +1,17 @@
+// Package orderbook provides an implementation of paths.Finder that searches
+// for payment paths in an in-memory graph of all the offers of the ledger,
+// without querying a database.
+//
+// The graph is made of assets, connected by the order books of the offers
+// selling an asset for another. An Updater loads all the offers of
+// stellar-core into the graph once, then applies the offers changes of every
+// closed ledger, so that the graph follows the latest ledger.
+//
+// Like simplepath, the search starts from the destination asset and extends
+// the path towards the source assets: extending a path whose head is A with
+// the asset B consumes the order book of the offers selling A for B, and the
+// amount of B needed becomes the cost of the new path. The paths are extended
+// one asset at a time, keeping only the cheapest paths to each asset, and the
+// number of paths priced by a search is capped. Searches run on a copy of the
+// order books of the graph, so they never block its updates.
+package orderbook
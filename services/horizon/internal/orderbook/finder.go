@@ -0,0 +1,85 @@
+package orderbook
+
+import (
+	"github.com/stellar/go/services/horizon/internal/paths"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/log"
+)
+
+// MaxPathLength is a maximum path length as defined in XDR file (includes source and
+// destination assets).
+const MaxPathLength uint = 7
+
+// Finder implements the paths.Finder interface and searches for payment paths
+// in an in-memory graph of the offers, returning the cheapest paths first.
+type Finder struct {
+	Graph *OrderBookGraph
+}
+
+// ensure the struct is paths.Finder compliant
+var _ paths.Finder = &Finder{}
+
+// Find performs a path find with the provided query.
+func (f *Finder) Find(q paths.Query, maxLength uint) ([]paths.Path, error) {
+	log.WithField("source_assets", q.SourceAssets).
+		WithField("destination_asset", q.DestinationAsset).
+		WithField("destination_amount", q.DestinationAmount).
+		Info("Starting pathfind")
+
+	if len(q.SourceAssets) == 0 {
+		return nil, errors.New("No source assets")
+	}
+
+	return f.find(&search{
+		start:   q.DestinationAsset,
+		amount:  q.DestinationAmount,
+		targets: q.SourceAssets,
+	}, maxLength)
+}
+
+// FindStrictSend performs a strict send path find with the provided query.
+func (f *Finder) FindStrictSend(q paths.StrictSendQuery, maxLength uint) ([]paths.Path, error) {
+	log.WithField("source_asset", q.SourceAsset).
+		WithField("source_amount", q.SourceAmount).
+		WithField("destination_assets", q.DestinationAssets).
+		Info("Starting strict send pathfind")
+
+	if len(q.DestinationAssets) == 0 {
+		return nil, errors.New("No destination assets")
+	}
+
+	return f.find(&search{
+		strictSend: true,
+		start:      q.SourceAsset,
+		amount:     q.SourceAmount,
+		targets:    q.DestinationAssets,
+	}, maxLength)
+}
+
+// find runs s on the graph, with paths of a maximum length `maxLength`.
+func (f *Finder) find(s *search, maxLength uint) ([]paths.Path, error) {
+	if maxLength == 0 {
+		maxLength = MaxPathLength
+	}
+
+	if maxLength < 2 || maxLength > MaxPathLength {
+		return nil, errors.New("invalid value of maxLength")
+	}
+
+	books, assets, ledger := f.Graph.snapshot(s.strictSend)
+	if ledger == 0 {
+		return nil, errors.New("order book graph is not loaded yet")
+	}
+
+	s.books = books
+	s.assets = assets
+	s.maxLength = maxLength
+	s.maxPriced = maxPricedPaths
+	result := s.run()
+
+	log.WithField("found", len(result)).
+		WithField("priced", s.priced).
+		WithField("ledger", ledger).
+		Info("Finished pathfind")
+	return result, nil
+}
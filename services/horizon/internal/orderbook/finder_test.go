@@ -0,0 +1,143 @@
+package orderbook
+
+import (
+	"testing"
+
+	"github.com/stellar/go/services/horizon/internal/db2/core"
+	"github.com/stellar/go/services/horizon/internal/paths"
+	"github.com/stellar/go/services/horizon/internal/simplepath"
+	"github.com/stellar/go/services/horizon/internal/test"
+	"github.com/stellar/go/xdr"
+)
+
+// pathKey returns a representation of p comparable across finders.
+func pathKey(p paths.Path) string {
+	key := p.Source.String()
+	for _, asset := range p.Path {
+		key += " -> " + asset.String()
+	}
+	return key + " -> " + p.Destination.String()
+}
+
+func TestFinderMatchesSimplePath(t *testing.T) {
+	tt := test.Start(t).Scenario("paths")
+	defer tt.Finish()
+
+	graph := NewOrderBookGraph()
+	updater := &Updater{Graph: graph, CoreQ: &core.Q{Session: tt.CoreSession()}}
+	tt.Require.NoError(updater.Update())
+
+	var coreLatest int32
+	tt.Require.NoError(updater.CoreQ.LatestLedger(&coreLatest))
+	tt.Assert.Equal(uint32(coreLatest), graph.LastLedger())
+
+	finder := &Finder{Graph: graph}
+	simpleFinder := &simplepath.Finder{Q: &core.Q{Session: tt.CoreSession()}}
+
+	usd := makeAsset("USD")
+	eur := makeAsset("EUR")
+	ccc := makeAsset("CCC")
+
+	queries := []paths.Query{
+		{
+			DestinationAddress: seller,
+			DestinationAsset:   eur,
+			DestinationAmount:  200000000,
+			SourceAssets:       []xdr.Asset{usd},
+		},
+		{
+			DestinationAddress: seller,
+			DestinationAsset:   eur,
+			DestinationAmount:  200000001,
+			SourceAssets:       []xdr.Asset{usd},
+		},
+		{
+			DestinationAddress: seller,
+			DestinationAsset:   eur,
+			DestinationAmount:  500000001,
+			SourceAssets:       []xdr.Asset{usd},
+		},
+		{
+			DestinationAddress: issuer,
+			DestinationAsset:   native,
+			DestinationAmount:  1,
+			SourceAssets:       []xdr.Asset{usd, native},
+		},
+		{
+			DestinationAddress: issuer,
+			DestinationAsset:   ccc,
+			DestinationAmount:  100000000,
+			SourceAssets:       []xdr.Asset{makeAsset("AAA")},
+		},
+	}
+
+	for _, query := range queries {
+		expected, err := simpleFinder.Find(query, simplepath.MaxPathLength)
+		tt.Require.NoError(err)
+		actual, err := finder.Find(query, MaxPathLength)
+		tt.Require.NoError(err)
+
+		// both finders find the same paths, at the same cost
+		costs := map[string]xdr.Int64{}
+		for _, p := range expected {
+			costs[pathKey(p)] = p.Cost
+		}
+
+		tt.Assert.Len(actual, len(expected))
+		for i, p := range actual {
+			cost, ok := costs[pathKey(p)]
+			if tt.Assert.True(ok, pathKey(p)) {
+				tt.Assert.Equal(cost, p.Cost, pathKey(p))
+			}
+
+			// the cheapest paths come first
+			if i > 0 && p.Source.Equals(actual[i-1].Source) {
+				tt.Assert.True(actual[i-1].Cost <= p.Cost)
+			}
+		}
+	}
+
+	sendQueries := []paths.StrictSendQuery{
+		{
+			SourceAsset:       usd,
+			SourceAmount:      100000000,
+			DestinationAssets: []xdr.Asset{eur},
+		},
+		{
+			SourceAsset:       usd,
+			SourceAmount:      100000001,
+			DestinationAssets: []xdr.Asset{eur, native},
+		},
+		{
+			SourceAsset:       makeAsset("AAA"),
+			SourceAmount:      110000000,
+			DestinationAssets: []xdr.Asset{ccc},
+		},
+	}
+
+	for _, query := range sendQueries {
+		expected, err := simpleFinder.FindStrictSend(query, simplepath.MaxPathLength)
+		tt.Require.NoError(err)
+		actual, err := finder.FindStrictSend(query, MaxPathLength)
+		tt.Require.NoError(err)
+
+		// both finders find the same paths, delivering the same amounts
+		amounts := map[string]xdr.Int64{}
+		for _, p := range expected {
+			amounts[pathKey(p)] = p.DestinationAmount
+		}
+
+		tt.Assert.Len(actual, len(expected))
+		for i, p := range actual {
+			amount, ok := amounts[pathKey(p)]
+			if tt.Assert.True(ok, pathKey(p)) {
+				tt.Assert.Equal(amount, p.DestinationAmount, pathKey(p))
+			}
+
+			// the most rewarding paths come first
+			if i > 0 && p.Destination.Equals(actual[i-1].Destination) {
+				tt.Assert.True(actual[i-1].DestinationAmount >= p.DestinationAmount)
+			}
+		}
+	}
+}
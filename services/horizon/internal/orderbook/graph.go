@@ -0,0 +1,236 @@
+package orderbook
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// tradingPair identifies the order book of the offers selling an asset for
+// another, by the string representation of both assets.
+type tradingPair struct {
+	selling string
+	buying  string
+}
+
+// OrderBookGraph is an in-memory graph of the offers of a ledger. It is safe
+// for concurrent use: searches run on a copy of its order books, taken while
+// the graph is briefly locked, so they never block its updates.
+type OrderBookGraph struct {
+	lock sync.RWMutex
+
+	// booksBySelling maps a selling asset to the order books of the offers
+	// selling it, by buying asset.
+	booksBySelling map[string]map[string]*orderBook
+	// booksByBuying maps a buying asset to the order books of the offers
+	// buying it, by selling asset.
+	booksByBuying map[string]map[string]*orderBook
+	// assets maps the string representation of the assets of the graph to
+	// the assets.
+	assets map[string]xdr.Asset
+	// pairs maps the id of the offers of the graph to their order book.
+	pairs map[xdr.Uint64]tradingPair
+	// lastLedger is the sequence of the ledger whose offers the graph holds,
+	// 0 until the graph is loaded.
+	lastLedger uint32
+}
+
+// NewOrderBookGraph returns an empty graph, not yet loaded.
+func NewOrderBookGraph() *OrderBookGraph {
+	graph := &OrderBookGraph{}
+	graph.clear()
+	return graph
+}
+
+// LastLedger returns the sequence of the ledger whose offers the graph holds,
+// or 0 when the graph was never loaded.
+func (graph *OrderBookGraph) LastLedger() uint32 {
+	graph.lock.RLock()
+	defer graph.lock.RUnlock()
+	return graph.lastLedger
+}
+
+// Reset replaces the offers of the graph with the offers of the ledger at
+// sequence ledger.
+func (graph *OrderBookGraph) Reset(ledger uint32, offers []xdr.OfferEntry) {
+	graph.lock.Lock()
+	defer graph.lock.Unlock()
+
+	graph.clear()
+	for _, offer := range offers {
+		graph.addOffer(offer)
+	}
+	graph.lastLedger = ledger
+}
+
+// Apply updates the graph with the offers changes of the ledger following the
+// last ledger of the graph. The other ledger entry changes are ignored.
+func (graph *OrderBookGraph) Apply(ledger uint32, changes xdr.LedgerEntryChanges) error {
+	graph.lock.Lock()
+	defer graph.lock.Unlock()
+
+	if graph.lastLedger == 0 || ledger != graph.lastLedger+1 {
+		return errors.Errorf("cannot apply ledger %d to a graph at ledger %d", ledger, graph.lastLedger)
+	}
+
+	for _, change := range changes {
+		switch change.Type {
+		case xdr.LedgerEntryChangeTypeLedgerEntryCreated:
+			graph.upsertOffer(change.MustCreated())
+		case xdr.LedgerEntryChangeTypeLedgerEntryUpdated:
+			graph.upsertOffer(change.MustUpdated())
+		case xdr.LedgerEntryChangeTypeLedgerEntryState:
+			continue
+		case xdr.LedgerEntryChangeTypeLedgerEntryRemoved:
+			key := change.MustRemoved()
+			if offer, ok := key.GetOffer(); ok {
+				graph.removeOffer(offer.OfferId)
+			}
+		default:
+			return errors.Errorf("unknown ledger entry change type %d", change.Type)
+		}
+	}
+
+	graph.lastLedger = ledger
+	return nil
+}
+
+// snapshot returns a copy of the order books of the graph, by selling asset
+// or, when byBuying is set, by buying asset, its assets and its last ledger.
+// The offers of an order book are never modified in place, so the copy can be
+// read while the graph is updated.
+func (graph *OrderBookGraph) snapshot(byBuying bool) (map[string]map[string]orderBook, map[string]xdr.Asset, uint32) {
+	graph.lock.RLock()
+	defer graph.lock.RUnlock()
+
+	source := graph.booksBySelling
+	if byBuying {
+		source = graph.booksByBuying
+	}
+
+	books := make(map[string]map[string]orderBook, len(source))
+	for from, byAsset := range source {
+		books[from] = make(map[string]orderBook, len(byAsset))
+		for to, book := range byAsset {
+			books[from][to] = *book
+		}
+	}
+
+	assets := make(map[string]xdr.Asset, len(graph.assets))
+	for id, asset := range graph.assets {
+		assets[id] = asset
+	}
+	return books, assets, graph.lastLedger
+}
+
+func (graph *OrderBookGraph) clear() {
+	graph.booksBySelling = map[string]map[string]*orderBook{}
+	graph.booksByBuying = map[string]map[string]*orderBook{}
+	graph.assets = map[string]xdr.Asset{}
+	graph.pairs = map[xdr.Uint64]tradingPair{}
+	graph.lastLedger = 0
+}
+
+func (graph *OrderBookGraph) upsertOffer(entry xdr.LedgerEntry) {
+	offer, ok := entry.Data.GetOffer()
+	if !ok {
+		return
+	}
+
+	graph.removeOffer(offer.OfferId)
+	graph.addOffer(offer)
+}
+
+func (graph *OrderBookGraph) addOffer(offer xdr.OfferEntry) {
+	pair := tradingPair{
+		selling: offer.Selling.String(),
+		buying:  offer.Buying.String(),
+	}
+	graph.assets[pair.selling] = offer.Selling
+	graph.assets[pair.buying] = offer.Buying
+
+	book, ok := graph.booksBySelling[pair.selling][pair.buying]
+	if !ok {
+		book = &orderBook{}
+		addBook(graph.booksBySelling, pair.selling, pair.buying, book)
+		addBook(graph.booksByBuying, pair.buying, pair.selling, book)
+	}
+
+	book.add(offer)
+	graph.pairs[offer.OfferId] = pair
+}
+
+func (graph *OrderBookGraph) removeOffer(offerID xdr.Uint64) {
+	pair, ok := graph.pairs[offerID]
+	if !ok {
+		return
+	}
+	delete(graph.pairs, offerID)
+
+	book := graph.booksBySelling[pair.selling][pair.buying]
+	book.remove(offerID)
+	if len(book.offers) > 0 {
+		return
+	}
+
+	removeBook(graph.booksBySelling, pair.selling, pair.buying)
+	removeBook(graph.booksByBuying, pair.buying, pair.selling)
+}
+
+func addBook(books map[string]map[string]*orderBook, from, to string, book *orderBook) {
+	if _, ok := books[from]; !ok {
+		books[from] = map[string]*orderBook{}
+	}
+	books[from][to] = book
+}
+
+func removeBook(books map[string]map[string]*orderBook, from, to string) {
+	delete(books[from], to)
+	if len(books[from]) == 0 {
+		delete(books, from)
+	}
+}
+
+// orderBook holds the offers selling an asset for another, sorted the way
+// stellar-core crosses them: by price, then by offer id. Adding or removing an
+// offer replaces the offers slice, leaving the copies of the order book taken
+// by the searches untouched.
+type orderBook struct {
+	offers []xdr.OfferEntry
+}
+
+func (book *orderBook) add(offer xdr.OfferEntry) {
+	i := sort.Search(len(book.offers), func(i int) bool {
+		return less(offer, book.offers[i])
+	})
+
+	offers := make([]xdr.OfferEntry, 0, len(book.offers)+1)
+	offers = append(offers, book.offers[:i]...)
+	offers = append(offers, offer)
+	book.offers = append(offers, book.offers[i:]...)
+}
+
+func (book *orderBook) remove(offerID xdr.Uint64) {
+	for i, offer := range book.offers {
+		if offer.OfferId == offerID {
+			offers := make([]xdr.OfferEntry, 0, len(book.offers)-1)
+			offers = append(offers, book.offers[:i]...)
+			book.offers = append(offers, book.offers[i+1:]...)
+			return
+		}
+	}
+}
+
+// less returns true if a is crossed before b.
+func less(a, b xdr.OfferEntry) bool {
+	// prices are positive int32 fractions, so their cross products fit in
+	// an int64
+	left := int64(a.Price.N) * int64(b.Price.D)
+	right := int64(b.Price.N) * int64(a.Price.D)
+	if left != right {
+		return left < right
+	}
+	return a.OfferId < b.OfferId
+}
@@ -0,0 +1,432 @@
+package orderbook
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stellar/go/services/horizon/internal/paths"
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	issuer = "GDSBCQO34HWPGUGQSP3QBFEXVTSR2PW46UIGTHVWGWJGQKH3AFNHXHXN"
+	seller = "GAEDTJ4PPEFVW5XV2S7LUXBEHNQMX5Q2GM562RJGOQG7GVCE5H3HIB4V"
+)
+
+var (
+	native = xdr.Asset{Type: xdr.AssetTypeAssetTypeNative}
+	usd    = makeAsset("USD")
+	eur    = makeAsset("EUR")
+	chf    = makeAsset("CHF")
+)
+
+func makeAsset(code string) xdr.Asset {
+	var asset xdr.Asset
+	err := asset.SetCredit(code, mustAccountID(issuer))
+	if err != nil {
+		panic(err)
+	}
+	return asset
+}
+
+func mustAccountID(address string) xdr.AccountId {
+	var aid xdr.AccountId
+	if err := aid.SetAddress(address); err != nil {
+		panic(err)
+	}
+	return aid
+}
+
+func makeOffer(id xdr.Uint64, selling, buying xdr.Asset, amount xdr.Int64, n, d xdr.Int32) xdr.OfferEntry {
+	return xdr.OfferEntry{
+		SellerId: mustAccountID(seller),
+		OfferId:  id,
+		Selling:  selling,
+		Buying:   buying,
+		Amount:   amount,
+		Price:    xdr.Price{N: n, D: d},
+	}
+}
+
+func offerChange(typ xdr.LedgerEntryChangeType, offer xdr.OfferEntry) xdr.LedgerEntryChange {
+	entry := xdr.LedgerEntry{
+		Data: xdr.LedgerEntryData{Type: xdr.LedgerEntryTypeOffer, Offer: &offer},
+	}
+
+	switch typ {
+	case xdr.LedgerEntryChangeTypeLedgerEntryCreated:
+		return xdr.LedgerEntryChange{Type: typ, Created: &entry}
+	case xdr.LedgerEntryChangeTypeLedgerEntryUpdated:
+		return xdr.LedgerEntryChange{Type: typ, Updated: &entry}
+	case xdr.LedgerEntryChangeTypeLedgerEntryState:
+		return xdr.LedgerEntryChange{Type: typ, State: &entry}
+	default:
+		key := entry.LedgerKey()
+		return xdr.LedgerEntryChange{Type: typ, Removed: &key}
+	}
+}
+
+func offerIDs(graph *OrderBookGraph, selling, buying xdr.Asset) []xdr.Uint64 {
+	book, ok := graph.booksBySelling[selling.String()][buying.String()]
+	if !ok {
+		return nil
+	}
+
+	var ids []xdr.Uint64
+	for _, offer := range book.offers {
+		ids = append(ids, offer.OfferId)
+	}
+	return ids
+}
+
+func TestOrderBookGraph(t *testing.T) {
+	graph := NewOrderBookGraph()
+	assert.Equal(t, uint32(0), graph.LastLedger())
+
+	graph.Reset(10, []xdr.OfferEntry{
+		makeOffer(1, eur, usd, 10, 1, 1),
+		makeOffer(2, eur, usd, 10, 1, 2),
+		makeOffer(3, eur, usd, 10, 1, 2),
+		makeOffer(4, usd, native, 10, 3, 1),
+	})
+	assert.Equal(t, uint32(10), graph.LastLedger())
+
+	// offers are sorted by price, then by id
+	assert.Equal(t, []xdr.Uint64{2, 3, 1}, offerIDs(graph, eur, usd))
+	assert.Equal(t, []xdr.Uint64{4}, offerIDs(graph, usd, native))
+
+	books, _, ledger := graph.snapshot(false)
+	assert.Equal(t, uint32(10), ledger)
+
+	err := graph.Apply(12, nil)
+	assert.EqualError(t, err, "cannot apply ledger 12 to a graph at ledger 10")
+
+	err = graph.Apply(11, xdr.LedgerEntryChanges{
+		offerChange(xdr.LedgerEntryChangeTypeLedgerEntryState, makeOffer(1, eur, usd, 10, 1, 1)),
+		offerChange(xdr.LedgerEntryChangeTypeLedgerEntryUpdated, makeOffer(1, eur, usd, 10, 1, 4)),
+		offerChange(xdr.LedgerEntryChangeTypeLedgerEntryRemoved, makeOffer(3, eur, usd, 10, 1, 2)),
+		offerChange(xdr.LedgerEntryChangeTypeLedgerEntryRemoved, makeOffer(4, usd, native, 10, 3, 1)),
+		offerChange(xdr.LedgerEntryChangeTypeLedgerEntryCreated, makeOffer(5, native, chf, 10, 1, 1)),
+		// updating an offer can change its trading pair
+		offerChange(xdr.LedgerEntryChangeTypeLedgerEntryUpdated, makeOffer(2, eur, chf, 10, 1, 2)),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, uint32(11), graph.LastLedger())
+
+	assert.Equal(t, []xdr.Uint64{1}, offerIDs(graph, eur, usd))
+	assert.Equal(t, []xdr.Uint64{2}, offerIDs(graph, eur, chf))
+	assert.Equal(t, []xdr.Uint64{5}, offerIDs(graph, native, chf))
+	assert.Nil(t, offerIDs(graph, usd, native))
+
+	// the copies of the order books taken before an update are unchanged
+	var snapshotIDs []xdr.Uint64
+	for _, offer := range books[eur.String()][usd.String()].offers {
+		snapshotIDs = append(snapshotIDs, offer.OfferId)
+	}
+	assert.Equal(t, []xdr.Uint64{2, 3, 1}, snapshotIDs)
+	assert.Contains(t, books, usd.String())
+
+	// empty order books are removed from the graph
+	assert.NotContains(t, graph.booksBySelling, usd.String())
+	assert.Len(t, graph.booksByBuying[chf.String()], 2)
+	assert.Len(t, graph.pairs, 3)
+
+	graph.Reset(20, nil)
+	assert.Equal(t, uint32(20), graph.LastLedger())
+	assert.Empty(t, graph.booksBySelling)
+	assert.Empty(t, graph.booksByBuying)
+	assert.Empty(t, graph.pairs)
+}
+
+func TestFinder(t *testing.T) {
+	graph := NewOrderBookGraph()
+	finder := &Finder{Graph: graph}
+
+	query := paths.Query{
+		DestinationAddress: seller,
+		DestinationAsset:   eur,
+		DestinationAmount:  200000000, // 20.0000000
+		SourceAssets:       []xdr.Asset{usd, native},
+	}
+
+	_, err := finder.Find(query, MaxPathLength)
+	assert.EqualError(t, err, "order book graph is not loaded yet")
+
+	graph.Reset(2, []xdr.OfferEntry{
+		// selling 10 EUR for USD, price = 0.5
+		makeOffer(1, eur, usd, 100000000, 1, 2),
+		// selling 20 EUR for USD, price = 2
+		makeOffer(2, eur, usd, 200000000, 2, 1),
+		// selling 20 EUR for CHF, price = 1
+		makeOffer(3, eur, chf, 200000000, 1, 1),
+		// selling 20 CHF for USD, price = 1
+		makeOffer(4, chf, usd, 200000000, 1, 1),
+		// selling 100 CHF for XLM, price = 10
+		makeOffer(5, chf, native, 1000000000, 10, 1),
+		// selling 5 EUR for XLM, price = 1
+		makeOffer(6, eur, native, 50000000, 1, 1),
+	})
+
+	found, err := finder.Find(query, MaxPathLength)
+	require.NoError(t, err)
+	if assert.Len(t, found, 3) {
+		// paths are ordered by source asset, then by cost
+		assert.Equal(t, usd, found[0].Source)
+		assert.Equal(t, eur, found[0].Destination)
+		assert.Equal(t, xdr.Int64(200000000), found[0].Cost)
+		assert.Equal(t, []xdr.Asset{chf}, found[0].Path)
+
+		assert.Equal(t, usd, found[1].Source)
+		assert.Equal(t, xdr.Int64(250000000), found[1].Cost)
+		assert.Empty(t, found[1].Path)
+
+		// selling XLM for EUR directly lacks depth
+		assert.Equal(t, native, found[2].Source)
+		assert.Equal(t, xdr.Int64(2000000000), found[2].Cost)
+		assert.Equal(t, []xdr.Asset{chf}, found[2].Path)
+	}
+
+	// paths are not longer than maxLength
+	found, err = finder.Find(query, 2)
+	require.NoError(t, err)
+	if assert.Len(t, found, 1) {
+		assert.Equal(t, usd, found[0].Source)
+		assert.Empty(t, found[0].Path)
+	}
+
+	// the destination asset is its own source
+	query.SourceAssets = []xdr.Asset{eur}
+	found, err = finder.Find(query, MaxPathLength)
+	require.NoError(t, err)
+	if assert.Len(t, found, 1) {
+		assert.Equal(t, eur, found[0].Source)
+		assert.Equal(t, query.DestinationAmount, found[0].Cost)
+		assert.Empty(t, found[0].Path)
+	}
+
+	query.SourceAssets = nil
+	_, err = finder.Find(query, MaxPathLength)
+	assert.EqualError(t, err, "No source assets")
+
+	query.SourceAssets = []xdr.Asset{usd}
+	_, err = finder.Find(query, MaxPathLength+1)
+	assert.EqualError(t, err, "invalid value of maxLength")
+}
+
+func TestFinderStrictSend(t *testing.T) {
+	graph := NewOrderBookGraph()
+	finder := &Finder{Graph: graph}
+	graph.Reset(2, []xdr.OfferEntry{
+		// selling 10 EUR for USD, price = 0.5
+		makeOffer(1, eur, usd, 100000000, 1, 2),
+		// selling 20 EUR for USD, price = 2
+		makeOffer(2, eur, usd, 200000000, 2, 1),
+		// selling 20 EUR for CHF, price = 1
+		makeOffer(3, eur, chf, 200000000, 1, 1),
+		// selling 20 CHF for USD, price = 1
+		makeOffer(4, chf, usd, 200000000, 1, 1),
+		// selling 100 CHF for XLM, price = 10
+		makeOffer(5, chf, native, 1000000000, 10, 1),
+		// selling 5 EUR for XLM, price = 1
+		makeOffer(6, eur, native, 50000000, 1, 1),
+	})
+
+	query := paths.StrictSendQuery{
+		SourceAsset:       usd,
+		SourceAmount:      200000000, // 20.0000000
+		DestinationAssets: []xdr.Asset{eur, native},
+	}
+
+	found, err := finder.FindStrictSend(query, MaxPathLength)
+	require.NoError(t, err)
+	if assert.Len(t, found, 2) {
+		// paths are ordered by destination asset, then by destination amount
+		assert.Equal(t, usd, found[0].Source)
+		assert.Equal(t, eur, found[0].Destination)
+		assert.Equal(t, xdr.Int64(200000000), found[0].Cost)
+		assert.Equal(t, xdr.Int64(200000000), found[0].DestinationAmount)
+		assert.Equal(t, []xdr.Asset{chf}, found[0].Path)
+		assert.Equal(t, float64(0), found[0].Slippage)
+
+		// buying all the first offer, then 15 USD worth of the second one
+		assert.Equal(t, usd, found[1].Source)
+		assert.Equal(t, eur, found[1].Destination)
+		assert.Equal(t, xdr.Int64(175000000), found[1].DestinationAmount)
+		assert.Empty(t, found[1].Path)
+		assert.InDelta(t, 0.5625, found[1].Slippage, 1e-9)
+	}
+
+	// selling XLM for EUR directly lacks depth
+	query.SourceAsset = native
+	query.SourceAmount = 1000000000
+	query.DestinationAssets = []xdr.Asset{eur}
+	found, err = finder.FindStrictSend(query, MaxPathLength)
+	require.NoError(t, err)
+	if assert.Len(t, found, 1) {
+		assert.Equal(t, native, found[0].Source)
+		assert.Equal(t, eur, found[0].Destination)
+		assert.Equal(t, xdr.Int64(100000000), found[0].DestinationAmount)
+		assert.Equal(t, []xdr.Asset{chf}, found[0].Path)
+	}
+
+	// paths are not longer than maxLength
+	found, err = finder.FindStrictSend(query, 2)
+	require.NoError(t, err)
+	assert.Empty(t, found)
+
+	query.DestinationAssets = nil
+	_, err = finder.FindStrictSend(query, MaxPathLength)
+	assert.EqualError(t, err, "No destination assets")
+}
+
+func TestFinderPrunesPaths(t *testing.T) {
+	graph := NewOrderBookGraph()
+	finder := &Finder{Graph: graph}
+
+	// EUR can be bought with USD through 6 assets, at increasing prices
+	var offers []xdr.OfferEntry
+	for i := 1; i <= 6; i++ {
+		asset := makeAsset(fmt.Sprintf("AS%d", i))
+		offers = append(offers,
+			makeOffer(xdr.Uint64(2*i), eur, asset, 1000000000, 1, 1),
+			makeOffer(xdr.Uint64(2*i+1), asset, usd, 1000000000, xdr.Int32(i), 1),
+		)
+	}
+	graph.Reset(2, offers)
+
+	query := paths.Query{
+		DestinationAddress: seller,
+		DestinationAsset:   eur,
+		DestinationAmount:  100000000,
+		SourceAssets:       []xdr.Asset{usd},
+	}
+
+	// only the cheapest paths to USD of the same length are extended
+	found, err := finder.Find(query, MaxPathLength)
+	require.NoError(t, err)
+	if assert.Len(t, found, maxAssetPaths) {
+		for i, p := range found {
+			assert.Equal(t, []xdr.Asset{makeAsset(fmt.Sprintf("AS%d", i+1))}, p.Path)
+			assert.Equal(t, xdr.Int64(100000000*(i+1)), p.Cost)
+		}
+	}
+}
+
+func TestSearchMaxPriced(t *testing.T) {
+	graph := NewOrderBookGraph()
+
+	// EUR can be bought with 6 assets
+	var offers []xdr.OfferEntry
+	var targets []xdr.Asset
+	for i := 1; i <= 6; i++ {
+		asset := makeAsset(fmt.Sprintf("AS%d", i))
+		offers = append(offers, makeOffer(xdr.Uint64(i), eur, asset, 1000000000, 1, 1))
+		targets = append(targets, asset)
+	}
+	graph.Reset(2, offers)
+	books, assets, _ := graph.snapshot(false)
+
+	// only 3 of the 6 paths are priced, and they are always the same ones
+	for i := 0; i < 20; i++ {
+		s := &search{
+			books:     books,
+			assets:    assets,
+			maxLength: MaxPathLength,
+			maxPriced: 3,
+			start:     eur,
+			amount:    100000000,
+			targets:   targets,
+		}
+		found := s.run()
+		assert.Equal(t, 3, s.priced)
+		if assert.Len(t, found, 3) {
+			for j, path := range found {
+				assert.Equal(t, targets[j], path.Source)
+			}
+		}
+	}
+}
+
+func TestConvertToBuyingUnits(t *testing.T) {
+	testCases := []struct {
+		sellingOfferAmount int64
+		sellingUnitsNeeded int64
+		pricen             int64
+		priced             int64
+		wantBuyingUnits    int64
+		wantSellingUnits   int64
+	}{
+		{7, 2, 3, 7, 1, 2},
+		{math.MaxInt64, 2, 3, 7, 1, 2},
+		{20, 20, 1, 4, 5, 20},
+		{20, 100, 1, 4, 5, 20},
+		{20, 20, 7, 11, 13, 19},
+		{20, 20, 11, 7, 32, 20},
+		{20, 100, 7, 11, 13, 19},
+		{20, 100, 11, 7, 32, 20},
+		{1, 0, 3, 7, 0, 0},
+		{1, 0, 7, 3, 0, 0},
+		{math.MaxInt64, 0, 3, 7, 0, 0},
+	}
+	for _, kase := range testCases {
+		buyingUnits, sellingUnits, err := convertToBuyingUnits(kase.sellingOfferAmount, kase.sellingUnitsNeeded, kase.pricen, kase.priced)
+		if assert.NoError(t, err) {
+			assert.Equal(t, kase.wantBuyingUnits, buyingUnits)
+			assert.Equal(t, kase.wantSellingUnits, sellingUnits)
+		}
+	}
+}
+
+func TestOrderBookCost(t *testing.T) {
+	book := &orderBook{}
+	book.add(makeOffer(3, eur, usd, 100000000, 1, 1))
+	book.add(makeOffer(1, eur, usd, 100000000, 1, 4))
+	book.add(makeOffer(2, eur, usd, 100000000, 1, 2))
+
+	testCases := []struct {
+		scenario    string
+		eur         xdr.Int64
+		wantCostUSD xdr.Int64
+	}{
+		{"first unit", 2, 1},
+		{"first full offer", 100000000, 25000000},
+		{"first full offer + 1", 100000002, 25000001},
+		{"first two full offers", 200000000, 75000000},
+		{"first three full offers", 300000000, 175000000},
+	}
+
+	for _, kase := range testCases {
+		cost, err := book.cost(kase.eur)
+		if assert.NoError(t, err, kase.scenario) {
+			assert.Equal(t, kase.wantCostUSD, cost, kase.scenario)
+		}
+	}
+
+	_, err := book.cost(300000001)
+	assert.Equal(t, ErrNotEnough, err)
+
+	receivedCases := []struct {
+		scenario string
+		usd      xdr.Int64
+		wantEUR  xdr.Int64
+	}{
+		{"first unit", 1, 4},
+		{"first full offer", 25000000, 100000000},
+		{"first full offer + 1", 25000001, 100000002},
+		{"first two full offers", 75000000, 200000000},
+		{"first three full offers", 175000000, 300000000},
+	}
+
+	for _, kase := range receivedCases {
+		received, err := book.received(kase.usd)
+		if assert.NoError(t, err, kase.scenario) {
+			assert.Equal(t, kase.wantEUR, received, kase.scenario)
+		}
+	}
+
+	_, err = book.received(175000001)
+	assert.Equal(t, ErrNotEnough, err)
+}
@@ -0,0 +1,423 @@
+package orderbook
+
+import (
+	"math"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/stellar/go/services/horizon/internal/paths"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/xdr"
+)
+
+// ErrNotEnough represents an error that occurs when pricing a trade on an
+// order book. This error occurs when the order book cannot fulfill the
+// requested amount.
+var ErrNotEnough = errors.New("not enough depth")
+
+// maxResults is the maximum number of paths returned by a search.
+const maxResults = 20
+
+// cost returns the amount of the buying asset of the order book needed to buy
+// sellingAmount of its selling asset, crossing the offers the way
+// stellar-core does.
+func (book *orderBook) cost(sellingAmount xdr.Int64) (xdr.Int64, error) {
+	remaining := int64(sellingAmount)
+	var buyingAmount int64
+	for _, offer := range book.offers {
+		buyingUnits, sellingUnits, err := convertToBuyingUnits(
+			int64(offer.Amount),
+			remaining,
+			int64(offer.Price.N),
+			int64(offer.Price.D),
+		)
+		if err != nil {
+			return 0, err
+		}
+
+		if buyingAmount > math.MaxInt64-buyingUnits {
+			return 0, errors.Errorf("adding these two values will cause an integer overflow: %d, %d", buyingAmount, buyingUnits)
+		}
+		buyingAmount += buyingUnits
+		remaining -= sellingUnits
+
+		if remaining <= 0 {
+			return xdr.Int64(buyingAmount), nil
+		}
+	}
+	return 0, ErrNotEnough
+}
+
+// received returns the amount of the selling asset of the order book bought
+// by spending buyingAmount of its buying asset, crossing the offers the way
+// stellar-core does.
+func (book *orderBook) received(buyingAmount xdr.Int64) (xdr.Int64, error) {
+	remaining := int64(buyingAmount)
+	var sellingAmount int64
+	for _, offer := range book.offers {
+		n, d := int64(offer.Price.N), int64(offer.Price.D)
+		buyingUnits, sellingUnits, err := convertToBuyingUnits(int64(offer.Amount), int64(offer.Amount), n, d)
+		if err != nil {
+			return 0, err
+		}
+
+		// the remaining amount only buys a part of the offer
+		if buyingUnits > remaining {
+			sellingUnits, err = mulFraction(remaining, d, n, false)
+			if err != nil {
+				return 0, err
+			}
+			buyingUnits = remaining
+		}
+
+		if sellingAmount > math.MaxInt64-sellingUnits {
+			return 0, errors.Errorf("adding these two values will cause an integer overflow: %d, %d", sellingAmount, sellingUnits)
+		}
+		sellingAmount += sellingUnits
+		remaining -= buyingUnits
+
+		if remaining <= 0 {
+			return xdr.Int64(sellingAmount), nil
+		}
+	}
+	return 0, ErrNotEnough
+}
+
+// convertToBuyingUnits returns the units of the buying asset paid, and the
+// units of the selling asset taken, when crossing an offer selling
+// sellingOfferAmount at the price pricen/priced to get sellingUnitsNeeded.
+// It follows the rounding of stellar-core, see
+// https://github.com/stellar/stellar-core/blob/9af27ef4e20b66f38ab148d52ba7904e74fe502f/src/util/types.cpp#L201
+func convertToBuyingUnits(sellingOfferAmount, sellingUnitsNeeded, pricen, priced int64) (int64, int64, error) {
+	// the amount the offer can actually be crossed for, once rounded
+	sellingBound := sellingOfferAmount
+	if pricen <= priced {
+		var err error
+		sellingBound, err = mulFraction(sellingOfferAmount, pricen, priced, false)
+		if err != nil {
+			return 0, 0, err
+		}
+		sellingBound, err = mulFraction(sellingBound, priced, pricen, true)
+		if err != nil {
+			return 0, 0, err
+		}
+	}
+
+	sellingUnits := sellingBound
+	if sellingUnitsNeeded < sellingUnits {
+		sellingUnits = sellingUnitsNeeded
+	}
+
+	buyingUnits, err := mulFraction(sellingUnits, pricen, priced, true)
+	if err != nil {
+		return 0, 0, err
+	}
+	return buyingUnits, sellingUnits, nil
+}
+
+// mulFraction returns x * n / d, rounded up or down.
+func mulFraction(x, n, d int64, roundUp bool) (int64, error) {
+	var r big.Int
+	r.SetInt64(x)
+	r.Mul(&r, big.NewInt(n))
+	if roundUp {
+		r.Add(&r, big.NewInt(d-1))
+	}
+	r.Quo(&r, big.NewInt(d))
+
+	if !r.IsInt64() {
+		return 0, errors.New("cannot convert big.Int value to int64")
+	}
+	return r.Int64(), nil
+}
+
+// search represents a single query against the order books of a graph.
+//
+// A strict receive search extends the paths from the destination asset
+// towards the source assets: the amount of each asset is the amount needed to
+// buy the amount of the previous asset. A strict send search extends the paths
+// from the source asset towards the destination assets: the amount of each
+// asset is the amount bought with the amount of the previous asset.
+//
+// The paths are extended one asset at a time, like simplepath does, and the
+// search is bounded: at each length, only the maxAssetPaths paths with the
+// best amounts to each asset are extended, and no path is extended once
+// maxPriced paths were priced. The connected assets are always priced in the
+// same order, so that a search reaching maxPriced returns the same paths.
+type search struct {
+	// books are the order books the paths are extended through: by selling
+	// asset for strict receive searches, by buying asset for strict send
+	// searches. assets maps the string representation of their assets to
+	// the assets.
+	books      map[string]map[string]orderBook
+	assets     map[string]xdr.Asset
+	maxLength  uint
+	strictSend bool
+
+	// start is the asset the paths are extended from, targets are the assets
+	// the paths are extended to.
+	start   xdr.Asset
+	amount  xdr.Int64
+	targets []xdr.Asset
+
+	// targetIndexes maps the string representation of the targets to their
+	// index in targets.
+	targetIndexes map[string]int
+	// priced is the number of paths priced so far, up to maxPriced.
+	priced    int
+	maxPriced int
+
+	results []result
+}
+
+// maxAssetPaths is the number of paths to the same asset, of the same length,
+// a search extends.
+const maxAssetPaths = 4
+
+// maxPricedPaths is the number of paths a search prices before it stops
+// extending them.
+const maxPricedPaths = 20000
+
+// maxTargetResults is the maximum number of paths to the same target returned
+// by a search.
+const maxTargetResults = 10
+
+// node is the head of a path explored by a search.
+type node struct {
+	asset  string
+	amount xdr.Int64
+	// price is the price of the best offer of the order book the path was
+	// extended to asset through.
+	price xdr.Price
+	prev  *node
+	depth uint
+}
+
+// contains returns true if asset is on the path of n.
+func (n *node) contains(asset string) bool {
+	for cur := n; cur != nil; cur = cur.prev {
+		if cur.asset == asset {
+			return true
+		}
+	}
+	return false
+}
+
+// assets returns the assets of the path of n, from the start asset to n.
+func (n *node) assets() []string {
+	ids := make([]string, n.depth)
+	for cur := n; cur != nil; cur = cur.prev {
+		ids[cur.depth-1] = cur.asset
+	}
+	return ids
+}
+
+// result is a path found by a search, with the index of its target asset and
+// the assets of the path, which order the paths of the same amounts and
+// length.
+type result struct {
+	path   paths.Path
+	target int
+	key    string
+}
+
+// run searches the paths between the start asset and the targets, and returns
+// them ordered by target, then from the best to the worst amount: the lowest
+// cost for strict receive searches, the highest destination amount for strict
+// send searches.
+func (s *search) run() []paths.Path {
+	s.targetIndexes = map[string]int{}
+	for i, asset := range s.targets {
+		if _, ok := s.targetIndexes[asset.String()]; !ok {
+			s.targetIndexes[asset.String()] = i
+		}
+	}
+
+	frontier := []*node{{asset: s.start.String(), amount: s.amount, depth: 1}}
+	for len(frontier) > 0 {
+		var next []*node
+		for _, cur := range frontier {
+			if target, ok := s.targetIndexes[cur.asset]; ok {
+				s.record(target, cur)
+			}
+			next = append(next, s.extend(cur)...)
+		}
+		frontier = s.prune(next)
+	}
+
+	sort.Slice(s.results, func(i, j int) bool {
+		a, b := s.results[i], s.results[j]
+		if a.target != b.target {
+			return a.target < b.target
+		}
+		if s.better(a.path, b.path) {
+			return true
+		}
+		if s.better(b.path, a.path) {
+			return false
+		}
+		if len(a.path.Path) != len(b.path.Path) {
+			return len(a.path.Path) < len(b.path.Path)
+		}
+		return a.key < b.key
+	})
+
+	found := []paths.Path{}
+	perTarget := map[int]int{}
+	for _, r := range s.results {
+		if len(found) == maxResults {
+			break
+		}
+		if perTarget[r.target] == maxTargetResults {
+			continue
+		}
+		perTarget[r.target]++
+		found = append(found, r.path)
+	}
+	return found
+}
+
+// better returns true if the amount of path a is better than the one of b.
+func (s *search) better(a, b paths.Path) bool {
+	if s.strictSend {
+		return a.DestinationAmount > b.DestinationAmount
+	}
+	return a.Cost < b.Cost
+}
+
+// extend returns the paths extending the path of cur with each asset
+// connected to its head, and the amount of each of them.
+func (s *search) extend(cur *node) []*node {
+	if cur.depth == s.maxLength {
+		return nil
+	}
+
+	// strict receive searches buy the asset from the offers selling it,
+	// strict send searches sell the asset to the offers buying it
+	books := s.books[cur.asset]
+	connected := make([]string, 0, len(books))
+	for next := range books {
+		connected = append(connected, next)
+	}
+	sort.Strings(connected)
+
+	var extended []*node
+	for _, next := range connected {
+		if s.priced >= s.maxPriced {
+			break
+		}
+		book := books[next]
+
+		if cur.contains(next) {
+			continue
+		}
+
+		// a path of the maximum length must end with a target
+		if _, ok := s.targetIndexes[next]; !ok && cur.depth == s.maxLength-1 {
+			continue
+		}
+
+		// the order book cannot fulfill the amount, or the amount of the
+		// path overflows
+		s.priced++
+		var amount xdr.Int64
+		var err error
+		if s.strictSend {
+			amount, err = book.received(cur.amount)
+		} else {
+			amount, err = book.cost(cur.amount)
+		}
+		if err != nil {
+			continue
+		}
+
+		extended = append(extended, &node{
+			asset:  next,
+			amount: amount,
+			price:  book.offers[0].Price,
+			prev:   cur,
+			depth:  cur.depth + 1,
+		})
+	}
+	return extended
+}
+
+// prune keeps, for each asset, the maxAssetPaths paths of nodes with the best
+// amounts.
+func (s *search) prune(nodes []*node) []*node {
+	keys := make(map[*node]string, len(nodes))
+	for _, n := range nodes {
+		keys[n] = strings.Join(n.assets(), ",")
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		a, b := nodes[i], nodes[j]
+		if a.asset != b.asset {
+			return a.asset < b.asset
+		}
+		if a.amount != b.amount {
+			if s.strictSend {
+				return a.amount > b.amount
+			}
+			return a.amount < b.amount
+		}
+		return keys[a] < keys[b]
+	})
+
+	var kept []*node
+	var count int
+	for i, n := range nodes {
+		if i > 0 && n.asset != nodes[i-1].asset {
+			count = 0
+		}
+		if count < maxAssetPaths {
+			kept = append(kept, n)
+		}
+		count++
+	}
+	return kept
+}
+
+// record appends the path of n, whose head is the target-th target, to the
+// results.
+func (s *search) record(target int, n *node) {
+	ids := n.assets()
+	assets := make([]xdr.Asset, len(ids))
+	for i, id := range ids {
+		assets[i] = s.assets[id]
+	}
+	assets[0] = s.start
+	assets[len(assets)-1] = s.targets[target]
+
+	var prices []xdr.Price
+	for cur := n; cur.prev != nil; cur = cur.prev {
+		prices = append(prices, cur.price)
+	}
+
+	path := paths.Path{Path: []xdr.Asset{}}
+	if s.strictSend {
+		path.Source = assets[0]
+		path.Destination = assets[len(assets)-1]
+		path.Cost = s.amount
+		path.DestinationAmount = n.amount
+		for i := 1; i < len(assets)-1; i++ {
+			path.Path = append(path.Path, assets[i])
+		}
+	} else {
+		path.Source = assets[len(assets)-1]
+		path.Destination = assets[0]
+		path.Cost = n.amount
+		path.DestinationAmount = s.amount
+		for i := len(assets) - 2; i > 0; i-- {
+			path.Path = append(path.Path, assets[i])
+		}
+	}
+	path.Slippage = paths.Slippage(path.Cost, path.DestinationAmount, prices)
+
+	s.results = append(s.results, result{
+		path:   path,
+		target: target,
+		key:    strings.Join(ids, ","),
+	})
+}
@@ -0,0 +1,147 @@
+package orderbook
+
+import (
+	"sync"
+
+	"github.com/stellar/go/services/horizon/internal/db2/core"
+	"github.com/stellar/go/services/horizon/internal/ingest/state"
+	"github.com/stellar/go/support/errors"
+	"github.com/stellar/go/support/log"
+	"github.com/stellar/go/xdr"
+)
+
+// maxLedgersToApply is the number of ledgers the graph can fall behind
+// stellar-core before it is reloaded instead of updated ledger by ledger.
+const maxLedgersToApply = 64
+
+// Updater keeps an OrderBookGraph up to date with the offers of stellar-core.
+type Updater struct {
+	Graph *OrderBookGraph
+	CoreQ *core.Q
+
+	lock     sync.Mutex
+	updating bool
+}
+
+// Update brings the graph up to date with the latest ledger in stellar-core.
+// The first update loads all the offers of stellar-core, the next ones apply
+// the offers changes of the ledgers closed since the last update. It returns
+// immediately when another update is in progress.
+func (u *Updater) Update() error {
+	u.lock.Lock()
+	if u.updating {
+		u.lock.Unlock()
+		log.Debug("order book graph: update already in progress")
+		return nil
+	}
+	u.updating = true
+	u.lock.Unlock()
+
+	defer func() {
+		u.lock.Lock()
+		u.updating = false
+		u.lock.Unlock()
+	}()
+
+	var coreLatest int32
+	err := u.CoreQ.LatestLedger(&coreLatest)
+	if err != nil {
+		return errors.Wrap(err, "failed to load core latest ledger")
+	}
+
+	last := int32(u.Graph.LastLedger())
+	if last >= coreLatest {
+		return nil
+	}
+
+	var coreElder int32
+	err = u.CoreQ.ElderLedger(&coreElder)
+	if err != nil {
+		return errors.Wrap(err, "failed to load core elder ledger")
+	}
+
+	if last == 0 || coreElder > last+1 || coreLatest-last > maxLedgersToApply {
+		return u.reload()
+	}
+
+	for seq := last + 1; seq <= coreLatest; seq++ {
+		changes, err := state.LedgerChanges(u.CoreQ, seq)
+		if err != nil {
+			return errors.Wrapf(err, "failed to load changes of ledger %d", seq)
+		}
+
+		err = u.Graph.Apply(uint32(seq), changes)
+		if err != nil {
+			return errors.Wrapf(err, "failed to apply ledger %d", seq)
+		}
+	}
+	return nil
+}
+
+// reload replaces the offers of the graph with the offers of the latest
+// ledger in stellar-core.
+func (u *Updater) reload() error {
+	q := &core.Q{Session: u.CoreQ.Clone()}
+	err := q.Begin()
+	if err != nil {
+		return errors.Wrap(err, "failed to begin transaction")
+	}
+	defer q.Rollback()
+
+	// We need REPEATABLE READ here for the offers to be the ones of the
+	// latest ledger loaded in the same transaction.
+	_, err = q.ExecRaw("SET TRANSACTION ISOLATION LEVEL REPEATABLE READ, READ ONLY")
+	if err != nil {
+		return errors.Wrap(err, "failed to set transaction isolation level")
+	}
+
+	var coreLatest int32
+	err = q.LatestLedger(&coreLatest)
+	if err != nil {
+		return errors.Wrap(err, "failed to load core latest ledger")
+	}
+
+	var offers []core.Offer
+	err = q.AllOffers(&offers)
+	if err != nil {
+		return errors.Wrap(err, "failed to load offers")
+	}
+
+	entries := make([]xdr.OfferEntry, len(offers))
+	for i, offer := range offers {
+		entries[i], err = offerEntry(offer)
+		if err != nil {
+			return errors.Wrapf(err, "invalid offer %d", offer.OfferID)
+		}
+	}
+
+	u.Graph.Reset(uint32(coreLatest), entries)
+
+	log.WithField("ledger", coreLatest).
+		WithField("offers", len(entries)).
+		Info("Loaded order book graph")
+	return nil
+}
+
+// offerEntry converts a row of the stellar-core offers table to an
+// xdr.OfferEntry.
+func offerEntry(offer core.Offer) (xdr.OfferEntry, error) {
+	var seller xdr.AccountId
+	err := seller.SetAddress(offer.SellerID)
+	if err != nil {
+		return xdr.OfferEntry{}, err
+	}
+
+	return xdr.OfferEntry{
+		SellerId: seller,
+		OfferId:  xdr.Uint64(offer.OfferID),
+		Selling:  offer.SellingAsset,
+		Buying:   offer.BuyingAsset,
+		Amount:   offer.Amount,
+		Price: xdr.Price{
+			N: xdr.Int32(offer.Pricen),
+			D: xdr.Int32(offer.Priced),
+		},
+		Flags: xdr.Uint32(offer.Flags),
+	}, nil
+}
@@ -1,10 +1,13 @@
 package paths
 
 import (
+	"math/big"
+
 	"github.com/stellar/go/xdr"
 )
 
-// Query is a query for paths
+// Query is a query for paths delivering a fixed amount of the destination
+// asset (strict receive)
 type Query struct {
 	DestinationAddress string
 	DestinationAsset   xdr.Asset
@@ -12,6 +15,14 @@ type Query struct {
 	SourceAssets       []xdr.Asset
 }
 
+// StrictSendQuery is a query for paths sending a fixed amount of the source
+// asset (strict send)
+type StrictSendQuery struct {
+	SourceAsset       xdr.Asset
+	SourceAmount      xdr.Int64
+	DestinationAssets []xdr.Asset
+}
+
 // Path is the result returned by a path finder and is tied to the DestinationAmount used in the input query
 type Path struct {
 	Path        []xdr.Asset
@@ -19,10 +30,43 @@ type Path struct {
 	Destination xdr.Asset
 	// represents the source assets to be used as `sendMax` field for a `PathPaymentOp` struct
 	Cost xdr.Int64
+	// the amount of the destination asset delivered for Cost
+	DestinationAmount xdr.Int64
+	// the relative difference, from 0 to 1, between the rate of the path and
+	// its rate at the best price of each of its order books
+	Slippage float64
 }
 
 // Finder finds paths.
 type Finder interface {
 	// Returns path for a Query of a maximum length `maxLength`
 	Find(q Query, maxLength uint) ([]Path, error)
+	// Returns path for a StrictSendQuery of a maximum length `maxLength`
+	FindStrictSend(q StrictSendQuery, maxLength uint) ([]Path, error)
+}
+
+// Slippage returns the relative difference between the rate of a path that
+// delivers destinationAmount for cost and the rate of the same path at
+// bestPrices, the prices of the best offers of each of its order books.
+func Slippage(cost, destinationAmount xdr.Int64, bestPrices []xdr.Price) float64 {
+	if cost == 0 {
+		return 0
+	}
+
+	// crossing an offer at the price n/d delivers d/n units of the selling
+	// asset for each unit of the buying asset
+	bestRate := big.NewRat(1, 1)
+	for _, price := range bestPrices {
+		bestRate.Mul(bestRate, big.NewRat(int64(price.D), int64(price.N)))
+	}
+
+	slippage := big.NewRat(int64(destinationAmount), int64(cost))
+	slippage.Quo(slippage, bestRate)
+	slippage.Sub(big.NewRat(1, 1), slippage)
+	if slippage.Sign() < 0 {
+		return 0
+	}
+
+	result, _ := slippage.Float64()
+	return result
 }
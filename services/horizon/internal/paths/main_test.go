@@ -0,0 +1,29 @@
+package paths
+
+import (
+	"testing"
+
+	"github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlippage(t *testing.T) {
+	testCases := []struct {
+		scenario          string
+		cost              xdr.Int64
+		destinationAmount xdr.Int64
+		bestPrices        []xdr.Price
+		want              float64
+	}{
+		{"no hop", 100, 100, nil, 0},
+		{"at the best price", 50, 100, []xdr.Price{{N: 1, D: 2}}, 0},
+		{"at twice the best price", 100, 100, []xdr.Price{{N: 1, D: 2}}, 0.5},
+		{"two hops", 25, 100, []xdr.Price{{N: 1, D: 2}, {N: 1, D: 4}}, 0.5},
+		{"better than the best price", 10, 100, []xdr.Price{{N: 1, D: 2}}, 0},
+		{"zero cost", 0, 100, []xdr.Price{{N: 1, D: 2}}, 0},
+	}
+
+	for _, kase := range testCases {
+		assert.InDelta(t, kase.want, Slippage(kase.cost, kase.destinationAmount, kase.bestPrices), 1e-9, kase.scenario)
+	}
+}
@@ -21,6 +21,7 @@ func PopulateAccount(
 	ct []core.Trustline,
 ) error {
 	dest.ID = ca.Accountid
+	dest.PT = ca.PagingToken()
 	dest.AccountID = ca.Accountid
 	dest.Sequence = ca.Seqnum
 	dest.SubentryCount = ca.Numsubentries
@@ -10,33 +10,6 @@ import (
 	"github.com/stellar/go/support/render/hal"
 )
 
-var EffectTypeNames = map[history.EffectType]string{
-	history.EffectAccountCreated:                     "account_created",
-	history.EffectAccountRemoved:                     "account_removed",
-	history.EffectAccountCredited:                    "account_credited",
-	history.EffectAccountDebited:                     "account_debited",
-	history.EffectAccountThresholdsUpdated:           "account_thresholds_updated",
-	history.EffectAccountHomeDomainUpdated:           "account_home_domain_updated",
-	history.EffectAccountFlagsUpdated:                "account_flags_updated",
-	history.EffectAccountInflationDestinationUpdated: "account_inflation_destination_updated",
-	history.EffectSignerCreated:                      "signer_created",
-	history.EffectSignerRemoved:                      "signer_removed",
-	history.EffectSignerUpdated:                      "signer_updated",
-	history.EffectTrustlineCreated:                   "trustline_created",
-	history.EffectTrustlineRemoved:                   "trustline_removed",
-	history.EffectTrustlineUpdated:                   "trustline_updated",
-	history.EffectTrustlineAuthorized:                "trustline_authorized",
-	history.EffectTrustlineDeauthorized:              "trustline_deauthorized",
-	history.EffectOfferCreated:                       "offer_created",
-	history.EffectOfferRemoved:                       "offer_removed",
-	history.EffectOfferUpdated:                       "offer_updated",
-	history.EffectTrade:                              "trade",
-	history.EffectDataCreated:                        "data_created",
-	history.EffectDataRemoved:                        "data_removed",
-	history.EffectDataUpdated:                        "data_updated",
-	history.EffectSequenceBumped:                     "sequence_bumped",
-}
-
 // NewEffect creates a new effect resource from the provided database representation
 // of the effect.
 func NewEffect(
@@ -53,6 +26,10 @@ func NewEffect(
 		e := effects.AccountCreated{Base: basev}
 		err = row.UnmarshalDetails(&e)
 		result = e
+	case history.EffectAccountRemoved:
+		e := effects.AccountRemoved{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
 	case history.EffectAccountCredited:
 		e := effects.AccountCredited{Base: basev}
 		err = row.UnmarshalDetails(&e)
@@ -73,6 +50,10 @@ func NewEffect(
 		e := effects.AccountFlagsUpdated{Base: basev}
 		err = row.UnmarshalDetails(&e)
 		result = e
+	case history.EffectAccountInflationDestinationUpdated:
+		e := effects.AccountInflationDestinationUpdated{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
 	case history.EffectSignerCreated:
 		e := effects.SignerCreated{Base: basev}
 		err = row.UnmarshalDetails(&e)
@@ -105,10 +86,34 @@ func NewEffect(
 		e := effects.TrustlineDeauthorized{Base: basev}
 		err = row.UnmarshalDetails(&e)
 		result = e
+	case history.EffectOfferCreated:
+		e := effects.OfferCreated{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
+	case history.EffectOfferRemoved:
+		e := effects.OfferRemoved{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
+	case history.EffectOfferUpdated:
+		e := effects.OfferUpdated{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
 	case history.EffectTrade:
 		e := effects.Trade{Base: basev}
 		err = row.UnmarshalDetails(&e)
 		result = e
+	case history.EffectDataCreated:
+		e := effects.DataCreated{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
+	case history.EffectDataUpdated:
+		e := effects.DataUpdated{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
+	case history.EffectDataRemoved:
+		e := effects.DataRemoved{Base: basev}
+		err = row.UnmarshalDetails(&e)
+		result = e
 	case history.EffectSequenceBumped:
 		e := effects.SequenceBumped{Base: basev}
 		err = row.UnmarshalDetails(&e)
@@ -147,7 +152,7 @@ func PopulateBaseEffect(ctx context.Context, this *effects.Base, row history.Eff
 func populateEffectType(this *effects.Base, row history.Effect) {
 	var ok bool
 	this.TypeI = int32(row.Type)
-	this.Type, ok = EffectTypeNames[row.Type]
+	this.Type, ok = effects.EffectTypeNames[effects.EffectType(row.Type)]
 
 	if !ok {
 		this.Type = "unknown"
@@ -0,0 +1,44 @@
+package resourceadapter
+
+import (
+	"testing"
+
+	"github.com/stellar/go/protocols/horizon/effects"
+	"github.com/stellar/go/services/horizon/internal/db2/history"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestEffectTypes tests that the effect types stored in the history database
+// are the effect types of the effect resources.
+func TestEffectTypes(t *testing.T) {
+	types := map[history.EffectType]effects.EffectType{
+		history.EffectAccountCreated:                     effects.EffectAccountCreated,
+		history.EffectAccountRemoved:                     effects.EffectAccountRemoved,
+		history.EffectAccountCredited:                    effects.EffectAccountCredited,
+		history.EffectAccountDebited:                     effects.EffectAccountDebited,
+		history.EffectAccountThresholdsUpdated:           effects.EffectAccountThresholdsUpdated,
+		history.EffectAccountHomeDomainUpdated:           effects.EffectAccountHomeDomainUpdated,
+		history.EffectAccountFlagsUpdated:                effects.EffectAccountFlagsUpdated,
+		history.EffectAccountInflationDestinationUpdated: effects.EffectAccountInflationDestinationUpdated,
+		history.EffectSignerCreated:                      effects.EffectSignerCreated,
+		history.EffectSignerRemoved:                      effects.EffectSignerRemoved,
+		history.EffectSignerUpdated:                      effects.EffectSignerUpdated,
+		history.EffectTrustlineCreated:                   effects.EffectTrustlineCreated,
+		history.EffectTrustlineRemoved:                   effects.EffectTrustlineRemoved,
+		history.EffectTrustlineUpdated:                   effects.EffectTrustlineUpdated,
+		history.EffectTrustlineAuthorized:                effects.EffectTrustlineAuthorized,
+		history.EffectTrustlineDeauthorized:              effects.EffectTrustlineDeauthorized,
+		history.EffectOfferCreated:                       effects.EffectOfferCreated,
+		history.EffectOfferRemoved:                       effects.EffectOfferRemoved,
+		history.EffectOfferUpdated:                       effects.EffectOfferUpdated,
+		history.EffectTrade:                              effects.EffectTrade,
+		history.EffectDataCreated:                        effects.EffectDataCreated,
+		history.EffectDataRemoved:                        effects.EffectDataRemoved,
+		history.EffectDataUpdated:                        effects.EffectDataUpdated,
+		history.EffectSequenceBumped:                     effects.EffectSequenceBumped,
+	}
+
+	for historyType, resourceType := range types {
+		assert.Equal(t, int(resourceType), int(historyType))
+	}
+}
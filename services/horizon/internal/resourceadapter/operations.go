@@ -45,6 +45,11 @@ func NewOperation(
 		e.CreatePassiveOffer.Base = base
 		err = row.UnmarshalDetails(&e)
 		result = e
+	case xdr.OperationTypeManageBuyOffer:
+		e := operations.ManageBuyOffer{}
+		e.CreatePassiveOffer.Base = base
+		err = row.UnmarshalDetails(&e)
+		result = e
 	case xdr.OperationTypeCreatePassiveOffer:
 		e := operations.CreatePassiveOffer{Base: base}
 		err = row.UnmarshalDetails(&e)
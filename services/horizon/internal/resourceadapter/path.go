@@ -4,14 +4,18 @@ import (
 	"context"
 
 	"github.com/stellar/go/amount"
+	"github.com/stellar/go/price"
 	"github.com/stellar/go/protocols/horizon"
 	"github.com/stellar/go/services/horizon/internal/paths"
 )
 
-// PopulatePath converts the paths.Path into a Path
-func PopulatePath(ctx context.Context, dest *horizon.Path, q paths.Query, p paths.Path) (err error) {
-	dest.DestinationAmount = amount.String(q.DestinationAmount)
+// PopulatePath converts the paths.Path into a Path. The amounts of p are the
+// ones of the query that found it, whether it fixed the destination amount
+// (strict receive) or the source amount (strict send).
+func PopulatePath(ctx context.Context, dest *horizon.Path, p paths.Path) (err error) {
+	dest.DestinationAmount = amount.String(p.DestinationAmount)
 	dest.SourceAmount = amount.String(p.Cost)
+	dest.Slippage = price.StringFromFloat64(p.Slippage)
 
 	err = p.Source.Extract(
 		&dest.SourceAssetType,
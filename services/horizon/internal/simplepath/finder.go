@@ -56,3 +56,41 @@ func (f *Finder) Find(q paths.Query, maxLength uint) (result []paths.Path, err e
 		Info("Finished pathfind")
 	return
 }
+
+// FindStrictSend performs a strict send path find with the provided query.
+func (f *Finder) FindStrictSend(q paths.StrictSendQuery, maxLength uint) (result []paths.Path, err error) {
+	log.WithField("source_asset", q.SourceAsset).
+		WithField("source_amount", q.SourceAmount).
+		WithField("destination_assets", q.DestinationAssets).
+		Info("Starting strict send pathfind")
+
+	if len(q.DestinationAssets) == 0 {
+		err = errors.New("No destination assets")
+		return
+	}
+
+	if maxLength == 0 {
+		maxLength = MaxPathLength
+	}
+
+	if maxLength < 2 || maxLength > MaxPathLength {
+		err = errors.New("invalid value of maxLength")
+		return
+	}
+
+	s := &sendSearch{
+		Query:     q,
+		Q:         &core.Q{Session: f.Q.Clone()},
+		MaxLength: maxLength,
+	}
+
+	s.Init()
+	s.Run()
+
+	result, err = s.Results, s.Err
+
+	log.WithField("found", len(s.Results)).
+		WithField("err", s.Err).
+		Info("Finished strict send pathfind")
+	return
+}
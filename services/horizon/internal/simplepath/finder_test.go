@@ -106,6 +106,35 @@ func TestFinder(t *testing.T) {
 		tt.Assert.Len(p, 0)
 	}
 
+	// strict send: the same paths, sending 10 USD
+	sendQuery := paths.StrictSendQuery{
+		SourceAsset:       usd,
+		SourceAmount:      xdr.Int64(100000000), // 10.0000000
+		DestinationAssets: []xdr.Asset{eur},
+	}
+
+	p, err = finder.FindStrictSend(sendQuery, MaxPathLength)
+	if tt.Assert.NoError(err) {
+		tt.Assert.Len(p, 3)
+
+		tt.Assert.Equal(p[0].Source.String(), usd.String())
+		tt.Assert.Equal(p[0].Destination.String(), eur.String())
+		tt.Assert.Equal(p[0].Cost, xdr.Int64(100000000))
+		tt.Assert.Equal(p[0].DestinationAmount, xdr.Int64(200000000)) // 20.0000000
+		tt.Assert.Len(p[0].Path, 0)
+
+		tt.Assert.Equal(p[1].DestinationAmount, xdr.Int64(100000000))
+		if tt.Assert.Len(p[1].Path, 1) {
+			tt.Assert.Equal(p[1].Path[0].String(), inter1.String())
+		}
+
+		tt.Assert.Equal(p[2].DestinationAmount, xdr.Int64(100000000))
+		if tt.Assert.Len(p[2].Path, 2) {
+			tt.Assert.Equal(p[2].Path[0].String(), inter21.String())
+			tt.Assert.Equal(p[2].Path[1].String(), inter22.String())
+		}
+	}
+
 	//  regression: paths that involve native currencies can be found
 
 	query = paths.Query{
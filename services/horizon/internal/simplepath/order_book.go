@@ -21,6 +21,10 @@ type orderBook struct {
 	Selling xdr.Asset // the offers are selling this asset
 	Buying  xdr.Asset // the offers are buying this asset
 	Q       *core.Q
+
+	// BestPrice is the price of the first offer crossed, set by
+	// CostToConsumeLiquidity and AmountReceived from the offers they load.
+	BestPrice xdr.Price
 }
 
 // CostToConsumeLiquidity returns the buyingAmount (ob.Buying) needed to consume the sellingAmount (ob.Selling)
@@ -39,6 +43,7 @@ func (ob *orderBook) CostToConsumeLiquidity(sellingAmount xdr.Int64) (xdr.Int64,
 	// remaining is the units of ob.Selling that we want to consume
 	remaining := int64(sellingAmount)
 	var buyingAmount int64
+	ob.BestPrice = xdr.Price{}
 	for rows.Next() {
 		// load data from the row
 		var offerAmount, pricen, priced, offerid int64
@@ -46,6 +51,7 @@ func (ob *orderBook) CostToConsumeLiquidity(sellingAmount xdr.Int64) (xdr.Int64,
 		if e != nil {
 			return 0, e
 		}
+		ob.crossed(pricen, priced)
 
 		buyingUnitsExtracted, sellingUnitsExtracted, e := convertToBuyingUnits(offerAmount, remaining, pricen, priced)
 		if e != nil {
@@ -66,6 +72,70 @@ func (ob *orderBook) CostToConsumeLiquidity(sellingAmount xdr.Int64) (xdr.Int64,
 	return 0, ErrNotEnough
 }
 
+// AmountReceived returns the sellingAmount (ob.Selling) bought by spending the buyingAmount (ob.Buying)
+func (ob *orderBook) AmountReceived(buyingAmount xdr.Int64) (xdr.Int64, error) {
+	// load orderbook from core's db
+	sql, e := ob.query()
+	if e != nil {
+		return 0, e
+	}
+	rows, e := ob.Q.Query(sql)
+	if e != nil {
+		return 0, e
+	}
+	defer rows.Close()
+
+	// remaining is the units of ob.Buying that we want to spend
+	remaining := int64(buyingAmount)
+	var sellingAmount int64
+	ob.BestPrice = xdr.Price{}
+	for rows.Next() {
+		// load data from the row
+		var offerAmount, pricen, priced, offerid int64
+		e = rows.Scan(&offerAmount, &pricen, &priced, &offerid)
+		if e != nil {
+			return 0, e
+		}
+		ob.crossed(pricen, priced)
+
+		// the cost of the whole offer
+		buyingUnitsExtracted, sellingUnitsExtracted, e := convertToBuyingUnits(offerAmount, offerAmount, pricen, priced)
+		if e != nil {
+			return 0, e
+		}
+
+		// the remaining units only buy a part of the offer
+		if buyingUnitsExtracted > remaining {
+			sellingUnitsExtracted, e = mulFractionRoundDown(remaining, priced, pricen)
+			if e != nil {
+				return 0, e
+			}
+			buyingUnitsExtracted = remaining
+		}
+
+		// overflow check
+		if willAddOverflow(sellingAmount, sellingUnitsExtracted) {
+			return xdr.Int64(0), fmt.Errorf("adding these two values will cause an integer overflow: %d, %d", sellingAmount, sellingUnitsExtracted)
+		}
+		sellingAmount += sellingUnitsExtracted
+		remaining -= buyingUnitsExtracted
+
+		// check if we spent all the units we wanted
+		if remaining <= 0 {
+			return xdr.Int64(sellingAmount), nil
+		}
+	}
+	return 0, ErrNotEnough
+}
+
+// crossed records the price of an offer crossed, the offers being crossed
+// from the best price.
+func (ob *orderBook) crossed(pricen, priced int64) {
+	if ob.BestPrice.D == 0 {
+		ob.BestPrice = xdr.Price{N: xdr.Int32(pricen), D: xdr.Int32(priced)}
+	}
+}
+
 func willAddOverflow(a int64, b int64) bool {
 	return a > math.MaxInt64-b
 }
@@ -45,6 +45,7 @@ func TestOrderBook(t *testing.T) {
 			r, err := ob.CostToConsumeLiquidity(xdr.Int64(kase.eur))
 			if tt.Assert.NoError(err) {
 				tt.Assert.Equal(xdr.Int64(kase.wantCostUSD), r)
+				tt.Assert.Equal(xdr.Price{N: 1, D: 2}, ob.BestPrice)
 			}
 		})
 	}
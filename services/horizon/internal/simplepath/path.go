@@ -14,7 +14,10 @@ type pathNode struct {
 	Tail       *pathNode
 	Q          *core.Q
 	CachedCost *xdr.Int64
-	Depth      uint
+	// BestPrice is the price of the best offer of the orderbook crossed to
+	// buy the asset of the tail, set once the cost of the node is computed.
+	BestPrice xdr.Price
+	Depth     uint
 }
 
 func (p *pathNode) String() string {
@@ -119,6 +122,7 @@ func (p *pathNode) Cost(amount xdr.Int64) (xdr.Int64, error) {
 		if err != nil {
 			return result, err
 		}
+		cur.BestPrice = ob.BestPrice
 	}
 
 	// Cache the result
@@ -50,6 +50,20 @@ func (c computedNode) asPath() paths.Path {
 	}
 }
 
+// asPath returns the paths.Path of c, delivering the destination amount of
+// the query. The best prices of its orderbooks were loaded with their cost.
+func (s *search) asPath(c computedNode) paths.Path {
+	result := c.asPath()
+	result.DestinationAmount = s.Query.DestinationAmount
+
+	var prices []xdr.Price
+	for cur := &c.path; cur.Tail != nil; cur = cur.Tail {
+		prices = append(prices, cur.BestPrice)
+	}
+	result.Slippage = paths.Slippage(result.Cost, result.DestinationAmount, prices)
+	return result
+}
+
 const maxResults = 20
 
 // Init initialized the search, setting fields on the struct used to
@@ -152,7 +166,7 @@ func (s *search) runOnce() {
 	id := cur.path.Asset.String()
 
 	if s.isTarget(id) {
-		s.Results = append(s.Results, cur.asPath())
+		s.Results = append(s.Results, s.asPath(cur))
 	}
 
 	if cur.path.Depth == s.MaxLength {
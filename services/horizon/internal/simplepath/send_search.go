@@ -0,0 +1,194 @@
+package simplepath
+
+import (
+	"github.com/stellar/go/services/horizon/internal/db2/core"
+	"github.com/stellar/go/services/horizon/internal/paths"
+	"github.com/stellar/go/xdr"
+)
+
+// sendSearch represents a single strict send query against the simple finder.
+// It is the counterpart of search: paths are extended from the source asset
+// towards the destination assets, and the amount of each asset on a path is
+// the amount bought by selling the amount of the previous asset.
+//
+// The sendSearch struct is used as search is: create an instance, call Init()
+// then Run().
+type sendSearch struct {
+	Query     paths.StrictSendQuery
+	Q         *core.Q
+	MaxLength uint
+
+	// Fields below are initialized by a call to Init() after
+	// setting the fields above
+	queue   []*sendNode
+	targets map[string]bool
+
+	//This fields below are initialized after the search is run
+	Err     error
+	Results []paths.Path
+}
+
+// sendNode represents a path from the source asset, whose head is Asset
+type sendNode struct {
+	Asset xdr.Asset
+	// the amount of Asset bought with the source amount
+	Amount xdr.Int64
+	// the price of the best offer of the orderbook crossed to buy Asset
+	BestPrice xdr.Price
+	Previous  *sendNode
+	Depth     uint
+}
+
+// IsOnPath returns true if a given asset is in the path.
+func (n *sendNode) IsOnPath(asset xdr.Asset) bool {
+	for cur := n; cur != nil; cur = cur.Previous {
+		if asset.Equals(cur.Asset) {
+			return true
+		}
+	}
+	return false
+}
+
+// asPath returns the paths.Path of n, sending sourceAmount.
+func (n *sendNode) asPath(sourceAmount xdr.Int64) paths.Path {
+	var assets []xdr.Asset
+	var prices []xdr.Price
+	for cur := n; cur != nil; cur = cur.Previous {
+		assets = append([]xdr.Asset{cur.Asset}, assets...)
+		if cur.Previous != nil {
+			prices = append(prices, cur.BestPrice)
+		}
+	}
+
+	result := paths.Path{
+		Path:              nil,
+		Source:            assets[0],
+		Destination:       n.Asset,
+		Cost:              sourceAmount,
+		DestinationAmount: n.Amount,
+		Slippage:          paths.Slippage(sourceAmount, n.Amount, prices),
+	}
+	if len(assets) > 2 {
+		result.Path = assets[1 : len(assets)-1]
+	}
+	return result
+}
+
+// Init initialized the search, setting fields on the struct used to
+// hold state needed during the actual search.
+func (s *sendSearch) Init() {
+	s.queue = []*sendNode{
+		{
+			Asset:  s.Query.SourceAsset,
+			Amount: s.Query.SourceAmount,
+			Depth:  1,
+		},
+	}
+
+	s.targets = map[string]bool{}
+	for _, a := range s.Query.DestinationAssets {
+		s.targets[a.String()] = true
+	}
+
+	s.Err = nil
+	s.Results = nil
+}
+
+// Run triggers the search, which will populate the Results and Err
+// field for the search after completion.
+func (s *sendSearch) Run() {
+	if s.Err != nil {
+		return
+	}
+
+	s.Err = s.Q.Begin()
+	if s.Err != nil {
+		return
+	}
+
+	defer s.Q.Rollback()
+
+	// See search.Run for why REPEATABLE READ is needed.
+	_, s.Err = s.Q.ExecRaw("SET TRANSACTION ISOLATION LEVEL REPEATABLE READ, READ ONLY")
+	if s.Err != nil {
+		return
+	}
+
+	for s.hasMore() {
+		s.runOnce()
+	}
+}
+
+// returns false if the search should stop.
+func (s *sendSearch) hasMore() bool {
+	if s.Err != nil {
+		return false
+	}
+
+	if len(s.Results) >= maxResults {
+		return false
+	}
+
+	return len(s.queue) > 0
+}
+
+// runOnce processes the head of the search queue, findings results
+// and extending the search as necessary.
+func (s *sendSearch) runOnce() {
+	cur := s.queue[0]
+	s.queue = s.queue[1:]
+
+	if s.targets[cur.Asset.String()] {
+		s.Results = append(s.Results, cur.asPath(s.Query.SourceAmount))
+	}
+
+	if cur.Depth == s.MaxLength {
+		return
+	}
+
+	s.extendSearch(cur)
+}
+
+func (s *sendSearch) extendSearch(n *sendNode) {
+	// find the assets sold for the head of the path
+	var connected []xdr.Asset
+	s.Err = s.Q.ConnectedSellingAssets(&connected, n.Asset)
+	if s.Err != nil {
+		return
+	}
+
+	for _, a := range connected {
+		// See search.extendSearch.
+		if n.IsOnPath(a) {
+			continue
+		}
+
+		if n.Depth == s.MaxLength-1 && !s.targets[a.String()] {
+			continue
+		}
+
+		ob := &orderBook{
+			Selling: a,       // offer is selling this asset
+			Buying:  n.Asset, // offer is buying this asset
+			Q:       s.Q,
+		}
+
+		var amount xdr.Int64
+		amount, s.Err = ob.AmountReceived(n.Amount)
+		if s.Err == ErrNotEnough {
+			s.Err = nil
+			continue
+		}
+		if s.Err != nil {
+			return
+		}
+
+		s.queue = append(s.queue, &sendNode{
+			Asset:     a,
+			Amount:    amount,
+			BestPrice: ob.BestPrice,
+			Previous:  n,
+			Depth:     n.Depth + 1,
+		})
+	}
+}
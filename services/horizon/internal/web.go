@@ -138,6 +138,7 @@ func (w *web) mustInstallActions(enableAssetStats bool, friendbotURL *url.URL) {
 
 	// account actions
 	r.Route("/accounts", func(r chi.Router) {
+		r.Get("/", w.accountIndexHandler(w.getAccountPage))
 		r.Route("/{account_id}", func(r chi.Router) {
 			r.Get("/", w.accountHandler(w.getAccountInfo))
 			r.Get("/transactions", w.transactionHandler(w.getTransactionPageByAccount, w.streamTransactionByAccount))
@@ -178,7 +179,8 @@ func (w *web) mustInstallActions(enableAssetStats bool, friendbotURL *url.URL) {
 	r.Get("/trades", TradeIndexAction{}.Handle)
 	r.Get("/trade_aggregations", TradeAggregateIndexAction{}.Handle)
 	r.Route("/offers", func(r chi.Router) {
-		r.Get("/{id}", NotImplementedAction{}.Handle)
+		r.Get("/", OfferIndexAction{}.Handle)
+		r.Get("/{id}", OfferShowAction{}.Handle)
 		r.Get("/{offer_id}/trades", TradeIndexAction{}.Handle)
 	})
 	r.Get("/order_book", OrderBookShowAction{}.Handle)
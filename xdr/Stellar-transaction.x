@@ -26,7 +26,8 @@ enum OperationType
     ACCOUNT_MERGE = 8,
     INFLATION = 9,
     MANAGE_DATA = 10,
-    BUMP_SEQUENCE = 11
+    BUMP_SEQUENCE = 11,
+    MANAGE_BUY_OFFER = 12
 };
 
 /* CreateAccount
@@ -102,6 +103,25 @@ struct ManageOfferOp
     uint64 offerID;
 };
 
+/* Creates, updates or deletes an offer with amount in terms of buying asset
+
+Threshold: med
+
+Result: ManageBuyOfferResult
+
+*/
+struct ManageBuyOfferOp
+{
+    Asset selling;
+    Asset buying;
+    int64 buyAmount; // amount being bought. if set to 0, delete the offer
+    Price price;     // price of thing being bought in terms of what you are
+                     // selling
+
+    // 0=create a new offer, otherwise edit an existing offer
+    uint64 offerID;
+};
+
 /* Creates an offer that doesn't take offers of the same price
 
 Threshold: med
@@ -268,6 +288,8 @@ struct Operation
         ManageDataOp manageDataOp;
     case BUMP_SEQUENCE:
         BumpSequenceOp bumpSequenceOp;
+    case MANAGE_BUY_OFFER:
+        ManageBuyOfferOp manageBuyOfferOp;
     }
     body;
 };
@@ -524,6 +546,39 @@ default:
     void;
 };
 
+/******* ManageBuyOffer Result ********/
+
+enum ManageBuyOfferResultCode
+{
+    // codes considered as "success" for the operation
+    MANAGE_BUY_OFFER_SUCCESS = 0,
+
+    // codes considered as "failure" for the operation
+    MANAGE_BUY_OFFER_MALFORMED = -1,     // generated offer would be invalid
+    MANAGE_BUY_OFFER_SELL_NO_TRUST = -2, // no trust line for what we're selling
+    MANAGE_BUY_OFFER_BUY_NO_TRUST = -3,  // no trust line for what we're buying
+    MANAGE_BUY_OFFER_SELL_NOT_AUTHORIZED = -4, // not authorized to sell
+    MANAGE_BUY_OFFER_BUY_NOT_AUTHORIZED = -5,  // not authorized to buy
+    MANAGE_BUY_OFFER_LINE_FULL = -6,   // can't receive more of what it's buying
+    MANAGE_BUY_OFFER_UNDERFUNDED = -7, // doesn't hold what it's trying to sell
+    MANAGE_BUY_OFFER_CROSS_SELF = -8, // would cross an offer from the same user
+    MANAGE_BUY_OFFER_SELL_NO_ISSUER = -9, // no issuer for what we're selling
+    MANAGE_BUY_OFFER_BUY_NO_ISSUER = -10, // no issuer for what we're buying
+
+    // update errors
+    MANAGE_BUY_OFFER_NOT_FOUND = -11, // offerID does not match an existing offer
+
+    MANAGE_BUY_OFFER_LOW_RESERVE = -12 // not enough funds to create a new Offer
+};
+
+union ManageBuyOfferResult switch (ManageBuyOfferResultCode code)
+{
+case MANAGE_BUY_OFFER_SUCCESS:
+    ManageOfferSuccessResult success;
+default:
+    void;
+};
+
 /******* SetOptions Result ********/
 
 enum SetOptionsResultCode
@@ -725,6 +780,8 @@ case opINNER:
         ManageDataResult manageDataResult;
     case BUMP_SEQUENCE:
         BumpSequenceResult bumpSeqResult;
+    case MANAGE_BUY_OFFER:
+        ManageBuyOfferResult manageBuyOfferResult;
     }
     tr;
 default:
@@ -0,0 +1,25 @@
+package xdr
+
+import "fmt"
+
+// LedgerEntryChanges returns the ledger entry changes of the transaction meta,
+// in the order they were applied: the changes of the transaction itself
+// first, then the changes of each of its operations.
+func (meta *TransactionMeta) LedgerEntryChanges() (LedgerEntryChanges, error) {
+	var changes LedgerEntryChanges
+	var operations []OperationMeta
+	switch meta.V {
+	case 0:
+		operations = meta.MustOperations()
+	case 1:
+		changes = append(changes, meta.MustV1().TxChanges...)
+		operations = meta.MustV1().Operations
+	default:
+		return nil, fmt.Errorf("unknown transaction meta version %d", meta.V)
+	}
+
+	for _, operation := range operations {
+		changes = append(changes, operation.Changes...)
+	}
+	return changes, nil
+}
@@ -0,0 +1,42 @@
+package xdr_test
+
+import (
+	"testing"
+
+	. "github.com/stellar/go/xdr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransactionMetaLedgerEntryChanges(t *testing.T) {
+	removed := func(offerID Uint64) LedgerEntryChange {
+		return LedgerEntryChange{
+			Type: LedgerEntryChangeTypeLedgerEntryRemoved,
+			Removed: &LedgerKey{
+				Type:  LedgerEntryTypeOffer,
+				Offer: &LedgerKeyOffer{OfferId: offerID},
+			},
+		}
+	}
+
+	operations := []OperationMeta{
+		{Changes: LedgerEntryChanges{removed(2)}},
+		{Changes: LedgerEntryChanges{removed(3), removed(4)}},
+	}
+
+	meta := TransactionMeta{V: 0, Operations: &operations}
+	changes, err := meta.LedgerEntryChanges()
+	assert.NoError(t, err)
+	assert.Equal(t, LedgerEntryChanges{removed(2), removed(3), removed(4)}, changes)
+
+	meta = TransactionMeta{V: 1, V1: &TransactionMetaV1{
+		TxChanges:  LedgerEntryChanges{removed(1)},
+		Operations: operations,
+	}}
+	changes, err = meta.LedgerEntryChanges()
+	assert.NoError(t, err)
+	assert.Equal(t, LedgerEntryChanges{removed(1), removed(2), removed(3), removed(4)}, changes)
+
+	meta = TransactionMeta{V: 2}
+	_, err = meta.LedgerEntryChanges()
+	assert.EqualError(t, err, "unknown transaction meta version 2")
+}
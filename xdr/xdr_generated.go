@@ -3425,20 +3425,28 @@ var (
 //
 //   enum BucketEntryType
 //    {
-//        LIVEENTRY = 0,
-//        DEADENTRY = 1
+//        METAENTRY =
+//            -1, // At-and-after protocol 11: bucket metadata, should come first.
+//        LIVEENTRY = 0, // Before protocol 11: created-or-updated;
+//                       // At-and-after protocol 11: only updated.
+//        DEADENTRY = 1,
+//        INITENTRY = 2 // At-and-after protocol 11: only created.
 //    };
 //
 type BucketEntryType int32
 
 const (
+	BucketEntryTypeMetaentry BucketEntryType = -1
 	BucketEntryTypeLiveentry BucketEntryType = 0
 	BucketEntryTypeDeadentry BucketEntryType = 1
+	BucketEntryTypeInitentry BucketEntryType = 2
 )
 
 var bucketEntryTypeMap = map[int32]string{
-	0: "BucketEntryTypeLiveentry",
-	1: "BucketEntryTypeDeadentry",
+	-1: "BucketEntryTypeMetaentry",
+	0:  "BucketEntryTypeLiveentry",
+	1:  "BucketEntryTypeDeadentry",
+	2:  "BucketEntryTypeInitentry",
 }
 
 // ValidEnum validates a proposed value for this enum.  Implements
@@ -3472,21 +3480,120 @@ var (
 	_ encoding.BinaryUnmarshaler = (*BucketEntryType)(nil)
 )
 
+// BucketMetadataExt is an XDR NestedUnion defines as:
+//
+//   union switch (int v)
+//        {
+//        case 0:
+//            void;
+//        }
+//
+type BucketMetadataExt struct {
+	V int32
+}
+
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u BucketMetadataExt) SwitchFieldName() string {
+	return "V"
+}
+
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of BucketMetadataExt
+func (u BucketMetadataExt) ArmForSwitch(sw int32) (string, bool) {
+	switch int32(sw) {
+	case 0:
+		return "", true
+	}
+	return "-", false
+}
+
+// NewBucketMetadataExt creates a new  BucketMetadataExt.
+func NewBucketMetadataExt(v int32, value interface{}) (result BucketMetadataExt, err error) {
+	result.V = v
+	switch int32(v) {
+	case 0:
+		// void
+	}
+	return
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s BucketMetadataExt) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *BucketMetadataExt) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*BucketMetadataExt)(nil)
+	_ encoding.BinaryUnmarshaler = (*BucketMetadataExt)(nil)
+)
+
+// BucketMetadata is an XDR Struct defines as:
+//
+//   struct BucketMetadata
+//    {
+//        // Indicates the protocol version used to create / merge this bucket.
+//        uint32 ledgerVersion;
+//
+//        // reserved for future use
+//        union switch (int v)
+//        {
+//        case 0:
+//            void;
+//        }
+//        ext;
+//    };
+//
+type BucketMetadata struct {
+	LedgerVersion Uint32
+	Ext           BucketMetadataExt
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s BucketMetadata) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *BucketMetadata) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*BucketMetadata)(nil)
+	_ encoding.BinaryUnmarshaler = (*BucketMetadata)(nil)
+)
+
 // BucketEntry is an XDR Union defines as:
 //
 //   union BucketEntry switch (BucketEntryType type)
 //    {
 //    case LIVEENTRY:
+//    case INITENTRY:
 //        LedgerEntry liveEntry;
 //
 //    case DEADENTRY:
 //        LedgerKey deadEntry;
+//    case METAENTRY:
+//        BucketMetadata metaEntry;
 //    };
 //
 type BucketEntry struct {
 	Type      BucketEntryType
 	LiveEntry *LedgerEntry
 	DeadEntry *LedgerKey
+	MetaEntry *BucketMetadata
 }
 
 // SwitchFieldName returns the field name in which this union's
@@ -3501,8 +3608,12 @@ func (u BucketEntry) ArmForSwitch(sw int32) (string, bool) {
 	switch BucketEntryType(sw) {
 	case BucketEntryTypeLiveentry:
 		return "LiveEntry", true
+	case BucketEntryTypeInitentry:
+		return "LiveEntry", true
 	case BucketEntryTypeDeadentry:
 		return "DeadEntry", true
+	case BucketEntryTypeMetaentry:
+		return "MetaEntry", true
 	}
 	return "-", false
 }
@@ -3518,6 +3629,13 @@ func NewBucketEntry(aType BucketEntryType, value interface{}) (result BucketEntr
 			return
 		}
 		result.LiveEntry = &tv
+	case BucketEntryTypeInitentry:
+		tv, ok := value.(LedgerEntry)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be LedgerEntry")
+			return
+		}
+		result.LiveEntry = &tv
 	case BucketEntryTypeDeadentry:
 		tv, ok := value.(LedgerKey)
 		if !ok {
@@ -3525,6 +3643,13 @@ func NewBucketEntry(aType BucketEntryType, value interface{}) (result BucketEntr
 			return
 		}
 		result.DeadEntry = &tv
+	case BucketEntryTypeMetaentry:
+		tv, ok := value.(BucketMetadata)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be BucketMetadata")
+			return
+		}
+		result.MetaEntry = &tv
 	}
 	return
 }
@@ -3579,6 +3704,31 @@ func (u BucketEntry) GetDeadEntry() (result LedgerKey, ok bool) {
 	return
 }
 
+// MustMetaEntry retrieves the MetaEntry value from the union,
+// panicing if the value is not set.
+func (u BucketEntry) MustMetaEntry() BucketMetadata {
+	val, ok := u.GetMetaEntry()
+
+	if !ok {
+		panic("arm MetaEntry is not set")
+	}
+
+	return val
+}
+
+// GetMetaEntry retrieves the MetaEntry value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u BucketEntry) GetMetaEntry() (result BucketMetadata, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "MetaEntry" {
+		result = *u.MetaEntry
+		ok = true
+	}
+
+	return
+}
+
 // MarshalBinary implements encoding.BinaryMarshaler.
 func (s BucketEntry) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
@@ -5800,7 +5950,8 @@ var (
 //        ACCOUNT_MERGE = 8,
 //        INFLATION = 9,
 //        MANAGE_DATA = 10,
-//        BUMP_SEQUENCE = 11
+//        BUMP_SEQUENCE = 11,
+//        MANAGE_BUY_OFFER = 12
 //    };
 //
 type OperationType int32
@@ -5818,6 +5969,7 @@ const (
 	OperationTypeInflation          OperationType = 9
 	OperationTypeManageData         OperationType = 10
 	OperationTypeBumpSequence       OperationType = 11
+	OperationTypeManageBuyOffer     OperationType = 12
 )
 
 var operationTypeMap = map[int32]string{
@@ -5833,6 +5985,7 @@ var operationTypeMap = map[int32]string{
 	9:  "OperationTypeInflation",
 	10: "OperationTypeManageData",
 	11: "OperationTypeBumpSequence",
+	12: "OperationTypeManageBuyOffer",
 }
 
 // ValidEnum validates a proposed value for this enum.  Implements
@@ -6012,6 +6165,46 @@ var (
 	_ encoding.BinaryUnmarshaler = (*ManageOfferOp)(nil)
 )
 
+// ManageBuyOfferOp is an XDR Struct defines as:
+//
+//   struct ManageBuyOfferOp
+//    {
+//        Asset selling;
+//        Asset buying;
+//        int64 buyAmount; // amount being bought. if set to 0, delete the offer
+//        Price price;     // price of thing being bought in terms of what you are
+//                         // selling
+//
+//        // 0=create a new offer, otherwise edit an existing offer
+//        uint64 offerID;
+//    };
+//
+type ManageBuyOfferOp struct {
+	Selling   Asset
+	Buying    Asset
+	BuyAmount Int64
+	Price     Price
+	OfferId   Uint64
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s ManageBuyOfferOp) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *ManageBuyOfferOp) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*ManageBuyOfferOp)(nil)
+	_ encoding.BinaryUnmarshaler = (*ManageBuyOfferOp)(nil)
+)
+
 // CreatePassiveOfferOp is an XDR Struct defines as:
 //
 //   struct CreatePassiveOfferOp
@@ -6393,6 +6586,8 @@ var (
 //            ManageDataOp manageDataOp;
 //        case BUMP_SEQUENCE:
 //            BumpSequenceOp bumpSequenceOp;
+//        case MANAGE_BUY_OFFER:
+//            ManageBuyOfferOp manageBuyOfferOp;
 //        }
 //
 type OperationBody struct {
@@ -6408,6 +6603,7 @@ type OperationBody struct {
 	Destination          *AccountId
 	ManageDataOp         *ManageDataOp
 	BumpSequenceOp       *BumpSequenceOp
+	ManageBuyOfferOp     *ManageBuyOfferOp
 }
 
 // SwitchFieldName returns the field name in which this union's
@@ -6444,6 +6640,8 @@ func (u OperationBody) ArmForSwitch(sw int32) (string, bool) {
 		return "ManageDataOp", true
 	case OperationTypeBumpSequence:
 		return "BumpSequenceOp", true
+	case OperationTypeManageBuyOffer:
+		return "ManageBuyOfferOp", true
 	}
 	return "-", false
 }
@@ -6531,6 +6729,13 @@ func NewOperationBody(aType OperationType, value interface{}) (result OperationB
 			return
 		}
 		result.BumpSequenceOp = &tv
+	case OperationTypeManageBuyOffer:
+		tv, ok := value.(ManageBuyOfferOp)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be ManageBuyOfferOp")
+			return
+		}
+		result.ManageBuyOfferOp = &tv
 	}
 	return
 }
@@ -6810,6 +7015,31 @@ func (u OperationBody) GetBumpSequenceOp() (result BumpSequenceOp, ok bool) {
 	return
 }
 
+// MustManageBuyOfferOp retrieves the ManageBuyOfferOp value from the union,
+// panicing if the value is not set.
+func (u OperationBody) MustManageBuyOfferOp() ManageBuyOfferOp {
+	val, ok := u.GetManageBuyOfferOp()
+
+	if !ok {
+		panic("arm ManageBuyOfferOp is not set")
+	}
+
+	return val
+}
+
+// GetManageBuyOfferOp retrieves the ManageBuyOfferOp value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u OperationBody) GetManageBuyOfferOp() (result ManageBuyOfferOp, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "ManageBuyOfferOp" {
+		result = *u.ManageBuyOfferOp
+		ok = true
+	}
+
+	return
+}
+
 // MarshalBinary implements encoding.BinaryMarshaler.
 func (s OperationBody) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
@@ -6863,6 +7093,8 @@ var (
 //            ManageDataOp manageDataOp;
 //        case BUMP_SEQUENCE:
 //            BumpSequenceOp bumpSequenceOp;
+//        case MANAGE_BUY_OFFER:
+//            ManageBuyOfferOp manageBuyOfferOp;
 //        }
 //        body;
 //    };
@@ -8427,6 +8659,188 @@ var (
 	_ encoding.BinaryUnmarshaler = (*ManageOfferResult)(nil)
 )
 
+// ManageBuyOfferResultCode is an XDR Enum defines as:
+//
+//   enum ManageBuyOfferResultCode
+//    {
+//        // codes considered as "success" for the operation
+//        MANAGE_BUY_OFFER_SUCCESS = 0,
+//
+//        // codes considered as "failure" for the operation
+//        MANAGE_BUY_OFFER_MALFORMED = -1,     // generated offer would be invalid
+//        MANAGE_BUY_OFFER_SELL_NO_TRUST = -2, // no trust line for what we're selling
+//        MANAGE_BUY_OFFER_BUY_NO_TRUST = -3,  // no trust line for what we're buying
+//        MANAGE_BUY_OFFER_SELL_NOT_AUTHORIZED = -4, // not authorized to sell
+//        MANAGE_BUY_OFFER_BUY_NOT_AUTHORIZED = -5,  // not authorized to buy
+//        MANAGE_BUY_OFFER_LINE_FULL = -6,   // can't receive more of what it's buying
+//        MANAGE_BUY_OFFER_UNDERFUNDED = -7, // doesn't hold what it's trying to sell
+//        MANAGE_BUY_OFFER_CROSS_SELF = -8, // would cross an offer from the same user
+//        MANAGE_BUY_OFFER_SELL_NO_ISSUER = -9, // no issuer for what we're selling
+//        MANAGE_BUY_OFFER_BUY_NO_ISSUER = -10, // no issuer for what we're buying
+//
+//        // update errors
+//        MANAGE_BUY_OFFER_NOT_FOUND = -11, // offerID does not match an existing offer
+//
+//        MANAGE_BUY_OFFER_LOW_RESERVE = -12 // not enough funds to create a new Offer
+//    };
+//
+type ManageBuyOfferResultCode int32
+
+const (
+	ManageBuyOfferResultCodeManageBuyOfferSuccess           ManageBuyOfferResultCode = 0
+	ManageBuyOfferResultCodeManageBuyOfferMalformed         ManageBuyOfferResultCode = -1
+	ManageBuyOfferResultCodeManageBuyOfferSellNoTrust       ManageBuyOfferResultCode = -2
+	ManageBuyOfferResultCodeManageBuyOfferBuyNoTrust        ManageBuyOfferResultCode = -3
+	ManageBuyOfferResultCodeManageBuyOfferSellNotAuthorized ManageBuyOfferResultCode = -4
+	ManageBuyOfferResultCodeManageBuyOfferBuyNotAuthorized  ManageBuyOfferResultCode = -5
+	ManageBuyOfferResultCodeManageBuyOfferLineFull          ManageBuyOfferResultCode = -6
+	ManageBuyOfferResultCodeManageBuyOfferUnderfunded       ManageBuyOfferResultCode = -7
+	ManageBuyOfferResultCodeManageBuyOfferCrossSelf         ManageBuyOfferResultCode = -8
+	ManageBuyOfferResultCodeManageBuyOfferSellNoIssuer      ManageBuyOfferResultCode = -9
+	ManageBuyOfferResultCodeManageBuyOfferBuyNoIssuer       ManageBuyOfferResultCode = -10
+	ManageBuyOfferResultCodeManageBuyOfferNotFound          ManageBuyOfferResultCode = -11
+	ManageBuyOfferResultCodeManageBuyOfferLowReserve        ManageBuyOfferResultCode = -12
+)
+
+var manageBuyOfferResultCodeMap = map[int32]string{
+	0:   "ManageBuyOfferResultCodeManageBuyOfferSuccess",
+	-1:  "ManageBuyOfferResultCodeManageBuyOfferMalformed",
+	-2:  "ManageBuyOfferResultCodeManageBuyOfferSellNoTrust",
+	-3:  "ManageBuyOfferResultCodeManageBuyOfferBuyNoTrust",
+	-4:  "ManageBuyOfferResultCodeManageBuyOfferSellNotAuthorized",
+	-5:  "ManageBuyOfferResultCodeManageBuyOfferBuyNotAuthorized",
+	-6:  "ManageBuyOfferResultCodeManageBuyOfferLineFull",
+	-7:  "ManageBuyOfferResultCodeManageBuyOfferUnderfunded",
+	-8:  "ManageBuyOfferResultCodeManageBuyOfferCrossSelf",
+	-9:  "ManageBuyOfferResultCodeManageBuyOfferSellNoIssuer",
+	-10: "ManageBuyOfferResultCodeManageBuyOfferBuyNoIssuer",
+	-11: "ManageBuyOfferResultCodeManageBuyOfferNotFound",
+	-12: "ManageBuyOfferResultCodeManageBuyOfferLowReserve",
+}
+
+// ValidEnum validates a proposed value for this enum.  Implements
+// the Enum interface for ManageBuyOfferResultCode
+func (e ManageBuyOfferResultCode) ValidEnum(v int32) bool {
+	_, ok := manageBuyOfferResultCodeMap[v]
+	return ok
+}
+
+// String returns the name of `e`
+func (e ManageBuyOfferResultCode) String() string {
+	name, _ := manageBuyOfferResultCodeMap[int32(e)]
+	return name
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s ManageBuyOfferResultCode) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *ManageBuyOfferResultCode) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*ManageBuyOfferResultCode)(nil)
+	_ encoding.BinaryUnmarshaler = (*ManageBuyOfferResultCode)(nil)
+)
+
+// ManageBuyOfferResult is an XDR Union defines as:
+//
+//   union ManageBuyOfferResult switch (ManageBuyOfferResultCode code)
+//    {
+//    case MANAGE_BUY_OFFER_SUCCESS:
+//        ManageOfferSuccessResult success;
+//    default:
+//        void;
+//    };
+//
+type ManageBuyOfferResult struct {
+	Code    ManageBuyOfferResultCode
+	Success *ManageOfferSuccessResult
+}
+
+// SwitchFieldName returns the field name in which this union's
+// discriminant is stored
+func (u ManageBuyOfferResult) SwitchFieldName() string {
+	return "Code"
+}
+
+// ArmForSwitch returns which field name should be used for storing
+// the value for an instance of ManageBuyOfferResult
+func (u ManageBuyOfferResult) ArmForSwitch(sw int32) (string, bool) {
+	switch ManageBuyOfferResultCode(sw) {
+	case ManageBuyOfferResultCodeManageBuyOfferSuccess:
+		return "Success", true
+	default:
+		return "", true
+	}
+}
+
+// NewManageBuyOfferResult creates a new  ManageBuyOfferResult.
+func NewManageBuyOfferResult(code ManageBuyOfferResultCode, value interface{}) (result ManageBuyOfferResult, err error) {
+	result.Code = code
+	switch ManageBuyOfferResultCode(code) {
+	case ManageBuyOfferResultCodeManageBuyOfferSuccess:
+		tv, ok := value.(ManageOfferSuccessResult)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be ManageOfferSuccessResult")
+			return
+		}
+		result.Success = &tv
+	default:
+		// void
+	}
+	return
+}
+
+// MustSuccess retrieves the Success value from the union,
+// panicing if the value is not set.
+func (u ManageBuyOfferResult) MustSuccess() ManageOfferSuccessResult {
+	val, ok := u.GetSuccess()
+
+	if !ok {
+		panic("arm Success is not set")
+	}
+
+	return val
+}
+
+// GetSuccess retrieves the Success value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u ManageBuyOfferResult) GetSuccess() (result ManageOfferSuccessResult, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Code))
+
+	if armName == "Success" {
+		result = *u.Success
+		ok = true
+	}
+
+	return
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (s ManageBuyOfferResult) MarshalBinary() ([]byte, error) {
+	b := new(bytes.Buffer)
+	_, err := Marshal(b, s)
+	return b.Bytes(), err
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (s *ManageBuyOfferResult) UnmarshalBinary(inp []byte) error {
+	_, err := Unmarshal(bytes.NewReader(inp), s)
+	return err
+}
+
+var (
+	_ encoding.BinaryMarshaler   = (*ManageBuyOfferResult)(nil)
+	_ encoding.BinaryUnmarshaler = (*ManageBuyOfferResult)(nil)
+)
+
 // SetOptionsResultCode is an XDR Enum defines as:
 //
 //   enum SetOptionsResultCode
@@ -9482,6 +9896,8 @@ var (
 //            ManageDataResult manageDataResult;
 //        case BUMP_SEQUENCE:
 //            BumpSequenceResult bumpSeqResult;
+//        case MANAGE_BUY_OFFER:
+//            ManageBuyOfferResult manageBuyOfferResult;
 //        }
 //
 type OperationResultTr struct {
@@ -9498,6 +9914,7 @@ type OperationResultTr struct {
 	InflationResult          *InflationResult
 	ManageDataResult         *ManageDataResult
 	BumpSeqResult            *BumpSequenceResult
+	ManageBuyOfferResult     *ManageBuyOfferResult
 }
 
 // SwitchFieldName returns the field name in which this union's
@@ -9534,6 +9951,8 @@ func (u OperationResultTr) ArmForSwitch(sw int32) (string, bool) {
 		return "ManageDataResult", true
 	case OperationTypeBumpSequence:
 		return "BumpSeqResult", true
+	case OperationTypeManageBuyOffer:
+		return "ManageBuyOfferResult", true
 	}
 	return "-", false
 }
@@ -9626,6 +10045,13 @@ func NewOperationResultTr(aType OperationType, value interface{}) (result Operat
 			return
 		}
 		result.BumpSeqResult = &tv
+	case OperationTypeManageBuyOffer:
+		tv, ok := value.(ManageBuyOfferResult)
+		if !ok {
+			err = fmt.Errorf("invalid value, must be ManageBuyOfferResult")
+			return
+		}
+		result.ManageBuyOfferResult = &tv
 	}
 	return
 }
@@ -9930,6 +10356,31 @@ func (u OperationResultTr) GetBumpSeqResult() (result BumpSequenceResult, ok boo
 	return
 }
 
+// MustManageBuyOfferResult retrieves the ManageBuyOfferResult value from the union,
+// panicing if the value is not set.
+func (u OperationResultTr) MustManageBuyOfferResult() ManageBuyOfferResult {
+	val, ok := u.GetManageBuyOfferResult()
+
+	if !ok {
+		panic("arm ManageBuyOfferResult is not set")
+	}
+
+	return val
+}
+
+// GetManageBuyOfferResult retrieves the ManageBuyOfferResult value from the union,
+// returning ok if the union's switch indicated the value is valid.
+func (u OperationResultTr) GetManageBuyOfferResult() (result ManageBuyOfferResult, ok bool) {
+	armName, _ := u.ArmForSwitch(int32(u.Type))
+
+	if armName == "ManageBuyOfferResult" {
+		result = *u.ManageBuyOfferResult
+		ok = true
+	}
+
+	return
+}
+
 // MarshalBinary implements encoding.BinaryMarshaler.
 func (s OperationResultTr) MarshalBinary() ([]byte, error) {
 	b := new(bytes.Buffer)
@@ -9979,6 +10430,8 @@ var (
 //            ManageDataResult manageDataResult;
 //        case BUMP_SEQUENCE:
 //            BumpSequenceResult bumpSeqResult;
+//        case MANAGE_BUY_OFFER:
+//            ManageBuyOfferResult manageBuyOfferResult;
 //        }
 //        tr;
 //    default: